@@ -11,6 +11,7 @@ import (
 
 	"github.com/ctreminiom/go-atlassian/v2/admin/internal"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/transport"
 	"github.com/ctreminiom/go-atlassian/v2/service/common"
 )
 
@@ -19,6 +20,18 @@ const defaultAPIEndpoint = "https://api.atlassian.com/"
 // New creates a new instance of Client.
 // It takes a common.HTTPClient as input and returns a pointer to Client and an error.
 func New(httpClient common.HTTPClient) (*Client, error) {
+	return NewWithConfig(&transport.Config{HTTPClient: httpClient})
+}
+
+// NewWithConfig creates a new instance of Client using the settings of the configuration.
+// The configuration site is ignored, as the Admin API is served by a fixed host.
+func NewWithConfig(config *transport.Config) (*Client, error) {
+
+	if config == nil {
+		return nil, model.ErrNoClientConfig
+	}
+
+	httpClient := config.HTTP()
 
 	// If no HTTP client is provided, use the default HTTP client.
 	if httpClient == nil {
@@ -56,6 +69,8 @@ func New(httpClient common.HTTPClient) (*Client, error) {
 	// Initialize the User service with a user token service.
 	client.User = internal.NewUserService(client, internal.NewUserTokenService(client))
 
+	config.Authenticate(client.Auth)
+
 	return client, nil
 }
 
@@ -103,6 +118,14 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 	}
 	req.Header.Set("Accept", "application/json")
 
+	if accept := model.AcceptFromContext(ctx); accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	if requestID := model.RequestIDFromContext(ctx); requestID != "" {
+		req.Header.Set("X-Request-Id", requestID)
+	}
+
 	// Set the Content-Type header if a body is provided.
 	if body != nil && contentType == "" {
 		req.Header.Set("Content-Type", "application/json")
@@ -117,9 +140,19 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 		req.Header.Add("Authorization", fmt.Sprintf("Bearer %v", c.Auth.GetBearerToken()))
 	}
 
-	// Set the User-Agent header if available.
+	// Set the User-Agent header, the library is identified if no user agent is provided.
+	userAgent := transport.DefaultUserAgent
 	if c.Auth.HasUserAgent() {
-		req.Header.Set("User-Agent", c.Auth.GetUserAgent())
+		userAgent = c.Auth.GetUserAgent()
+	}
+
+	req.Header.Set("User-Agent", userAgent)
+
+	// Apply the credentials provider, if any, it replaces the static credentials.
+	if provider := c.Auth.GetAuthProvider(); provider != nil {
+		if err = provider.Apply(ctx, req); err != nil {
+			return nil, err
+		}
 	}
 
 	return req, nil
@@ -128,6 +161,37 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 // Call sends an HTTP request and processes the response.
 // It takes an *http.Request and a structure to unmarshal the response into.
 // It returns a pointer to model.ResponseScheme and an error.
+// Close releases the resources held by the client, e.g. the background workers of the transport options.
+//
+// The HTTP client is closed if it implements io.Closer, the client must not be used after Close.
+func (c *Client) Close() error {
+
+	if closer, ok := c.HTTP.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
+// With returns a client sharing the site, the credentials and the HTTP transport of the client, decorated with the
+// additional transport options, e.g. a longer timeout or an extra header:
+//
+//	uploads, err := instance.With(transport.WithTimeout(5 * time.Minute))
+//
+// The HTTP client isn't rebuilt, the options are applied on a shallow copy of the transport decorator, so the
+// connections are reused. Closing the returned client doesn't close the HTTP client of the original one.
+func (c *Client) With(options ...transport.Option) (*Client, error) {
+
+	derived, err := NewWithConfig(&transport.Config{Site: c.Site.String(), HTTPClient: transport.Derive(c.HTTP, options...)})
+	if err != nil {
+		return nil, err
+	}
+
+	derived.Site, derived.Auth = c.Site, c.Auth
+
+	return derived, nil
+}
+
 func (c *Client) Call(request *http.Request, structure interface{}) (*model.ResponseScheme, error) {
 
 	// Perform the HTTP request.
@@ -137,7 +201,13 @@ func (c *Client) Call(request *http.Request, structure interface{}) (*model.Resp
 	}
 
 	// Process the HTTP response.
-	return c.processResponse(response, structure)
+	res, err := c.processResponse(response, structure)
+	if err != nil {
+		// The Atlassian request ID is appended, so it can be quoted to the Atlassian support.
+		return res, model.NewRequestError(res, err)
+	}
+
+	return res, nil
 }
 
 func (c *Client) processResponse(response *http.Response, structure interface{}) (*model.ResponseScheme, error) {
@@ -170,6 +240,12 @@ func (c *Client) processResponse(response *http.Response, structure interface{})
 		case http.StatusUnauthorized:
 			return res, model.ErrUnauthorized
 
+		case http.StatusForbidden:
+			return res, model.ErrForbidden
+
+		case http.StatusConflict:
+			return res, model.ErrConflict
+
 		case http.StatusInternalServerError:
 			return res, model.ErrInternal
 
@@ -181,7 +257,7 @@ func (c *Client) processResponse(response *http.Response, structure interface{})
 		}
 	}
 
-	if structure != nil {
+	if structure != nil && model.AcceptsJSON(response.Request.Header.Get("Accept")) {
 		if err = json.Unmarshal(responseAsBytes, &structure); err != nil {
 			return res, err
 		}
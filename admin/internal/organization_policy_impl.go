@@ -140,6 +140,10 @@ func (i *internalOrganizationPolicyImpl) Create(ctx context.Context, organizatio
 		return nil, nil, model.ErrNoAdminOrganization
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("admin/v1/orgs/%v/policies", organizationID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -166,6 +170,10 @@ func (i *internalOrganizationPolicyImpl) Update(ctx context.Context, organizatio
 		return nil, nil, model.ErrNoAdminPolicy
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("admin/v1/orgs/%v/policies/%v", organizationID, policyID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
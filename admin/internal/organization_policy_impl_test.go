@@ -347,6 +347,19 @@ func Test_internalOrganizationPolicyImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to create the http request"),
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -484,6 +497,20 @@ func Test_internalOrganizationPolicyImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to create the http request"),
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:            context.Background(),
+				organizationID: "organization-id-sample",
+				policyID:       "policy-id-sample",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -110,6 +110,10 @@ func (i *internalSCIMUserImpl) Create(ctx context.Context, directoryID string, p
 		return nil, nil, model.ErrNoAdminDirectoryID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	params := url.Values{}
 
 	if len(attributes) != 0 {
@@ -293,6 +297,10 @@ func (i *internalSCIMUserImpl) Update(ctx context.Context, directoryID, userID s
 		return nil, nil, model.ErrNoAdminUserID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	params := url.Values{}
 	if len(attributes) != 0 {
 		params.Add("attributes", strings.Join(attributes, ","))
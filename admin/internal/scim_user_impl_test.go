@@ -651,6 +651,22 @@ func Test_internalSCIMUserImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to create the http request"),
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:                context.Background(),
+				directoryID:        "direction-id-sample",
+				userID:             "user-id-uuid-sample",
+				attributes:         []string{"groups"},
+				excludedAttributes: []string{"roles"},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -789,6 +805,21 @@ func Test_internalSCIMUserImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to create the http request"),
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:                context.Background(),
+				directoryID:        "direction-id-sample",
+				attributes:         []string{"groups"},
+				excludedAttributes: []string{"roles"},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
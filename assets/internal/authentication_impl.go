@@ -25,6 +25,9 @@ type AuthenticationService struct {
 	userAgentProvided bool
 	// agent is the user agent string.
 	agent string
+
+	// provider sets the credentials on the requests, replacing the static ones.
+	provider common.AuthProvider
 }
 
 // SetBearerToken sets the bearer token for authentication.
@@ -77,3 +80,13 @@ func (a *AuthenticationService) GetUserAgent() string {
 func (a *AuthenticationService) HasUserAgent() bool {
 	return a.userAgentProvided
 }
+
+// SetAuthProvider sets the provider applying the credentials on the requests.
+func (a *AuthenticationService) SetAuthProvider(provider common.AuthProvider) {
+	a.provider = provider
+}
+
+// GetAuthProvider returns the provider applying the credentials on the requests, if any.
+func (a *AuthenticationService) GetAuthProvider() common.AuthProvider {
+	return a.provider
+}
@@ -215,6 +215,10 @@ func (i *internalObjectImpl) Update(ctx context.Context, workspaceID, objectID s
 		return nil, nil, model.ErrNoObjectID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/object/%v", workspaceID, objectID)
 
 	req, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -344,6 +348,10 @@ func (i *internalObjectImpl) Create(ctx context.Context, workspaceID string, pay
 		return nil, nil, model.ErrNoWorkspaceID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/object/create", workspaceID)
 
 	req, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -859,6 +859,20 @@ func Test_internalObjectImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoObjectID,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:         context.Background(),
+				workspaceID: "workspace-uuid-sample",
+				objectID:    "object-uuid-sample",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -996,6 +1010,19 @@ func Test_internalObjectImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoWorkspaceID,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:         context.Background(),
+				workspaceID: "workspace-uuid-sample",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -120,6 +120,10 @@ func (i *internalObjectSchemaImpl) Create(ctx context.Context, workspaceID strin
 		return nil, nil, model.ErrNoWorkspaceID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/objectschema/create", workspaceID)
 
 	req, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -172,6 +176,10 @@ func (i *internalObjectSchemaImpl) Update(ctx context.Context, workspaceID, obje
 		return nil, nil, model.ErrNoObjectSchemaID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/objectschema/%v", workspaceID, objectSchemaID)
 
 	req, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -758,6 +758,20 @@ func Test_internalObjectSchemaImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoObjectSchemaID,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:            context.Background(),
+				workspaceID:    "workspace-uuid-sample",
+				objectSchemaID: "object-schema-id-sample",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -882,6 +896,19 @@ func Test_internalObjectSchemaImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoWorkspaceID,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:         context.Background(),
+				workspaceID: "workspace-uuid-sample",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
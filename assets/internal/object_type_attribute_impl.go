@@ -64,6 +64,10 @@ func (i *internalObjectTypeAttributeImpl) Create(ctx context.Context, workspaceI
 		return nil, nil, model.ErrNoObjectTypeID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/objecttypeattribute/%v", workspaceID, objectTypeID)
 
 	req, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -94,6 +98,10 @@ func (i *internalObjectTypeAttributeImpl) Update(ctx context.Context, workspaceI
 		return nil, nil, model.ErrNoObjectTypeAttributeID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/objecttypeattribute/%v/%v", workspaceID, objectTypeID, attributeID)
 
 	req, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
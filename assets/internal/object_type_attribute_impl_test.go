@@ -136,6 +136,20 @@ func Test_internalObjectTypeAttributeImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoObjectTypeID,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspaceID:  "workspace-uuid-sample",
+				objectTypeID: "object-type-uuid-sample",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -309,6 +323,21 @@ func Test_internalObjectTypeAttributeImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoObjectTypeAttributeID,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspaceID:  "workspace-uuid-sample",
+				objectTypeID: "object-type-uuid-sample",
+				attributeID:  "attribute-id-sample",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
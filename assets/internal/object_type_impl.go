@@ -119,6 +119,10 @@ func (i *internalObjectTypeImpl) Update(ctx context.Context, workspaceID, object
 		return nil, nil, model.ErrNoObjectTypeID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/objecttype/%v", workspaceID, objectTypeID)
 
 	req, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -141,6 +145,10 @@ func (i *internalObjectTypeImpl) Create(ctx context.Context, workspaceID string,
 		return nil, nil, model.ErrNoWorkspaceID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("jsm/assets/workspace/%v/v1/objecttype/create", workspaceID)
 
 	req, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
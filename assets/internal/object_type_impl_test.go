@@ -497,6 +497,20 @@ func Test_internalObjectTypeImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoObjectTypeID,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:          context.Background(),
+				workspaceID:  "workspace-uuid-sample",
+				objectTypeID: "object-type-id-sample",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -625,6 +639,19 @@ func Test_internalObjectTypeImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoWorkspaceID,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:         context.Background(),
+				workspaceID: "workspace-uuid-sample",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -19,6 +19,8 @@ type AuthenticationService struct {
 
 	userAgentProvided bool
 	agent             string
+
+	provider common.AuthProvider
 }
 
 // SetBearerToken sets the token to be used in the Authorization header.
@@ -72,3 +74,13 @@ func (a *AuthenticationService) GetUserAgent() string {
 func (a *AuthenticationService) HasUserAgent() bool {
 	return a.userAgentProvided
 }
+
+// SetAuthProvider sets the provider applying the credentials on the requests.
+func (a *AuthenticationService) SetAuthProvider(provider common.AuthProvider) {
+	a.provider = provider
+}
+
+// GetAuthProvider returns the provider applying the credentials on the requests, if any.
+func (a *AuthenticationService) GetAuthProvider() common.AuthProvider {
+	return a.provider
+}
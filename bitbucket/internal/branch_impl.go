@@ -0,0 +1,150 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewBranchService creates a new BranchService.
+func NewBranchService(client service.Connector, restriction *BranchRestrictionService) *BranchService {
+
+	return &BranchService{
+		internalClient: &internalBranchServiceImpl{c: client},
+		Restriction:    restriction,
+	}
+}
+
+// BranchService handles communication with the repository branch related methods of the Bitbucket API.
+type BranchService struct {
+	internalClient bitbucket.BranchConnector
+	Restriction    *BranchRestrictionService
+}
+
+// Create creates a new branch in the specified repository.
+//
+// The target hash is the commit the branch points to.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/refs/branches
+//
+// https://docs.go-atlassian.io/bitbucket-cloud/repository/branches#create-a-branch
+func (b *BranchService) Create(ctx context.Context, workspace, repoSlug string, payload *model.BranchCreatePayloadScheme) (*model.BranchReferenceScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Create(ctx, workspace, repoSlug, payload)
+}
+
+// Get returns a branch object within the specified repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/refs/branches/{name}
+//
+// https://docs.go-atlassian.io/bitbucket-cloud/repository/branches#get-a-branch
+func (b *BranchService) Get(ctx context.Context, workspace, repoSlug, name string) (*model.BranchReferenceScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Get(ctx, workspace, repoSlug, name)
+}
+
+// Delete deletes a branch in the specified repository.
+//
+// The main branch is not allowed to be deleted.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/refs/branches/{name}
+//
+// https://docs.go-atlassian.io/bitbucket-cloud/repository/branches#delete-a-branch
+func (b *BranchService) Delete(ctx context.Context, workspace, repoSlug, name string) (*model.ResponseScheme, error) {
+	return b.internalClient.Delete(ctx, workspace, repoSlug, name)
+}
+
+type internalBranchServiceImpl struct {
+	c service.Connector
+}
+
+func (i *internalBranchServiceImpl) Create(ctx context.Context, workspace, repoSlug string, payload *model.BranchCreatePayloadScheme) (*model.BranchReferenceScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if payload == nil || payload.Name == "" {
+		return nil, nil, model.ErrNoBranchName
+	}
+
+	if payload.Target == nil || payload.Target.Hash == "" {
+		return nil, nil, model.ErrNoBranchTarget
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/refs/branches", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	branch := new(model.BranchReferenceScheme)
+	response, err := i.c.Call(request, branch)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return branch, response, nil
+}
+
+func (i *internalBranchServiceImpl) Get(ctx context.Context, workspace, repoSlug, name string) (*model.BranchReferenceScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if name == "" {
+		return nil, nil, model.ErrNoBranchName
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/refs/branches/%v", workspace, repoSlug, url.PathEscape(name))
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	branch := new(model.BranchReferenceScheme)
+	response, err := i.c.Call(request, branch)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return branch, response, nil
+}
+
+func (i *internalBranchServiceImpl) Delete(ctx context.Context, workspace, repoSlug, name string) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, model.ErrNoRepository
+	}
+
+	if name == "" {
+		return nil, model.ErrNoBranchName
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/refs/branches/%v", workspace, repoSlug, url.PathEscape(name))
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
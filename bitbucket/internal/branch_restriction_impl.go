@@ -0,0 +1,156 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/bitbucket"
+)
+
+// NewBranchRestrictionService creates a new BranchRestrictionService.
+func NewBranchRestrictionService(client service.Connector) *BranchRestrictionService {
+	return &BranchRestrictionService{
+		internalClient: &internalBranchRestrictionServiceImpl{c: client},
+	}
+}
+
+// BranchRestrictionService handles communication with the repository branch restriction related methods of the Bitbucket API.
+type BranchRestrictionService struct {
+	internalClient bitbucket.BranchRestrictionConnector
+}
+
+// Gets returns a paginated list of all branch restrictions on the repository.
+//
+// GET /2.0/repositories/{workspace}/{repo_slug}/branch-restrictions
+//
+// https://docs.go-atlassian.io/bitbucket-cloud/repository/branch-restrictions#list-branch-restrictions
+func (b *BranchRestrictionService) Gets(ctx context.Context, workspace, repoSlug string, options *model.BranchRestrictionOptionsScheme) (*model.BranchRestrictionPageScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Gets(ctx, workspace, repoSlug, options)
+}
+
+// Create creates a new branch restriction rule for a repository.
+//
+// The ID of the created restriction is returned on the restriction.
+//
+// POST /2.0/repositories/{workspace}/{repo_slug}/branch-restrictions
+//
+// https://docs.go-atlassian.io/bitbucket-cloud/repository/branch-restrictions#create-a-branch-restriction-rule
+func (b *BranchRestrictionService) Create(ctx context.Context, workspace, repoSlug string, payload *model.BranchRestrictionScheme) (*model.BranchRestrictionScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Create(ctx, workspace, repoSlug, payload)
+}
+
+// Delete deletes an existing branch restriction rule.
+//
+// DELETE /2.0/repositories/{workspace}/{repo_slug}/branch-restrictions/{id}
+//
+// https://docs.go-atlassian.io/bitbucket-cloud/repository/branch-restrictions#delete-a-branch-restriction-rule
+func (b *BranchRestrictionService) Delete(ctx context.Context, workspace, repoSlug string, restrictionID int) (*model.ResponseScheme, error) {
+	return b.internalClient.Delete(ctx, workspace, repoSlug, restrictionID)
+}
+
+type internalBranchRestrictionServiceImpl struct {
+	c service.Connector
+}
+
+func (i *internalBranchRestrictionServiceImpl) Gets(ctx context.Context, workspace, repoSlug string, options *model.BranchRestrictionOptionsScheme) (*model.BranchRestrictionPageScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("2.0/repositories/%v/%v/branch-restrictions", workspace, repoSlug))
+
+	if options != nil {
+
+		query := url.Values{}
+
+		if options.Kind != "" {
+			query.Add("kind", string(options.Kind))
+		}
+
+		if options.Pattern != "" {
+			query.Add("pattern", options.Pattern)
+		}
+
+		if query.Encode() != "" {
+			endpoint.WriteString(fmt.Sprintf("?%v", query.Encode()))
+		}
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint.String(), "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.BranchRestrictionPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalBranchRestrictionServiceImpl) Create(ctx context.Context, workspace, repoSlug string, payload *model.BranchRestrictionScheme) (*model.BranchRestrictionScheme, *model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, nil, model.ErrNoRepository
+	}
+
+	if payload == nil || payload.Kind == "" {
+		return nil, nil, model.ErrNoBranchRestrictionKind
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/branch-restrictions", workspace, repoSlug)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	restriction := new(model.BranchRestrictionScheme)
+	response, err := i.c.Call(request, restriction)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return restriction, response, nil
+}
+
+func (i *internalBranchRestrictionServiceImpl) Delete(ctx context.Context, workspace, repoSlug string, restrictionID int) (*model.ResponseScheme, error) {
+
+	if workspace == "" {
+		return nil, model.ErrNoWorkspace
+	}
+
+	if repoSlug == "" {
+		return nil, model.ErrNoRepository
+	}
+
+	if restrictionID == 0 {
+		return nil, model.ErrNoBranchRestrictionID
+	}
+
+	endpoint := fmt.Sprintf("2.0/repositories/%v/%v/branch-restrictions/%v", workspace, repoSlug, restrictionID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
@@ -101,6 +101,10 @@ func (i *internalWorkspaceHookServiceImpl) Create(ctx context.Context, workspace
 		return nil, nil, model.ErrNoWorkspace
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("2.0/workspaces/%v/hooks", workspace)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -153,6 +157,10 @@ func (i *internalWorkspaceHookServiceImpl) Update(ctx context.Context, workspace
 		return nil, nil, model.ErrNoWebhookID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("2.0/workspaces/%v/hooks/%v", workspace, webhookID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
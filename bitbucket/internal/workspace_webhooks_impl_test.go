@@ -332,6 +332,19 @@ func Test_internalWorkspaceWebhookServiceImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoWorkspace,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -464,6 +477,20 @@ func Test_internalWorkspaceWebhookServiceImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoWebhookID,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:       context.Background(),
+				workspace: "work-space-name-sample",
+				webhookID: "webhook-uuid",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
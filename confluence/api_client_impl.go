@@ -12,10 +12,22 @@ import (
 
 	"github.com/ctreminiom/go-atlassian/v2/confluence/internal"
 	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/transport"
 	"github.com/ctreminiom/go-atlassian/v2/service/common"
 )
 
 func New(httpClient common.HTTPClient, site string) (*Client, error) {
+	return NewWithConfig(&transport.Config{HTTPClient: httpClient, Site: site})
+}
+
+// NewWithConfig creates a new instance of Client using the settings of the configuration.
+func NewWithConfig(config *transport.Config) (*Client, error) {
+
+	if config == nil {
+		return nil, models.ErrNoClientConfig
+	}
+
+	httpClient, site := config.HTTP(), config.Site
 
 	if httpClient == nil {
 		httpClient = http.DefaultClient
@@ -47,6 +59,8 @@ func New(httpClient common.HTTPClient, site string) (*Client, error) {
 		Label:              internal.NewContentLabelService(client),
 		Property:           internal.NewPropertyService(client),
 		Version:            internal.NewVersionService(client),
+		State:              internal.NewContentStateService(client),
+		Watch:              internal.NewContentWatchService(client),
 		Restriction: internal.NewRestrictionService(client,
 			internal.NewRestrictionOperationService(client,
 				internal.NewRestrictionOperationGroupService(client),
@@ -61,6 +75,10 @@ func New(httpClient common.HTTPClient, site string) (*Client, error) {
 	client.LongTask = internal.NewTaskService(client)
 	client.Analytics = internal.NewAnalyticsService(client)
 	client.Template = internal.NewTemplateService(client)
+	client.Reaction = internal.NewReactionService(client)
+	client.Group = internal.NewGroupService(client)
+
+	config.Authenticate(client.Auth)
 
 	return client, nil
 }
@@ -76,6 +94,8 @@ type Client struct {
 	LongTask  *internal.TaskService
 	Analytics *internal.AnalyticsService
 	Template  *internal.TemplateService
+	Reaction  *internal.ReactionService
+	Group     *internal.GroupService
 }
 
 func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType string, body interface{}) (*http.Request, error) {
@@ -107,6 +127,14 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 
 	req.Header.Set("Accept", "application/json")
 
+	if accept := models.AcceptFromContext(ctx); accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	if requestID := models.RequestIDFromContext(ctx); requestID != "" {
+		req.Header.Set("X-Request-Id", requestID)
+	}
+
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
@@ -121,17 +149,57 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 		req.SetBasicAuth(c.Auth.GetBasicAuth())
 	}
 
+	userAgent := transport.DefaultUserAgent
 	if c.Auth.HasUserAgent() {
-		req.Header.Set("User-Agent", c.Auth.GetUserAgent())
+		userAgent = c.Auth.GetUserAgent()
 	}
 
+	req.Header.Set("User-Agent", userAgent)
+
 	if c.Auth.GetBearerToken() != "" && !c.Auth.HasBasicAuth() {
 		req.Header.Add("Authorization", fmt.Sprintf("Bearer %v", c.Auth.GetBearerToken()))
 	}
 
+	if provider := c.Auth.GetAuthProvider(); provider != nil {
+		if err = provider.Apply(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
 	return req, nil
 }
 
+// Close releases the resources held by the client, e.g. the background workers of the transport options.
+//
+// The HTTP client is closed if it implements io.Closer, the client must not be used after Close.
+func (c *Client) Close() error {
+
+	if closer, ok := c.HTTP.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
+// With returns a client sharing the site, the credentials and the HTTP transport of the client, decorated with the
+// additional transport options, e.g. a longer timeout or an extra header:
+//
+//	uploads, err := instance.With(transport.WithTimeout(5 * time.Minute))
+//
+// The HTTP client isn't rebuilt, the options are applied on a shallow copy of the transport decorator, so the
+// connections are reused. Closing the returned client doesn't close the HTTP client of the original one.
+func (c *Client) With(options ...transport.Option) (*Client, error) {
+
+	derived, err := NewWithConfig(&transport.Config{Site: c.Site.String(), HTTPClient: transport.Derive(c.HTTP, options...)})
+	if err != nil {
+		return nil, err
+	}
+
+	derived.Site, derived.Auth = c.Site, c.Auth
+
+	return derived, nil
+}
+
 func (c *Client) Call(request *http.Request, structure interface{}) (*models.ResponseScheme, error) {
 
 	response, err := c.HTTP.Do(request)
@@ -139,7 +207,13 @@ func (c *Client) Call(request *http.Request, structure interface{}) (*models.Res
 		return nil, err
 	}
 
-	return c.processResponse(response, structure)
+	res, err := c.processResponse(response, structure)
+	if err != nil {
+		// The Atlassian request ID is appended, so it can be quoted to the Atlassian support.
+		return res, models.NewRequestError(res, err)
+	}
+
+	return res, nil
 }
 
 func (c *Client) processResponse(response *http.Response, structure interface{}) (*models.ResponseScheme, error) {
@@ -172,6 +246,12 @@ func (c *Client) processResponse(response *http.Response, structure interface{})
 		case http.StatusUnauthorized:
 			return res, models.ErrUnauthorized
 
+		case http.StatusForbidden:
+			return res, models.ErrForbidden
+
+		case http.StatusConflict:
+			return res, models.ErrConflict
+
 		case http.StatusInternalServerError:
 			return res, models.ErrInternal
 
@@ -183,7 +263,7 @@ func (c *Client) processResponse(response *http.Response, structure interface{})
 		}
 	}
 
-	if structure != nil {
+	if structure != nil && models.AcceptsJSON(response.Request.Header.Get("Accept")) {
 		if err = json.Unmarshal(responseAsBytes, &structure); err != nil {
 			return res, err
 		}
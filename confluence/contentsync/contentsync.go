@@ -0,0 +1,229 @@
+// Package contentsync computes the incremental changes of a Confluence space, so the contents can be mirrored
+// on another system without fetching the whole space on every run.
+//
+// The version of every content synchronized is persisted on a StateStore. On every run, the contents modified
+// since the last run are searched with the CQL lastmodified field, and the IDs of the space are compared with
+// the persisted ones to detect the deletions, as the deleted contents are no longer returned by the searches:
+//
+//	syncer := contentsync.New(instance.Search, contentsync.NewMemoryStore())
+//
+//	plan, err := syncer.Plan(ctx, "DUMMY")
+//	if err != nil {
+//		return err
+//	}
+//
+//	// Apply the plan.Created, plan.Updated and plan.Deleted changes on the mirror.
+//
+//	err = syncer.Commit(ctx, "DUMMY", plan)
+package contentsync
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"sync"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+)
+
+const (
+	// pageSize is the number of search results requested per page.
+	pageSize = 100
+
+	// lastModifiedMargin widens the lastmodified search, as the CQL dates have a minute precision and they're
+	// evaluated on the time zone of the user. The contents found with the persisted version are skipped.
+	lastModifiedMargin = 24 * time.Hour
+
+	// lastModifiedLayout is the CQL date format.
+	lastModifiedLayout = "2006/01/02 15:04"
+)
+
+// StateStore persists the synchronization state of the spaces.
+type StateStore interface {
+
+	// Load returns the state of the space, a nil state is returned if the space was never synchronized.
+	Load(ctx context.Context, spaceKey string) (*model.SyncStateScheme, error)
+
+	// Save persists the state of the space.
+	Save(ctx context.Context, spaceKey string, state *model.SyncStateScheme) error
+}
+
+// New creates a new Syncer searching the contents with the search service and persisting the state on the store.
+func New(search confluence.SearchConnector, store StateStore) *Syncer {
+	return &Syncer{search: search, store: store, now: time.Now}
+}
+
+// Syncer computes the incremental changes of the spaces.
+type Syncer struct {
+	search confluence.SearchConnector
+	store  StateStore
+	now    func() time.Time
+}
+
+// Plan returns the changes of the pages and blog posts of the space since the last synchronization.
+//
+// Every content of the space is reported as created on the first synchronization. The restored contents are
+// reported as created without version when they weren't modified since the last synchronization.
+func (s *Syncer) Plan(ctx context.Context, spaceKey string) (*model.SyncPlan, error) {
+
+	if spaceKey == "" {
+		return nil, model.ErrNoSpaceKey
+	}
+
+	previous, err := s.store.Load(ctx, spaceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if previous == nil {
+		previous = &model.SyncStateScheme{}
+	}
+
+	startedAt := s.now()
+	space := fmt.Sprintf("space = %q AND type IN (page, blogpost)", spaceKey)
+
+	plan := &model.SyncPlan{
+		State: &model.SyncStateScheme{Versions: make(map[string]int), SyncedAt: startedAt},
+	}
+
+	modified := space
+	if !previous.SyncedAt.IsZero() {
+		since := previous.SyncedAt.Add(-lastModifiedMargin).UTC().Format(lastModifiedLayout)
+		modified = fmt.Sprintf("%v AND lastmodified >= %q", space, since)
+	}
+
+	changed := make(map[string]*model.ContentScheme)
+	err = s.walk(ctx, modified, []string{"content.version"}, func(content *model.ContentScheme) {
+		changed[content.ID] = content
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	current := make(map[string]bool)
+	err = s.walk(ctx, space, nil, func(content *model.ContentScheme) {
+		current[content.ID] = true
+
+		version, synced := previous.Versions[content.ID]
+
+		modified, ok := changed[content.ID]
+		if !ok {
+			// The content wasn't modified since the last synchronization.
+			plan.State.Versions[content.ID] = version
+
+			if !synced {
+				plan.Created = append(plan.Created, content)
+			}
+
+			return
+		}
+
+		number := 0
+		if modified.Version != nil {
+			number = modified.Version.Number
+		}
+
+		plan.State.Versions[content.ID] = number
+
+		switch {
+		case !synced:
+			plan.Created = append(plan.Created, modified)
+		case number != version:
+			plan.Updated = append(plan.Updated, modified)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for contentID := range previous.Versions {
+		if !current[contentID] {
+			plan.Deleted = append(plan.Deleted, contentID)
+		}
+	}
+
+	sort.Strings(plan.Deleted)
+
+	return plan, nil
+}
+
+// Commit persists the state of the plan, it must be called once the plan is applied.
+func (s *Syncer) Commit(ctx context.Context, spaceKey string, plan *model.SyncPlan) error {
+
+	if spaceKey == "" {
+		return model.ErrNoSpaceKey
+	}
+
+	if plan == nil || plan.State == nil {
+		return model.ErrNoSyncPlan
+	}
+
+	return s.store.Save(ctx, spaceKey, plan.State)
+}
+
+// walk visits every content found by the CQL query, following the search cursor.
+func (s *Syncer) walk(ctx context.Context, cql string, expand []string, visit func(content *model.ContentScheme)) error {
+
+	options := &model.SearchContentOptions{Limit: pageSize, Expand: expand}
+
+	for {
+
+		page, _, err := s.search.Content(ctx, cql, options)
+		if err != nil {
+			return err
+		}
+
+		for _, result := range page.Results {
+			if result.Content != nil && result.Content.ID != "" {
+				visit(result.Content)
+			}
+		}
+
+		if page.Links == nil || page.Links.Next == "" {
+			return nil
+		}
+
+		next, err := url.Parse(page.Links.Next)
+		if err != nil {
+			return err
+		}
+
+		options.Cursor = next.Query().Get("cursor")
+		if options.Cursor == "" {
+			return nil
+		}
+	}
+}
+
+// NewMemoryStore creates a new StateStore keeping the states in memory, e.g. for the tests or the long-running processes.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{states: make(map[string]*model.SyncStateScheme)}
+}
+
+// MemoryStore is a StateStore keeping the states in memory, it's safe for concurrent use.
+type MemoryStore struct {
+	mu     sync.Mutex
+	states map[string]*model.SyncStateScheme
+}
+
+// Load returns the state of the space.
+func (m *MemoryStore) Load(_ context.Context, spaceKey string) (*model.SyncStateScheme, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.states[spaceKey], nil
+}
+
+// Save stores the state of the space.
+func (m *MemoryStore) Save(_ context.Context, spaceKey string, state *model.SyncStateScheme) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.states[spaceKey] = state
+	return nil
+}
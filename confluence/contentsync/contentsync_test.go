@@ -0,0 +1,117 @@
+package contentsync
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ctreminiom/go-atlassian/v2/confluence/internal"
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/testutil"
+)
+
+func TestSyncer_Plan(t *testing.T) {
+
+	lastRun := time.Date(2024, 3, 10, 8, 30, 0, 0, time.UTC)
+	startedAt := time.Date(2024, 3, 11, 8, 30, 0, 0, time.UTC)
+
+	store := NewMemoryStore()
+	assert.NoError(t, store.Save(context.Background(), "DUMMY", &model.SyncStateScheme{
+		Versions: map[string]int{"1": 3, "2": 5, "3": 1},
+		SyncedAt: lastRun,
+	}))
+
+	recorder := testutil.NewRecorder()
+
+	// The contents modified since the last run, the content 1 is found due to the search margin.
+	recorder.Respond(`{"results":[
+		{"content":{"id":"1","type":"page","version":{"number":3}}},
+		{"content":{"id":"2","type":"page","version":{"number":6}}},
+		{"content":{"id":"4","type":"blogpost","version":{"number":1}}}]}`)
+
+	// The contents of the space, the content 3 was deleted and the content 5 restored.
+	recorder.Respond(`{"results":[{"content":{"id":"1"}},{"content":{"id":"2"}}],
+		"_links":{"next":"/rest/api/search?cursor=raNDoMsTRiNg&next=true"}}`)
+	recorder.Respond(`{"results":[{"content":{"id":"4"}},{"content":{"id":"5","type":"page"}}]}`)
+
+	syncer := New(internal.NewSearchService(recorder), store)
+	syncer.now = func() time.Time { return startedAt }
+
+	plan, err := syncer.Plan(context.Background(), "DUMMY")
+	assert.NoError(t, err)
+
+	assert.Equal(t, []*model.ContentScheme{
+		{ID: "4", Type: "blogpost", Version: &model.ContentVersionScheme{Number: 1}},
+		{ID: "5", Type: "page"},
+	}, plan.Created)
+	assert.Equal(t, []*model.ContentScheme{{ID: "2", Type: "page", Version: &model.ContentVersionScheme{Number: 6}}}, plan.Updated)
+	assert.Equal(t, []string{"3"}, plan.Deleted)
+	assert.Equal(t, &model.SyncStateScheme{Versions: map[string]int{"1": 3, "2": 6, "4": 1, "5": 0}, SyncedAt: startedAt}, plan.State)
+
+	testutil.ExpectRequest(t, recorder, testutil.Request{
+		Method: http.MethodGet,
+		Path:   "wiki/rest/api/search",
+		Query: url.Values{
+			"cql":    {`space = "DUMMY" AND type IN (page, blogpost) AND lastmodified >= "2024/03/09 08:30"`},
+			"expand": {"content.version"},
+			"limit":  {"100"},
+		},
+	})
+
+	testutil.ExpectRequest(t, recorder, testutil.Request{
+		Method: http.MethodGet,
+		Path:   "wiki/rest/api/search",
+		Query:  url.Values{"cql": {`space = "DUMMY" AND type IN (page, blogpost)`}, "limit": {"100"}},
+	})
+
+	testutil.ExpectRequest(t, recorder, testutil.Request{
+		Method: http.MethodGet,
+		Path:   "wiki/rest/api/search",
+		Query:  url.Values{"cql": {`space = "DUMMY" AND type IN (page, blogpost)`}, "cursor": {"raNDoMsTRiNg"}, "limit": {"100"}},
+	})
+
+	assert.NoError(t, syncer.Commit(context.Background(), "DUMMY", plan))
+
+	state, err := store.Load(context.Background(), "DUMMY")
+	assert.NoError(t, err)
+	assert.Equal(t, plan.State, state)
+}
+
+func TestSyncer_Plan_FirstRun(t *testing.T) {
+
+	recorder := testutil.NewRecorder()
+	recorder.Respond(`{"results":[{"content":{"id":"1","version":{"number":2}}}]}`)
+	recorder.Respond(`{"results":[{"content":{"id":"1"}}]}`)
+
+	syncer := New(internal.NewSearchService(recorder), NewMemoryStore())
+
+	plan, err := syncer.Plan(context.Background(), "DUMMY")
+	assert.NoError(t, err)
+	assert.Equal(t, []*model.ContentScheme{{ID: "1", Version: &model.ContentVersionScheme{Number: 2}}}, plan.Created)
+	assert.Empty(t, plan.Updated)
+	assert.Empty(t, plan.Deleted)
+	assert.Equal(t, map[string]int{"1": 2}, plan.State.Versions)
+
+	testutil.ExpectRequest(t, recorder, testutil.Request{
+		Method: http.MethodGet,
+		Path:   "wiki/rest/api/search",
+		Query:  url.Values{"cql": {`space = "DUMMY" AND type IN (page, blogpost)`}, "expand": {"content.version"}, "limit": {"100"}},
+	})
+}
+
+func TestSyncer_Errors(t *testing.T) {
+
+	syncer := New(internal.NewSearchService(testutil.NewRecorder()), NewMemoryStore())
+
+	_, err := syncer.Plan(context.Background(), "")
+	assert.True(t, errors.Is(err, model.ErrNoSpaceKey))
+
+	assert.True(t, errors.Is(syncer.Commit(context.Background(), "DUMMY", nil), model.ErrNoSyncPlan))
+	assert.True(t, errors.Is(syncer.Commit(context.Background(), "", &model.SyncPlan{}), model.ErrNoSpaceKey))
+	assert.True(t, (*model.SyncPlan)(nil).Empty())
+}
@@ -67,6 +67,22 @@ func (a *ContentAttachmentService) Create(ctx context.Context, attachmentID, sta
 	return a.internalClient.Create(ctx, attachmentID, status, fileName, file)
 }
 
+// Upsert uploads an attachment to a piece of content, matching the existing attachments by the file name.
+//
+// A new version of the attachment is added if the content already has an attachment with exactly the file name,
+// otherwise the attachment is created. The result reports which of both happened.
+//
+// GET /wiki/rest/api/content/{id}/child/attachment
+//
+// POST /wiki/rest/api/content/{id}/child/attachment
+//
+// POST /wiki/rest/api/content/{id}/child/attachment/{attachmentID}/data
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/attachments#upsert-attachment
+func (a *ContentAttachmentService) Upsert(ctx context.Context, contentID, fileName string, file io.Reader) (*model.ContentAttachmentUpsertScheme, *model.ResponseScheme, error) {
+	return a.internalClient.Upsert(ctx, contentID, fileName, file)
+}
+
 type internalContentAttachmentImpl struct {
 	c service.Connector
 }
@@ -137,26 +153,7 @@ func (i *internalContentAttachmentImpl) CreateOrUpdate(ctx context.Context, atta
 		endpoint.WriteString(fmt.Sprintf("?%v", query.Encode()))
 	}
 
-	reader := &bytes.Buffer{}
-	writer := multipart.NewWriter(reader)
-
-	attachment, err := writer.CreateFormFile("file", fileName)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	_, err = io.Copy(attachment, file)
-	if err != nil {
-		return nil, nil, err
-	}
-
-	if err = writer.WriteField("minorEdit", "true"); err != nil {
-		return nil, nil, err
-	}
-
-	writer.Close()
-
-	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint.String(), writer.FormDataContentType(), reader)
+	request, err := i.newAttachmentRequest(ctx, http.MethodPut, endpoint.String(), fileName, file)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -194,35 +191,91 @@ func (i *internalContentAttachmentImpl) Create(ctx context.Context, attachmentID
 		endpoint.WriteString(fmt.Sprintf("?%v", query.Encode()))
 	}
 
-	reader := &bytes.Buffer{}
-	writer := multipart.NewWriter(reader)
-
-	attachment, err := writer.CreateFormFile("file", fileName)
+	request, err := i.newAttachmentRequest(ctx, http.MethodPost, endpoint.String(), fileName, file)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	_, err = io.Copy(attachment, file)
+	page := new(model.ContentPageScheme)
+	response, err := i.c.Call(request, page)
 	if err != nil {
-		return nil, nil, err
+		return nil, response, err
 	}
 
-	if err = writer.WriteField("minorEdit", "true"); err != nil {
-		return nil, nil, err
+	return page, response, nil
+}
+
+func (i *internalContentAttachmentImpl) Upsert(ctx context.Context, contentID, fileName string, file io.Reader) (*model.ContentAttachmentUpsertScheme, *model.ResponseScheme, error) {
+
+	if contentID == "" {
+		return nil, nil, model.ErrNoContentID
 	}
 
-	writer.Close()
+	if fileName == "" {
+		return nil, nil, model.ErrNoContentAttachmentName
+	}
+
+	if file == nil {
+		return nil, nil, model.ErrNoContentReader
+	}
+
+	existing, response, err := i.Gets(ctx, contentID, 0, 1, &model.GetContentAttachmentsOptionsScheme{FileName: fileName})
+	if err != nil {
+		return nil, response, err
+	}
+
+	if len(existing.Results) == 0 || existing.Results[0].Title != fileName {
 
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint.String(), writer.FormDataContentType(), reader)
+		page, response, err := i.Create(ctx, contentID, "", fileName, file)
+		if err != nil {
+			return nil, response, err
+		}
+
+		result := &model.ContentAttachmentUpsertScheme{Created: true}
+		if len(page.Results) != 0 {
+			result.Attachment = page.Results[0]
+		}
+
+		return result, response, nil
+	}
+
+	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/child/attachment/%v/data", contentID, existing.Results[0].ID)
+
+	request, err := i.newAttachmentRequest(ctx, http.MethodPost, endpoint, fileName, file)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	page := new(model.ContentPageScheme)
-	response, err := i.c.Call(request, page)
+	updated := new(model.ContentScheme)
+	response, err = i.c.Call(request, updated)
 	if err != nil {
 		return nil, response, err
 	}
 
-	return page, response, nil
+	return &model.ContentAttachmentUpsertScheme{Attachment: updated}, response, nil
+}
+
+// newAttachmentRequest builds the multipart request uploading the file, as a minor edit of the content.
+func (i *internalContentAttachmentImpl) newAttachmentRequest(ctx context.Context, method, endpoint, fileName string, file io.Reader) (*http.Request, error) {
+
+	reader := &bytes.Buffer{}
+	writer := multipart.NewWriter(reader)
+
+	attachment, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = io.Copy(attachment, file)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = writer.WriteField("minorEdit", "true"); err != nil {
+		return nil, err
+	}
+
+	writer.Close()
+
+	return i.c.NewRequest(ctx, method, endpoint, writer.FormDataContentType(), reader)
 }
@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -443,3 +444,257 @@ func Test_internalContentAttachmentImpl_Create(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalContentAttachmentImpl_Upsert(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx                 context.Context
+		contentID, fileName string
+		file                io.Reader
+	}
+
+	testCases := []struct {
+		name        string
+		fields      fields
+		args        args
+		on          func(*fields)
+		wantCreated bool
+		wantErr     bool
+		Err         error
+	}{
+		{
+			name: "when the attachment does not exist",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "3837272",
+				fileName:  "diagram.png",
+				file:      strings.NewReader("diagram"),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/3837272/child/attachment?filename=diagram.png&limit=1&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentPageScheme{}).
+					Return(&model.ResponseScheme{}, nil).
+					Once()
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/content/3837272/child/attachment",
+					mock.Anything,
+					mock.Anything).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentPageScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentPageScheme).Results = []*model.ContentScheme{{ID: "att10001"}}
+					}).
+					Return(&model.ResponseScheme{}, nil).
+					Once()
+
+				fields.c = client
+			},
+			wantCreated: true,
+		},
+
+		{
+			name: "when the attachment already exists",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "3837272",
+				fileName:  "diagram.png",
+				file:      strings.NewReader("diagram"),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/3837272/child/attachment?filename=diagram.png&limit=1&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentPageScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentPageScheme).Results = []*model.ContentScheme{{ID: "att10001", Title: "diagram.png"}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/content/3837272/child/attachment/att10001/data",
+					mock.Anything,
+					mock.Anything).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the listed attachment has another file name",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "3837272",
+				fileName:  "diagram.png",
+				file:      strings.NewReader("diagram"),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/3837272/child/attachment?filename=diagram.png&limit=1&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentPageScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentPageScheme).Results = []*model.ContentScheme{{ID: "att10001", Title: "diagram-old.png"}}
+					}).
+					Return(&model.ResponseScheme{}, nil).
+					Once()
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/content/3837272/child/attachment",
+					mock.Anything,
+					mock.Anything).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentPageScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentPageScheme).Results = []*model.ContentScheme{{ID: "att10002"}}
+					}).
+					Return(&model.ResponseScheme{}, nil).
+					Once()
+
+				fields.c = client
+			},
+			wantCreated: true,
+		},
+
+		{
+			name: "when the attachments cannot be listed",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "3837272",
+				fileName:  "diagram.png",
+				file:      strings.NewReader("diagram"),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/3837272/child/attachment?filename=diagram.png&limit=1&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name: "when the content id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentID,
+		},
+
+		{
+			name: "when the file name is not provided",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "3837272",
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentAttachmentName,
+		},
+
+		{
+			name: "when the file reader is not provided",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "3837272",
+				fileName:  "diagram.png",
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentReader,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			attachmentService := NewContentAttachmentService(testCase.fields.c)
+
+			gotResult, gotResponse, err := attachmentService.Upsert(testCase.args.ctx, testCase.args.contentID,
+				testCase.args.fileName, testCase.args.file)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.wantCreated, gotResult.Created)
+				assert.NotNil(t, gotResult.Attachment)
+			}
+
+		})
+	}
+}
@@ -1,8 +1,10 @@
 package internal
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -58,6 +60,31 @@ func (a *AttachmentService) Gets(ctx context.Context, entityID int, entityType s
 	return a.internalClient.Gets(ctx, entityID, entityType, options, cursor, limit)
 }
 
+// GetsByPage returns the attachments of a specific page.
+//
+// The number of results is limited by the limit parameter and additional results.
+//
+// (if available) will be available through the next URL present in the Link response header.
+//
+// GET /wiki/api/v2/pages/{id}/attachments
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/attachments#get-attachments-for-page
+func (a *AttachmentService) GetsByPage(ctx context.Context, pageID string, cursor string, limit int) (*model.AttachmentPageScheme, *model.ResponseScheme, error) {
+	return a.internalClient.GetsByPage(ctx, pageID, cursor, limit)
+}
+
+// Download returns the contents of an attachment, the caller must close the returned reader.
+//
+// The attachment download link is resolved first, the file contents are streamed when the client implements
+// service.BodyConnector, otherwise they're buffered in memory.
+//
+// GET /wiki/download/attachments/{pageID}/{fileName}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/attachments#download-attachment
+func (a *AttachmentService) Download(ctx context.Context, attachmentID string) (io.ReadCloser, *model.ResponseScheme, error) {
+	return a.internalClient.Download(ctx, attachmentID)
+}
+
 // Delete deletes an attachment by id.
 //
 // DELETE /wiki/api/v2/attachments/{id}
@@ -184,3 +211,62 @@ func (i *internalAttachmentImpl) Gets(ctx context.Context, entityID int, entityT
 
 	return page, response, nil
 }
+
+func (i *internalAttachmentImpl) GetsByPage(ctx context.Context, pageID string, cursor string, limit int) (*model.AttachmentPageScheme, *model.ResponseScheme, error) {
+
+	if pageID == "" {
+		return nil, nil, model.ErrNoPageID
+	}
+
+	query := url.Values{}
+	query.Add("limit", strconv.Itoa(limit))
+
+	if cursor != "" {
+		query.Add("cursor", cursor)
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/pages/%v/attachments?%v", pageID, query.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.AttachmentPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalAttachmentImpl) Download(ctx context.Context, attachmentID string) (io.ReadCloser, *model.ResponseScheme, error) {
+
+	attachment, response, err := i.Get(ctx, attachmentID, 0, false)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if attachment.DownloadLink == "" {
+		return nil, response, model.ErrNoAttachmentDownloadLink
+	}
+
+	endpoint := fmt.Sprintf("wiki/%v", strings.TrimPrefix(attachment.DownloadLink, "/"))
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if downloader, ok := i.c.(service.BodyConnector); ok {
+		return downloader.CallBody(request)
+	}
+
+	response, err = i.c.Call(request, nil)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return io.NopCloser(bytes.NewReader(response.Bytes.Bytes())), response, nil
+}
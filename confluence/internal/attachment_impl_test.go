@@ -1,13 +1,17 @@
 package internal
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"io"
 	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -380,3 +384,329 @@ func Test_internalAttachmentImpl_Delete(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalAttachmentImpl_GetsByPage(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx    context.Context
+		pageID string
+		cursor string
+		limit  int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:    context.Background(),
+				pageID: "200001",
+				cursor: "uuid-sample",
+				limit:  50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/pages/200001/attachments?cursor=uuid-sample&limit=50",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.AttachmentPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:    context.Background(),
+				pageID: "200001",
+				cursor: "uuid-sample",
+				limit:  50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/pages/200001/attachments?cursor=uuid-sample&limit=50",
+					"", nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name: "when the page id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoPageID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			attachmentService := NewAttachmentService(testCase.fields.c, nil)
+
+			gotResult, gotResponse, err := attachmentService.GetsByPage(testCase.args.ctx, testCase.args.pageID, testCase.args.cursor,
+				testCase.args.limit)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+// bodyConnector is a connector returning the response body unread, as the Confluence clients do.
+type bodyConnector struct {
+	*mocks.Connector
+	body string
+}
+
+func (c *bodyConnector) CallBody(request *http.Request) (io.ReadCloser, *model.ResponseScheme, error) {
+	return io.NopCloser(strings.NewReader(c.body)), &model.ResponseScheme{}, nil
+}
+
+func Test_internalAttachmentImpl_Download(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx          context.Context
+		attachmentID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    string
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:          context.Background(),
+				attachmentID: "100001",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/attachments/100001",
+					"", nil).
+					Return(&http.Request{}, nil).
+					Once()
+
+				client.On("Call",
+					&http.Request{},
+					&model.AttachmentScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.AttachmentScheme).DownloadLink = "/download/attachments/200001/report.pdf?version=1"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/download/attachments/200001/report.pdf?version=1",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{Bytes: *bytes.NewBufferString("%PDF-1.7")}, nil)
+
+				fields.c = client
+
+			},
+			want: "%PDF-1.7",
+		},
+
+		{
+			name: "when the client streams the response body",
+			args: args{
+				ctx:          context.Background(),
+				attachmentID: "100001",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/attachments/100001",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.AttachmentScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.AttachmentScheme).DownloadLink = "/download/attachments/200001/report.pdf?version=1"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/download/attachments/200001/report.pdf?version=1",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				fields.c = &bodyConnector{Connector: client, body: "%PDF-1.7"}
+
+			},
+			want: "%PDF-1.7",
+		},
+
+		{
+			name: "when the attachment does not contain a download link",
+			args: args{
+				ctx:          context.Background(),
+				attachmentID: "100001",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/attachments/100001",
+					"", nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.AttachmentScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+
+			},
+			wantErr: true,
+			Err:     model.ErrNoAttachmentDownloadLink,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:          context.Background(),
+				attachmentID: "100001",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/api/v2/attachments/100001",
+					"", nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name: "when the attachment id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentAttachmentID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			attachmentService := NewAttachmentService(testCase.fields.c, nil)
+
+			gotBody, gotResponse, err := attachmentService.Download(testCase.args.ctx, testCase.args.attachmentID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+
+				body, err := io.ReadAll(gotBody)
+				assert.NoError(t, err)
+				assert.NoError(t, gotBody.Close())
+				assert.Equal(t, testCase.want, string(body))
+			}
+
+		})
+	}
+}
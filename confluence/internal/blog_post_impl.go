@@ -0,0 +1,242 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// NewBlogPostService creates a new instance of BlogPostService.
+// It takes a service.Connector as input and returns a pointer to BlogPostService.
+func NewBlogPostService(client service.Connector) *BlogPostService {
+	return &BlogPostService{internalClient: &internalBlogPostImpl{c: client}}
+}
+
+// BlogPostService provides methods to interact with blog post operations in Confluence.
+type BlogPostService struct {
+	// internalClient is the connector interface for blog post operations.
+	internalClient confluence.BlogPostConnector
+}
+
+// Gets returns all blog posts that fit the filtering criteria.
+//
+// GET /wiki/api/v2/blogposts
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/blog-post#get-blog-posts
+func (b *BlogPostService) Gets(ctx context.Context, options *model.BlogPostOptionsScheme, cursor string, limit int) (*model.BlogPostChunkScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Gets(ctx, options, cursor, limit)
+}
+
+// Get returns a specific blog post.
+//
+// GET /wiki/api/v2/blogposts/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/blog-post#get-blog-post-by-id
+func (b *BlogPostService) Get(ctx context.Context, blogPostID int, format string, draft bool, version int) (*model.BlogPostScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Get(ctx, blogPostID, format, draft, version)
+}
+
+// Create creates a blog post in the space.
+//
+// Blog posts are created as published by default unless specified as a draft in the status field.
+//
+// If creating a published blog post, the title must be specified.
+//
+// POST /wiki/api/v2/blogposts
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/blog-post#create-blog-post
+func (b *BlogPostService) Create(ctx context.Context, payload *model.BlogPostCreatePayloadScheme) (*model.BlogPostScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Create(ctx, payload)
+}
+
+// Update updates a blog post by id.
+//
+// PUT /wiki/api/v2/blogposts/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/blog-post#update-blog-post
+func (b *BlogPostService) Update(ctx context.Context, blogPostID int, payload *model.BlogPostUpdatePayloadScheme) (*model.BlogPostScheme, *model.ResponseScheme, error) {
+	return b.internalClient.Update(ctx, blogPostID, payload)
+}
+
+// Delete deletes a blog post by id.
+//
+// DELETE /wiki/api/v2/blogposts/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/blog-post#delete-blog-post
+func (b *BlogPostService) Delete(ctx context.Context, blogPostID int) (*model.ResponseScheme, error) {
+	return b.internalClient.Delete(ctx, blogPostID)
+}
+
+type internalBlogPostImpl struct {
+	c service.Connector
+}
+
+func (i *internalBlogPostImpl) Gets(ctx context.Context, options *model.BlogPostOptionsScheme, cursor string, limit int) (*model.BlogPostChunkScheme, *model.ResponseScheme, error) {
+
+	query := url.Values{}
+	query.Add("limit", strconv.Itoa(limit))
+
+	if cursor != "" {
+		query.Add("cursor", cursor)
+	}
+
+	if options != nil {
+
+		if options.Title != "" {
+			query.Add("title", options.Title)
+		}
+
+		if options.Sort != "" {
+			query.Add("sort", options.Sort)
+		}
+
+		if options.BodyFormat != "" {
+			query.Add("body-format", options.BodyFormat)
+		}
+
+		if options.Status != nil {
+			query.Add("status", strings.Join(options.Status, ","))
+		}
+
+		if len(options.BlogPostIDs) > 0 {
+
+			var blogPostIDs = make([]string, 0, len(options.BlogPostIDs))
+			for _, blogPostIDAsInt := range options.BlogPostIDs {
+				blogPostIDs = append(blogPostIDs, strconv.Itoa(blogPostIDAsInt))
+			}
+
+			query.Add("id", strings.Join(blogPostIDs, ","))
+		}
+
+		if len(options.SpaceIDs) > 0 {
+
+			var spaceIDs = make([]string, 0, len(options.SpaceIDs))
+			for _, spaceIDAsInt := range options.SpaceIDs {
+				spaceIDs = append(spaceIDs, strconv.Itoa(spaceIDAsInt))
+			}
+
+			query.Add("space-id", strings.Join(spaceIDs, ","))
+		}
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/blogposts?%v", query.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chunk := new(model.BlogPostChunkScheme)
+	response, err := i.c.Call(request, chunk)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return chunk, response, nil
+}
+
+func (i *internalBlogPostImpl) Get(ctx context.Context, blogPostID int, format string, draft bool, version int) (*model.BlogPostScheme, *model.ResponseScheme, error) {
+
+	if blogPostID == 0 {
+		return nil, nil, model.ErrNoBlogPostID
+	}
+
+	query := url.Values{}
+
+	if format != "" {
+		query.Add("body-format", format)
+	}
+
+	if draft {
+		query.Add("get-draft", "true")
+	}
+
+	if version != 0 {
+		query.Add("version", strconv.Itoa(version))
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/blogposts/%v?%v", blogPostID, query.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blogPost := new(model.BlogPostScheme)
+	response, err := i.c.Call(request, blogPost)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return blogPost, response, nil
+}
+
+func (i *internalBlogPostImpl) Create(ctx context.Context, payload *model.BlogPostCreatePayloadScheme) (*model.BlogPostScheme, *model.ResponseScheme, error) {
+
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
+	endpoint := "wiki/api/v2/blogposts"
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blogPost := new(model.BlogPostScheme)
+	response, err := i.c.Call(request, blogPost)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return blogPost, response, nil
+}
+
+func (i *internalBlogPostImpl) Update(ctx context.Context, blogPostID int, payload *model.BlogPostUpdatePayloadScheme) (*model.BlogPostScheme, *model.ResponseScheme, error) {
+
+	if blogPostID == 0 {
+		return nil, nil, model.ErrNoBlogPostID
+	}
+
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/blogposts/%v", blogPostID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	blogPost := new(model.BlogPostScheme)
+	response, err := i.c.Call(request, blogPost)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return blogPost, response, nil
+}
+
+func (i *internalBlogPostImpl) Delete(ctx context.Context, blogPostID int) (*model.ResponseScheme, error) {
+
+	if blogPostID == 0 {
+		return nil, model.ErrNoBlogPostID
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/blogposts/%v", blogPostID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
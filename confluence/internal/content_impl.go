@@ -3,11 +3,14 @@ package internal
 import (
 	"context"
 	"fmt"
+	"github.com/ctreminiom/go-atlassian/v2/confluence/storage"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
 	"net/http"
 	"net/url"
+	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -30,6 +33,10 @@ type ContentSubServices struct {
 	Restriction *RestrictionService
 	// Version is the service for content version operations.
 	Version *VersionService
+	// State is the service for content state operations.
+	State *ContentStateService
+	// Watch is the service for content watcher operations.
+	Watch *ContentWatchService
 }
 
 // NewContentService creates a new instance of ContentService.
@@ -45,6 +52,8 @@ func NewContentService(client service.Connector, subServices *ContentSubServices
 		Property:           subServices.Property,
 		Restriction:        subServices.Restriction,
 		Version:            subServices.Version,
+		State:              subServices.State,
+		Watch:              subServices.Watch,
 	}
 }
 
@@ -68,6 +77,10 @@ type ContentService struct {
 	Restriction *RestrictionService
 	// Version is the service for content version operations.
 	Version *VersionService
+	// State is the service for content state operations.
+	State *ContentStateService
+	// Watch is the service for content watcher operations.
+	Watch *ContentWatchService
 }
 
 // Gets returns all content in a Confluence instance.
@@ -107,6 +120,8 @@ func (c *ContentService) Search(ctx context.Context, cql, cqlContext string, exp
 //
 // By default, the following objects are expanded: space, history, version.
 //
+// The expansions are validated against model.ContentExpands, wrap the unlisted ones with model.RawExpand.
+//
 // GET /wiki/rest/api/content/{id}
 //
 // https://docs.go-atlassian.io/confluence-cloud/content#get-content
@@ -166,6 +181,137 @@ func (c *ContentService) Archive(ctx context.Context, payload *model.ContentArch
 	return c.internalClient.Archive(ctx, payload)
 }
 
+// HasAnonymousAccess returns whether anonymous users can view a piece of content.
+//
+// The content is not visible when itself or any of its ancestors has read restrictions,
+// otherwise the access is inherited from the anonymous permissions of the space.
+//
+// GET /wiki/rest/api/content/{id}?expand=space,ancestors
+//
+// https://docs.go-atlassian.io/confluence-cloud/content#get-content-anonymous-access
+func (c *ContentService) HasAnonymousAccess(ctx context.Context, contentID string) (*model.AnonymousAccessScheme, *model.ResponseScheme, error) {
+	return c.internalClient.HasAnonymousAccess(ctx, contentID)
+}
+
+// SearchByProperty returns all the content where the content property matches the given value.
+//
+// The CQL content.property[propertyKey] = "propertyValue" is built and the search pages are walked using the cursor,
+// if the spaceKey is provided, the search is restricted to that space.
+//
+// GET /wiki/rest/api/content/search
+//
+// https://docs.go-atlassian.io/confluence-cloud/content#search-contents-by-property
+func (c *ContentService) SearchByProperty(ctx context.Context, spaceKey, propertyKey, propertyValue string) ([]*model.ContentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.SearchByProperty(ctx, spaceKey, propertyKey, propertyValue)
+}
+
+// SetParent moves a piece of content under a new parent page.
+//
+// The current version of the content is fetched and a minimal update with the new ancestor and the next version number is sent.
+// The parent must belong to the same space, otherwise model.ErrParentSpaceMismatch is returned.
+//
+// PUT /wiki/rest/api/content/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content#set-content-parent
+func (c *ContentService) SetParent(ctx context.Context, contentID, newParentID string) (*model.ContentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.SetParent(ctx, contentID, newParentID)
+}
+
+// GetMany returns the contents with the provided IDs, such as pages or blog posts.
+//
+// The contents are fetched in batches using the CQL id in (...) query and the search pages are walked using the cursor,
+// the batches are executed one after another to avoid hitting the rate limits.
+//
+// The contents are returned in the same order as the IDs, the IDs not found or failed are nil
+// and their errors are returned on the result keyed by the ID.
+//
+// GET /wiki/rest/api/content/search
+//
+// https://docs.go-atlassian.io/confluence-cloud/content#get-many-contents
+func (c *ContentService) GetMany(ctx context.Context, ids []string, expand []string) (*model.ContentBulkResultScheme, *model.ResponseScheme, error) {
+	return c.internalClient.GetMany(ctx, ids, expand)
+}
+
+// GetBySpace returns a page of the contents of a type in a space, such as the pages or the blog posts.
+//
+// The content type must be page or blogpost, and the status current, trashed, draft, historical or any.
+// An empty status returns the current contents.
+//
+// GET /wiki/rest/api/content
+//
+// https://docs.go-atlassian.io/confluence-cloud/content#get-content-by-space
+func (c *ContentService) GetBySpace(ctx context.Context, spaceKey, contentType, status string, expand []string, startAt, maxResults int) (*model.ContentPageScheme, *model.ResponseScheme, error) {
+	return c.internalClient.GetBySpace(ctx, spaceKey, contentType, status, expand, startAt, maxResults)
+}
+
+// FindReplace replaces a text on the storage body of every page of a space, creating a new version of every page changed.
+//
+// The pages are searched using the CQL text match, and the pages not containing the text on the storage body are skipped.
+// The text is only replaced on the text nodes of the storage format, the markup and the macro parameters are untouched,
+// see storage.ReplaceText. Both texts are provided as displayed, e.g. R&D, they're escaped on the storage body.
+//
+// The dry run returns the replacements planned without updating the pages.
+// The pages failed don't stop the replacement, their errors are returned on the result keyed by the content ID.
+//
+// GET /wiki/rest/api/content/search
+//
+// PUT /wiki/rest/api/content/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content#find-and-replace
+func (c *ContentService) FindReplace(ctx context.Context, spaceKey, find, replace string, dryRun bool) (*model.ContentFindReplaceResultScheme, *model.ResponseScheme, error) {
+	return c.internalClient.FindReplace(ctx, spaceKey, find, replace, dryRun)
+}
+
+// GetByTitle returns the content of the type, page or blogpost, with the title in the space.
+//
+// It returns model.ErrContentNotFound if no content has the title. If several contents share the title,
+// the first match is returned along with a *model.ContentDuplicateTitleError carrying every match.
+//
+// GET /wiki/rest/api/content
+//
+// https://docs.go-atlassian.io/confluence-cloud/content#get-content-by-title
+func (c *ContentService) GetByTitle(ctx context.Context, spaceKey, title, contentType string, expand []string) (*model.ContentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.GetByTitle(ctx, spaceKey, title, contentType, expand)
+}
+
+// Purge permanently deletes a trashed content, the content must be trashed before it can be purged.
+//
+// It returns model.ErrContentNotTrashed if the content is not in the trash.
+//
+// GET /wiki/rest/api/content/{id}
+//
+// DELETE /wiki/rest/api/content/{id}?status=trashed
+//
+// https://docs.go-atlassian.io/confluence-cloud/content#purge-content
+func (c *ContentService) Purge(ctx context.Context, contentID string) (*model.ResponseScheme, error) {
+	return c.internalClient.Purge(ctx, contentID)
+}
+
+// PurgeSpace permanently deletes the trashed contents of a type, page or blogpost, in a space.
+//
+// The trashed contents are listed before being purged one after another, the contents failed don't stop the purge,
+// their errors are returned on the result keyed by the content ID.
+//
+// GET /wiki/rest/api/content
+//
+// DELETE /wiki/rest/api/content/{id}?status=trashed
+//
+// https://docs.go-atlassian.io/confluence-cloud/content#purge-space-content
+func (c *ContentService) PurgeSpace(ctx context.Context, spaceKey, contentType string) (*model.ContentPurgeResultScheme, *model.ResponseScheme, error) {
+	return c.internalClient.PurgeSpace(ctx, spaceKey, contentType)
+}
+
+// Tasks returns the inline tasks of the current version of a piece of content, in document order.
+//
+// The storage body of the content is fetched and parsed with storage.Tasks, use Completed to filter the open tasks.
+//
+// GET /wiki/rest/api/content/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content#get-content-tasks
+func (c *ContentService) Tasks(ctx context.Context, contentID string) ([]model.InlineTaskScheme, *model.ResponseScheme, error) {
+	return c.internalClient.Tasks(ctx, contentID)
+}
+
 type internalContentImpl struct {
 	c service.Connector
 }
@@ -206,8 +352,13 @@ func (i *internalContentImpl) Gets(ctx context.Context, options *model.GetConten
 			query.Add("status", strings.Join(options.Status, ","))
 		}
 
-		if len(options.Expand) != 0 {
-			query.Add("expand", strings.Join(options.Expand, ","))
+		expand, err := model.ValidateExpand(options.Expand, model.ContentExpands)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(expand) != 0 {
+			query.Add("expand", strings.Join(expand, ","))
 		}
 
 	}
@@ -230,6 +381,10 @@ func (i *internalContentImpl) Gets(ctx context.Context, options *model.GetConten
 
 func (i *internalContentImpl) Create(ctx context.Context, payload *model.ContentScheme) (*model.ContentScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := "wiki/rest/api/content"
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -252,6 +407,11 @@ func (i *internalContentImpl) Search(ctx context.Context, cql, cqlContext string
 		return nil, nil, model.ErrNoCQL
 	}
 
+	expand, err := model.ValidateExpand(expand, model.ContentExpands)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	query := url.Values{}
 	query.Add("limit", strconv.Itoa(maxResults))
 	query.Add("cql", cql)
@@ -290,6 +450,11 @@ func (i *internalContentImpl) Get(ctx context.Context, contentID string, expand
 		return nil, nil, model.ErrNoContentID
 	}
 
+	expand, err := model.ValidateExpand(expand, model.ContentExpands)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	query := url.Values{}
 	query.Add("version", strconv.Itoa(version))
 
@@ -319,6 +484,10 @@ func (i *internalContentImpl) Update(ctx context.Context, contentID string, payl
 		return nil, nil, model.ErrNoContentID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v", contentID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -365,6 +534,11 @@ func (i *internalContentImpl) History(ctx context.Context, contentID string, exp
 		return nil, nil, model.ErrNoContentID
 	}
 
+	expand, err := model.ValidateExpand(expand, model.ContentHistoryExpands)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	var endpoint strings.Builder
 	endpoint.WriteString(fmt.Sprintf("wiki/rest/api/content/%v/history", contentID))
 
@@ -406,3 +580,528 @@ func (i *internalContentImpl) Archive(ctx context.Context, payload *model.Conten
 
 	return result, response, nil
 }
+
+func (i *internalContentImpl) HasAnonymousAccess(ctx context.Context, contentID string) (*model.AnonymousAccessScheme, *model.ResponseScheme, error) {
+
+	if contentID == "" {
+		return nil, nil, model.ErrNoContentID
+	}
+
+	endpoint := fmt.Sprintf("wiki/rest/api/content/%v?expand=space,ancestors", contentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content := new(model.ContentScheme)
+	response, err := i.c.Call(request, content)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if content.Space == nil || content.Space.Key == "" {
+		return nil, response, model.ErrNoSpaceKey
+	}
+
+	// Read restrictions are inherited from the ancestors, so the whole hierarchy must be verified.
+	contentIDs := []string{contentID}
+	for _, ancestor := range content.Ancestors {
+		contentIDs = append(contentIDs, ancestor.ID)
+	}
+
+	operation := &internalRestrictionOperationImpl{c: i.c}
+	for _, id := range contentIDs {
+
+		restriction, response, err := operation.Get(ctx, id, "read", []string{"restrictions.user", "restrictions.group"}, 0, 1)
+		if err != nil {
+			return nil, response, err
+		}
+
+		if isContentRestricted(restriction) {
+			return &model.AnonymousAccessScheme{
+				Granted: false,
+				Level:   model.AnonymousAccessContentLevel,
+				Reason:  fmt.Sprintf("the content %v restricts the read operation to specific users or groups", id),
+			}, response, nil
+		}
+	}
+
+	space := &internalSpaceImpl{c: i.c}
+	return space.AnonymousAccess(ctx, content.Space.Key)
+}
+
+func isContentRestricted(restriction *model.ContentRestrictionScheme) bool {
+
+	if restriction == nil || restriction.Restrictions == nil {
+		return false
+	}
+
+	if user := restriction.Restrictions.User; user != nil && (user.Size != 0 || len(user.Results) != 0) {
+		return true
+	}
+
+	if group := restriction.Restrictions.Group; group != nil && (group.Size != 0 || len(group.Results) != 0) {
+		return true
+	}
+
+	return false
+}
+
+func (i *internalContentImpl) SearchByProperty(ctx context.Context, spaceKey, propertyKey, propertyValue string) ([]*model.ContentScheme, *model.ResponseScheme, error) {
+
+	if propertyKey == "" {
+		return nil, nil, model.ErrNoContentProperty
+	}
+
+	if !contentPropertyKeyPattern.MatchString(propertyKey) {
+		return nil, nil, model.ErrInvalidContentProperty
+	}
+
+	if propertyValue == "" {
+		return nil, nil, model.ErrNoContentPropertyValue
+	}
+
+	cql := fmt.Sprintf("content.property[%v] = %v", propertyKey, quoteCQL(propertyValue))
+	if spaceKey != "" {
+		cql = fmt.Sprintf("space = %v and %v", quoteCQL(spaceKey), cql)
+	}
+
+	return i.searchAll(ctx, cql, nil)
+}
+
+// searchAll returns every content matching the CQL query, walking the search pages using the cursor.
+func (i *internalContentImpl) searchAll(ctx context.Context, cql string, expand []string) ([]*model.ContentScheme, *model.ResponseScheme, error) {
+
+	var (
+		contents []*model.ContentScheme
+		cursor   string
+	)
+
+	for {
+
+		page, response, err := i.Search(ctx, cql, "", expand, cursor, contentSearchPageSize)
+		if err != nil {
+			return nil, response, err
+		}
+
+		contents = append(contents, page.Results...)
+
+		if page.Links == nil || page.Links.Next == "" {
+			return contents, response, nil
+		}
+
+		next, err := url.Parse(page.Links.Next)
+		if err != nil {
+			return nil, response, err
+		}
+
+		cursor = next.Query().Get("cursor")
+		if cursor == "" {
+			return contents, response, nil
+		}
+	}
+}
+
+// contentSearchPageSize is the number of contents requested on every search page.
+const contentSearchPageSize = 50
+
+// contentStatuses are the statuses supported by the content filtering.
+var contentStatuses = []string{"current", "trashed", "draft", "historical", "any"}
+
+// contentPropertyKeyPattern matches the content property keys which can be safely embedded on a CQL query.
+var contentPropertyKeyPattern = regexp.MustCompile(`^[a-zA-Z0-9_.\-]+$`)
+
+// quoteCQL quotes a CQL value, escaping the backslashes and the double quotes.
+func quoteCQL(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+func (i *internalContentImpl) SetParent(ctx context.Context, contentID, newParentID string) (*model.ContentScheme, *model.ResponseScheme, error) {
+
+	if contentID == "" {
+		return nil, nil, model.ErrNoContentID
+	}
+
+	if newParentID == "" {
+		return nil, nil, model.ErrNoParentContentID
+	}
+
+	content, response, err := i.getWithExpand(ctx, contentID, "space,version")
+	if err != nil {
+		return nil, response, err
+	}
+
+	parent, response, err := i.getWithExpand(ctx, newParentID, "space")
+	if err != nil {
+		return nil, response, err
+	}
+
+	if content.Space == nil || parent.Space == nil || content.Space.Key != parent.Space.Key {
+		return nil, response, model.ErrParentSpaceMismatch
+	}
+
+	var versionNumber int
+	if content.Version != nil {
+		versionNumber = content.Version.Number
+	}
+
+	payload := &model.ContentScheme{
+		ID:        contentID,
+		Type:      content.Type,
+		Title:     content.Title,
+		Version:   &model.ContentVersionScheme{Number: versionNumber + 1},
+		Ancestors: []*model.ContentScheme{{ID: newParentID}},
+	}
+
+	return i.Update(ctx, contentID, payload)
+}
+
+func (i *internalContentImpl) getWithExpand(ctx context.Context, contentID, expand string) (*model.ContentScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("wiki/rest/api/content/%v?expand=%v", contentID, expand)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content := new(model.ContentScheme)
+	response, err := i.c.Call(request, content)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return content, response, nil
+}
+
+func (i *internalContentImpl) GetMany(ctx context.Context, ids []string, expand []string) (*model.ContentBulkResultScheme, *model.ResponseScheme, error) {
+
+	if len(ids) == 0 {
+		return nil, nil, model.ErrNoContentID
+	}
+
+	result := &model.ContentBulkResultScheme{
+		Contents: make([]*model.ContentScheme, len(ids)),
+		Errors:   make(map[string]error),
+	}
+
+	var batch []string
+	for _, id := range ids {
+
+		if _, err := strconv.Atoi(id); err != nil {
+			result.Errors[id] = fmt.Errorf("%w: %v", model.ErrInvalidContentID, id)
+			continue
+		}
+
+		if !slices.Contains(batch, id) {
+			batch = append(batch, id)
+		}
+	}
+
+	var (
+		found    = make(map[string]*model.ContentScheme)
+		response *model.ResponseScheme
+	)
+
+	for start := 0; start < len(batch); start += contentSearchPageSize {
+
+		chunk := batch[start:min(start+contentSearchPageSize, len(batch))]
+
+		var (
+			contents []*model.ContentScheme
+			err      error
+		)
+
+		contents, response, err = i.searchAll(ctx, fmt.Sprintf("id in (%v)", strings.Join(chunk, ",")), expand)
+		if err != nil {
+
+			// The batch errors don't stop the operation, they're reported on every ID of the batch.
+			for _, id := range chunk {
+				result.Errors[id] = err
+			}
+
+			continue
+		}
+
+		for _, content := range contents {
+			found[content.ID] = content
+		}
+	}
+
+	for index, id := range ids {
+
+		if _, failed := result.Errors[id]; failed {
+			continue
+		}
+
+		content, ok := found[id]
+		if !ok {
+			result.Errors[id] = fmt.Errorf("%w: %v", model.ErrNotFound, id)
+			continue
+		}
+
+		result.Contents[index] = content
+	}
+
+	return result, response, nil
+}
+
+func (i *internalContentImpl) GetBySpace(ctx context.Context, spaceKey, contentType, status string, expand []string, startAt, maxResults int) (*model.ContentPageScheme, *model.ResponseScheme, error) {
+
+	if spaceKey == "" {
+		return nil, nil, model.ErrNoSpaceKey
+	}
+
+	if contentType != "page" && contentType != "blogpost" {
+		return nil, nil, model.ErrInvalidContentType
+	}
+
+	options := &model.GetContentOptionsScheme{
+		ContextType: contentType,
+		SpaceKey:    spaceKey,
+		Expand:      expand,
+	}
+
+	if status != "" {
+
+		if !slices.Contains(contentStatuses, status) {
+			return nil, nil, model.ErrInvalidContentStatus
+		}
+
+		options.Status = []string{status}
+	}
+
+	return i.Gets(ctx, options, startAt, maxResults)
+}
+
+func (i *internalContentImpl) FindReplace(ctx context.Context, spaceKey, find, replace string, dryRun bool) (*model.ContentFindReplaceResultScheme, *model.ResponseScheme, error) {
+
+	if spaceKey == "" {
+		return nil, nil, model.ErrNoSpaceKey
+	}
+
+	if find == "" {
+		return nil, nil, model.ErrNoFindText
+	}
+
+	cql := fmt.Sprintf("space = %v and type = page and text ~ %v", quoteCQL(spaceKey), quoteCQL(find))
+
+	matches, response, err := i.searchAll(ctx, cql, nil)
+	if err != nil {
+		return nil, response, err
+	}
+
+	result := &model.ContentFindReplaceResultScheme{Errors: make(map[string]error)}
+
+	for _, match := range matches {
+
+		content, contentResponse, err := i.getWithExpand(ctx, match.ID, "body.storage,version")
+		if err != nil {
+			result.Errors[match.ID] = err
+			continue
+		}
+
+		response = contentResponse
+
+		if content.Body == nil || content.Body.Storage == nil {
+			result.Skipped = append(result.Skipped, match.ID)
+			continue
+		}
+
+		body, occurrences := storage.ReplaceText(content.Body.Storage.Value, find, replace)
+		if occurrences == 0 {
+			result.Skipped = append(result.Skipped, match.ID)
+			continue
+		}
+
+		change := &model.ContentReplacementScheme{
+			ContentID:   match.ID,
+			Title:       content.Title,
+			Occurrences: occurrences,
+		}
+
+		if dryRun {
+			result.Changes = append(result.Changes, change)
+			continue
+		}
+
+		var versionNumber int
+		if content.Version != nil {
+			versionNumber = content.Version.Number
+		}
+
+		payload := &model.ContentScheme{
+			ID:      match.ID,
+			Type:    content.Type,
+			Title:   content.Title,
+			Version: &model.ContentVersionScheme{Number: versionNumber + 1},
+			Body: &model.BodyScheme{
+				Storage: &model.BodyNodeScheme{
+					Value:          body,
+					Representation: "storage",
+				},
+			},
+		}
+
+		updated, updateResponse, err := i.Update(ctx, match.ID, payload)
+		if err != nil {
+			result.Errors[match.ID] = err
+			continue
+		}
+
+		response = updateResponse
+
+		change.Version = versionNumber + 1
+		if updated.Version != nil {
+			change.Version = updated.Version.Number
+		}
+
+		result.Changes = append(result.Changes, change)
+	}
+
+	return result, response, nil
+}
+
+// contentByTitlePageSize is the number of contents fetched per page by GetByTitle.
+const contentByTitlePageSize = 25
+
+func (i *internalContentImpl) GetByTitle(ctx context.Context, spaceKey, title, contentType string, expand []string) (*model.ContentScheme, *model.ResponseScheme, error) {
+
+	if spaceKey == "" {
+		return nil, nil, model.ErrNoSpaceKey
+	}
+
+	if title == "" {
+		return nil, nil, model.ErrNoContentTitle
+	}
+
+	if contentType != "page" && contentType != "blogpost" {
+		return nil, nil, model.ErrInvalidContentType
+	}
+
+	options := &model.GetContentOptionsScheme{
+		ContextType: contentType,
+		SpaceKey:    spaceKey,
+		Title:       title,
+		Expand:      expand,
+	}
+
+	var (
+		matches  []*model.ContentScheme
+		response *model.ResponseScheme
+	)
+
+	for startAt := 0; ; startAt += contentByTitlePageSize {
+
+		page, pageResponse, err := i.Gets(ctx, options, startAt, contentByTitlePageSize)
+		if err != nil {
+			return nil, pageResponse, err
+		}
+
+		response = pageResponse
+		matches = append(matches, page.Results...)
+
+		if len(page.Results) < contentByTitlePageSize {
+			break
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, response, model.ErrContentNotFound
+	case 1:
+		return matches[0], response, nil
+	default:
+		return matches[0], response, &model.ContentDuplicateTitleError{Title: title, Matches: matches}
+	}
+}
+
+func (i *internalContentImpl) Purge(ctx context.Context, contentID string) (*model.ResponseScheme, error) {
+
+	if contentID == "" {
+		return nil, model.ErrNoContentID
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, fmt.Sprintf("wiki/rest/api/content/%v?status=any", contentID), "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	content := new(model.ContentScheme)
+	response, err := i.c.Call(request, content)
+	if err != nil {
+		return response, err
+	}
+
+	if content.Status != "trashed" {
+		return response, fmt.Errorf("%w: %v", model.ErrContentNotTrashed, contentID)
+	}
+
+	return i.Delete(ctx, contentID, "trashed")
+}
+
+// contentPurgePageSize is the number of trashed contents fetched per page by PurgeSpace.
+const contentPurgePageSize = 50
+
+func (i *internalContentImpl) PurgeSpace(ctx context.Context, spaceKey, contentType string) (*model.ContentPurgeResultScheme, *model.ResponseScheme, error) {
+
+	var (
+		trashed  []string
+		response *model.ResponseScheme
+	)
+
+	// The trashed contents are listed before purging them, the pages would shift otherwise.
+	for startAt := 0; ; startAt += contentPurgePageSize {
+
+		page, pageResponse, err := i.GetBySpace(ctx, spaceKey, contentType, "trashed", nil, startAt, contentPurgePageSize)
+		if err != nil {
+			return nil, pageResponse, err
+		}
+
+		response = pageResponse
+
+		for _, content := range page.Results {
+			trashed = append(trashed, content.ID)
+		}
+
+		if len(page.Results) < contentPurgePageSize {
+			break
+		}
+	}
+
+	result := &model.ContentPurgeResultScheme{Total: len(trashed), Errors: make(map[string]error)}
+
+	for _, contentID := range trashed {
+
+		deleteResponse, err := i.Delete(ctx, contentID, "trashed")
+		if err != nil {
+			result.Errors[contentID] = err
+			continue
+		}
+
+		response = deleteResponse
+		result.Purged = append(result.Purged, contentID)
+	}
+
+	return result, response, nil
+}
+
+func (i *internalContentImpl) Tasks(ctx context.Context, contentID string) ([]model.InlineTaskScheme, *model.ResponseScheme, error) {
+
+	content, response, err := i.Get(ctx, contentID, []string{"body.storage"}, 0)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if content.Body == nil || content.Body.Storage == nil {
+		return nil, response, nil
+	}
+
+	tasks, err := storage.Tasks(content.Body.Storage.Value)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return tasks, response, nil
+}
@@ -3,10 +3,13 @@ package internal
 import (
 	"context"
 	"errors"
+	"fmt"
+	"github.com/ctreminiom/go-atlassian/v2/confluence/storage"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"testing"
 	"time"
@@ -306,6 +309,79 @@ func Test_internalContentImpl_Get(t *testing.T) {
 			},
 		},
 
+		{
+			name: "when the expand is not supported",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "11727271",
+				expand:    []string{"bodies.storage"},
+				version:   23,
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     fmt.Errorf("%w: %v", model.ErrInvalidExpand, "bodies.storage"),
+		},
+
+		{
+			name: "when the expand is nested",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "11727271",
+				expand:    []string{"version.by", "space.homepage", model.ContentExpandChildren},
+				version:   23,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/11727271?expand=version.by%2Cspace.homepage%2Cchildren&version=23",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the expand is raw",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "11727271",
+				expand:    []string{model.RawExpand("children.page.version")},
+				version:   23,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/11727271?expand=children.page.version&version=23",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
 		{
 			name: "when the http request cannot be created",
 			args: args{
@@ -397,7 +473,7 @@ func Test_internalContentImpl_History(t *testing.T) {
 			args: args{
 				ctx:       context.Background(),
 				contentID: "11727271",
-				expand:    []string{"restrictions.update.restrictions.user"},
+				expand:    []string{"lastUpdated"},
 			},
 			on: func(fields *fields) {
 
@@ -406,7 +482,7 @@ func Test_internalContentImpl_History(t *testing.T) {
 				client.On("NewRequest",
 					context.Background(),
 					http.MethodGet,
-					"wiki/rest/api/content/11727271/history?expand=restrictions.update.restrictions.user",
+					"wiki/rest/api/content/11727271/history?expand=lastUpdated",
 					"", nil).
 					Return(&http.Request{}, nil)
 
@@ -424,7 +500,7 @@ func Test_internalContentImpl_History(t *testing.T) {
 			args: args{
 				ctx:       context.Background(),
 				contentID: "11727271",
-				expand:    []string{"restrictions.update.restrictions.user"},
+				expand:    []string{"lastUpdated"},
 			},
 			on: func(fields *fields) {
 
@@ -433,7 +509,7 @@ func Test_internalContentImpl_History(t *testing.T) {
 				client.On("NewRequest",
 					context.Background(),
 					http.MethodGet,
-					"wiki/rest/api/content/11727271/history?expand=restrictions.update.restrictions.user",
+					"wiki/rest/api/content/11727271/history?expand=lastUpdated",
 					"", nil).
 					Return(&http.Request{}, errors.New("error, unable to create the http request"))
 
@@ -672,6 +748,18 @@ func Test_internalContentImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to create the http request"),
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -795,6 +883,19 @@ func Test_internalContentImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoContentID,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "100001",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -933,3 +1034,1497 @@ func Test_internalContentImpl_Archive(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalContentImpl_HasAnonymousAccess(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		contentID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the content inherits the space anonymous access",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "100",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100?expand=space,ancestors",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentScheme).Space = &model.SpaceScheme{Key: "DUMMY"}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100/restriction/byOperation/read?expand=restrictions.user%2Crestrictions.group&limit=1&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentRestrictionScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/space/DUMMY?expand=permissions",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SpaceScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the content has read restrictions",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "100",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100?expand=space,ancestors",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentScheme).Space = &model.SpaceScheme{Key: "DUMMY"}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100/restriction/byOperation/read?expand=restrictions.user%2Crestrictions.group&limit=1&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentRestrictionScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentRestrictionScheme).Restrictions = &model.ContentRestrictionDetailScheme{
+							User: &model.UserPermissionScheme{Size: 1},
+						}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the content space is not returned",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "100",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100?expand=space,ancestors",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrNoSpaceKey,
+		},
+
+		{
+			name: "when the content id is not provided",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentID,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "100",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100?expand=space,ancestors",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewContentService(testCase.fields.c, &ContentSubServices{})
+
+			gotResult, gotResponse, err := newService.HasAnonymousAccess(testCase.args.ctx, testCase.args.contentID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalContentImpl_SearchByProperty(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx           context.Context
+		spaceKey      string
+		propertyKey   string
+		propertyValue string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the search results are paginated",
+			args: args{
+				ctx:           context.Background(),
+				spaceKey:      "DUMMY",
+				propertyKey:   "review-state",
+				propertyValue: "approved",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/search?cql=space+%3D+%22DUMMY%22+and+content.property%5Breview-state%5D+%3D+%22approved%22&limit=50",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentPageScheme{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.ContentPageScheme)
+						page.Results = []*model.ContentScheme{{ID: "10001"}}
+						page.Links = &model.LinkScheme{Next: "/rest/api/content/search?cursor=raNDoMsTRiNg&limit=50"}
+					}).
+					Once().
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/search?cql=space+%3D+%22DUMMY%22+and+content.property%5Breview-state%5D+%3D+%22approved%22&cursor=raNDoMsTRiNg&limit=50",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentPageScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentPageScheme).Results = []*model.ContentScheme{{ID: "10002"}}
+					}).
+					Once().
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the property key is not provided",
+			args: args{
+				ctx:           context.Background(),
+				spaceKey:      "DUMMY",
+				propertyKey:   "",
+				propertyValue: "approved",
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentProperty,
+		},
+
+		{
+			name: "when the property key cannot be used on the cql",
+			args: args{
+				ctx:           context.Background(),
+				spaceKey:      "DUMMY",
+				propertyKey:   "review] = 1 or type",
+				propertyValue: "approved",
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidContentProperty,
+		},
+
+		{
+			name: "when the property value is not provided",
+			args: args{
+				ctx:           context.Background(),
+				spaceKey:      "DUMMY",
+				propertyKey:   "review-state",
+				propertyValue: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentPropertyValue,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:           context.Background(),
+				spaceKey:      "DUMMY",
+				propertyKey:   "review-state",
+				propertyValue: "approved",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/search?cql=space+%3D+%22DUMMY%22+and+content.property%5Breview-state%5D+%3D+%22approved%22&limit=50",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewContentService(testCase.fields.c, &ContentSubServices{})
+
+			gotResult, gotResponse, err := newService.SearchByProperty(testCase.args.ctx, testCase.args.spaceKey, testCase.args.propertyKey, testCase.args.propertyValue)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Len(t, gotResult, 2)
+			}
+
+		})
+	}
+}
+
+func Test_internalContentImpl_SetParent(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx         context.Context
+		contentID   string
+		newParentID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:         context.Background(),
+				contentID:   "100",
+				newParentID: "200",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100?expand=space,version",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Run(func(args mock.Arguments) {
+						content := args.Get(1).(*model.ContentScheme)
+						content.Type, content.Title = "page", "Release notes"
+						content.Space = &model.SpaceScheme{Key: "DUMMY"}
+						content.Version = &model.ContentVersionScheme{Number: 3}
+					}).
+					Once().
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/200?expand=space",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentScheme).Space = &model.SpaceScheme{Key: "DUMMY"}
+					}).
+					Once().
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"wiki/rest/api/content/100",
+					"",
+					&model.ContentScheme{
+						ID:        "100",
+						Type:      "page",
+						Title:     "Release notes",
+						Version:   &model.ContentVersionScheme{Number: 4},
+						Ancestors: []*model.ContentScheme{{ID: "200"}},
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Once().
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the parent belongs to a different space",
+			args: args{
+				ctx:         context.Background(),
+				contentID:   "100",
+				newParentID: "200",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100?expand=space,version",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Run(func(args mock.Arguments) {
+						content := args.Get(1).(*model.ContentScheme)
+						content.Type, content.Title = "page", "Release notes"
+						content.Space = &model.SpaceScheme{Key: "DUMMY"}
+						content.Version = &model.ContentVersionScheme{Number: 3}
+					}).
+					Once().
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/200?expand=space",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentScheme).Space = &model.SpaceScheme{Key: "OTHER"}
+					}).
+					Once().
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     model.ErrParentSpaceMismatch,
+		},
+
+		{
+			name: "when the content id is not provided",
+			args: args{
+				ctx:         context.Background(),
+				contentID:   "",
+				newParentID: "200",
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentID,
+		},
+
+		{
+			name: "when the parent id is not provided",
+			args: args{
+				ctx:         context.Background(),
+				contentID:   "100",
+				newParentID: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoParentContentID,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:         context.Background(),
+				contentID:   "100",
+				newParentID: "200",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100?expand=space,version",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewContentService(testCase.fields.c, &ContentSubServices{})
+
+			gotResult, gotResponse, err := newService.SetParent(testCase.args.ctx, testCase.args.contentID, testCase.args.newParentID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalContentImpl_GetMany(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx    context.Context
+		ids    []string
+		expand []string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    []string
+		errors  []string
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:    context.Background(),
+				ids:    []string{"200", "100", "DUMMY", "300"},
+				expand: []string{"version"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/search?cql=id+in+%28200%2C100%2C300%29&expand=version&limit=50",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentPageScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentPageScheme).Results = []*model.ContentScheme{{ID: "100"}, {ID: "200"}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want:   []string{"200", "100", "", ""},
+			errors: []string{"DUMMY", "300"},
+		},
+
+		{
+			name: "when the batch cannot be fetched",
+			args: args{
+				ctx:    context.Background(),
+				ids:    []string{"200", "100", "DUMMY", "300"},
+				expand: []string{"version"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/search?cql=id+in+%28200%2C100%2C300%29&expand=version&limit=50",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			want:   []string{"", "", "", ""},
+			errors: []string{"200", "100", "DUMMY", "300"},
+		},
+
+		{
+			name: "when the content ids are not provided",
+			args: args{
+				ctx:    context.Background(),
+				ids:    nil,
+				expand: []string{"version"},
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewContentService(testCase.fields.c, &ContentSubServices{})
+
+			gotResult, gotResponse, err := newService.GetMany(testCase.args.ctx, testCase.args.ids, testCase.args.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				var got []string
+				for _, content := range gotResult.Contents {
+					if content == nil {
+						got = append(got, "")
+						continue
+					}
+					got = append(got, content.ID)
+				}
+
+				assert.Equal(t, testCase.want, got)
+				assert.Len(t, gotResult.Errors, len(testCase.errors))
+
+				for _, id := range testCase.errors {
+					assert.Error(t, gotResult.Errors[id])
+				}
+			}
+
+		})
+	}
+}
+
+func Test_internalContentImpl_GetBySpace(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx         context.Context
+		spaceKey    string
+		contentType string
+		status      string
+		expand      []string
+		startAt     int
+		maxResults  int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:         context.Background(),
+				spaceKey:    "DUMMY",
+				contentType: "blogpost",
+				status:      "trashed",
+				expand:      []string{"version"},
+				startAt:     0,
+				maxResults:  25,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content?expand=version&limit=25&spaceKey=DUMMY&start=0&status=trashed&type=blogpost",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the status is not provided",
+			args: args{
+				ctx:         context.Background(),
+				spaceKey:    "DUMMY",
+				contentType: "blogpost",
+				status:      "",
+				expand:      nil,
+				startAt:     0,
+				maxResults:  25,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content?limit=25&spaceKey=DUMMY&start=0&type=blogpost",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the space key is not provided",
+			args: args{
+				ctx:         context.Background(),
+				spaceKey:    "",
+				contentType: "blogpost",
+				status:      "trashed",
+				expand:      []string{"version"},
+				startAt:     0,
+				maxResults:  25,
+			},
+			wantErr: true,
+			Err:     model.ErrNoSpaceKey,
+		},
+
+		{
+			name: "when the content type is not supported",
+			args: args{
+				ctx:         context.Background(),
+				spaceKey:    "DUMMY",
+				contentType: "attachment",
+				status:      "trashed",
+				expand:      []string{"version"},
+				startAt:     0,
+				maxResults:  25,
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidContentType,
+		},
+
+		{
+			name: "when the status is not supported",
+			args: args{
+				ctx:         context.Background(),
+				spaceKey:    "DUMMY",
+				contentType: "blogpost",
+				status:      "archived",
+				expand:      []string{"version"},
+				startAt:     0,
+				maxResults:  25,
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidContentStatus,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:         context.Background(),
+				spaceKey:    "DUMMY",
+				contentType: "blogpost",
+				status:      "trashed",
+				expand:      []string{"version"},
+				startAt:     0,
+				maxResults:  25,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content?expand=version&limit=25&spaceKey=DUMMY&start=0&status=trashed&type=blogpost",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewContentService(testCase.fields.c, &ContentSubServices{})
+
+			gotResult, gotResponse, err := newService.GetBySpace(testCase.args.ctx, testCase.args.spaceKey, testCase.args.contentType, testCase.args.status, testCase.args.expand, testCase.args.startAt, testCase.args.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalContentImpl_FindReplace(t *testing.T) {
+
+	const searchEndpoint = "wiki/rest/api/content/search?cql=space+%3D+%22DUMMY%22+and+type+%3D+page+and+text+~+%22Jira+Core%22&limit=50"
+
+	type args struct {
+		ctx                     context.Context
+		spaceKey, find, replace string
+		dryRun                  bool
+	}
+
+	// search mocks the search matching the pages 100 and 200, the update mocks are registered after the page 100 fetch.
+	// The page 200 only references the text on its markup, so it's skipped.
+	search := func(client *mocks.Connector, update func(*mocks.Connector)) {
+
+		client.On("NewRequest", context.Background(), http.MethodGet, searchEndpoint, "", nil).
+			Return(&http.Request{}, nil).
+			Once()
+
+		client.On("Call", &http.Request{}, &model.ContentPageScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.ContentPageScheme).Results = []*model.ContentScheme{{ID: "100"}, {ID: "200"}}
+			}).
+			Return(&model.ResponseScheme{}, nil).
+			Once()
+
+		for _, page := range []struct{ id, body string }{{"100", "<p>Jira Core and Jira Core</p>"}, {"200", `<p><ac:link><ri:page ri:content-title="Jira Core" /></ac:link> Jira Work Management</p>`}} {
+
+			body := page.body
+
+			client.On("NewRequest", context.Background(), http.MethodGet,
+				fmt.Sprintf("wiki/rest/api/content/%v?expand=body.storage,version", page.id), "", nil).
+				Return(&http.Request{}, nil).
+				Once()
+
+			client.On("Call", &http.Request{}, &model.ContentScheme{}).
+				Run(func(args mock.Arguments) {
+					content := args.Get(1).(*model.ContentScheme)
+					content.Type, content.Title = "page", "Release notes"
+					content.Version = &model.ContentVersionScheme{Number: 3}
+					content.Body = &model.BodyScheme{Storage: &model.BodyNodeScheme{Value: body, Representation: "storage"}}
+				}).
+				Return(&model.ResponseScheme{}, nil).
+				Once()
+
+			if page.id == "100" && update != nil {
+				update(client)
+			}
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		args    args
+		on      func(*mocks.Connector)
+		want    *model.ContentFindReplaceResultScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the replacement is executed",
+			args: args{ctx: context.Background(), spaceKey: "DUMMY", find: "Jira Core", replace: "Jira Work Management"},
+			on: func(client *mocks.Connector) {
+				search(client, func(client *mocks.Connector) {
+
+					client.On("NewRequest", context.Background(), http.MethodPut, "wiki/rest/api/content/100", "",
+						&model.ContentScheme{
+							ID:      "100",
+							Type:    "page",
+							Title:   "Release notes",
+							Version: &model.ContentVersionScheme{Number: 4},
+							Body: &model.BodyScheme{Storage: &model.BodyNodeScheme{
+								Value:          "<p>Jira Work Management and Jira Work Management</p>",
+								Representation: "storage",
+							}},
+						}).
+						Return(&http.Request{}, nil).
+						Once()
+
+					client.On("Call", &http.Request{}, &model.ContentScheme{}).
+						Run(func(args mock.Arguments) {
+							args.Get(1).(*model.ContentScheme).Version = &model.ContentVersionScheme{Number: 4}
+						}).
+						Return(&model.ResponseScheme{}, nil).
+						Once()
+				})
+			},
+			want: &model.ContentFindReplaceResultScheme{
+				Changes: []*model.ContentReplacementScheme{{ContentID: "100", Title: "Release notes", Occurrences: 2, Version: 4}},
+				Skipped: []string{"200"},
+				Errors:  map[string]error{},
+			},
+		},
+
+		{
+			name: "when the replacement is a dry run",
+			args: args{ctx: context.Background(), spaceKey: "DUMMY", find: "Jira Core", replace: "Jira Work Management", dryRun: true},
+			on:   func(client *mocks.Connector) { search(client, nil) },
+			want: &model.ContentFindReplaceResultScheme{
+				Changes: []*model.ContentReplacementScheme{{ContentID: "100", Title: "Release notes", Occurrences: 2}},
+				Skipped: []string{"200"},
+				Errors:  map[string]error{},
+			},
+		},
+
+		{
+			name:    "when the space key is not provided",
+			args:    args{ctx: context.Background(), find: "Jira Core"},
+			wantErr: true,
+			Err:     model.ErrNoSpaceKey,
+		},
+
+		{
+			name:    "when the text to find is not provided",
+			args:    args{ctx: context.Background(), spaceKey: "DUMMY"},
+			wantErr: true,
+			Err:     model.ErrNoFindText,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{ctx: context.Background(), spaceKey: "DUMMY", find: "Jira Core"},
+			on: func(client *mocks.Connector) {
+				client.On("NewRequest", context.Background(), http.MethodGet, searchEndpoint, "", nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			client := mocks.NewConnector(t)
+			if testCase.on != nil {
+				testCase.on(client)
+			}
+
+			newService := NewContentService(client, &ContentSubServices{})
+
+			gotResult, _, err := newService.FindReplace(testCase.args.ctx, testCase.args.spaceKey, testCase.args.find,
+				testCase.args.replace, testCase.args.dryRun)
+
+			if testCase.wantErr {
+				assert.EqualError(t, err, testCase.Err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, gotResult)
+		})
+	}
+}
+
+func Test_internalContentImpl_GetByTitle(t *testing.T) {
+
+	testCases := []struct {
+		name        string
+		spaceKey    string
+		title       string
+		contentType string
+		results     []*model.ContentScheme
+		wantID      string
+		wantErr     bool
+		Err         error
+	}{
+		{
+			name:        "when the title matches a single content",
+			spaceKey:    "DUMMY",
+			title:       "Release notes",
+			contentType: "page",
+			results:     []*model.ContentScheme{{ID: "100001"}},
+			wantID:      "100001",
+		},
+
+		{
+			name:        "when the title matches several contents",
+			spaceKey:    "DUMMY",
+			title:       "Release notes",
+			contentType: "page",
+			results:     []*model.ContentScheme{{ID: "100001"}, {ID: "100002"}},
+			wantID:      "100001",
+			wantErr:     true,
+			Err:         model.ErrDuplicateContentTitle,
+		},
+
+		{
+			name:        "when the title does not match any content",
+			spaceKey:    "DUMMY",
+			title:       "Release notes",
+			contentType: "page",
+			wantErr:     true,
+			Err:         model.ErrContentNotFound,
+		},
+
+		{
+			name:        "when the content type is not supported",
+			spaceKey:    "DUMMY",
+			title:       "Release notes",
+			contentType: "attachment",
+			wantErr:     true,
+			Err:         model.ErrInvalidContentType,
+		},
+
+		{
+			name:        "when the title is not provided",
+			spaceKey:    "DUMMY",
+			contentType: "page",
+			wantErr:     true,
+			Err:         model.ErrNoContentTitle,
+		},
+
+		{
+			name:        "when the space key is not provided",
+			title:       "Release notes",
+			contentType: "page",
+			wantErr:     true,
+			Err:         model.ErrNoSpaceKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			client := mocks.NewConnector(t)
+
+			if testCase.Err == nil || errors.Is(testCase.Err, model.ErrDuplicateContentTitle) || errors.Is(testCase.Err, model.ErrContentNotFound) {
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content?expand=version&limit=25&spaceKey=DUMMY&start=0&title=Release+notes&type=page",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentPageScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentPageScheme).Results = testCase.results
+					}).
+					Return(&model.ResponseScheme{}, nil)
+			}
+
+			newService := NewContentService(client, &ContentSubServices{})
+
+			gotResult, _, err := newService.GetByTitle(context.Background(), testCase.spaceKey, testCase.title, testCase.contentType, []string{"version"})
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err))
+
+				var duplicated *model.ContentDuplicateTitleError
+				if errors.As(err, &duplicated) {
+					assert.Equal(t, testCase.results, duplicated.Matches)
+					assert.Equal(t, testCase.wantID, gotResult.ID)
+				}
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantID, gotResult.ID)
+		})
+	}
+}
+
+func Test_internalContentImpl_Purge(t *testing.T) {
+
+	testCases := []struct {
+		name      string
+		contentID string
+		status    string
+		wantErr   bool
+		Err       error
+	}{
+		{
+			name:      "when the content is trashed",
+			contentID: "100001",
+			status:    "trashed",
+		},
+
+		{
+			name:      "when the content is not trashed",
+			contentID: "100001",
+			status:    "current",
+			wantErr:   true,
+			Err:       model.ErrContentNotTrashed,
+		},
+
+		{
+			name:    "when the content id is not provided",
+			wantErr: true,
+			Err:     model.ErrNoContentID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			client := mocks.NewConnector(t)
+
+			if testCase.contentID != "" {
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100001?status=any",
+					"",
+					nil).
+					Return(&http.Request{}, nil).Once()
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentScheme).Status = testCase.status
+					}).
+					Return(&model.ResponseScheme{}, nil).Once()
+			}
+
+			if testCase.Err == nil {
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"wiki/rest/api/content/100001?status=trashed",
+					"",
+					nil).
+					Return(&http.Request{}, nil).Once()
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil).Once()
+			}
+
+			newService := NewContentService(client, &ContentSubServices{})
+
+			_, err := newService.Purge(context.Background(), testCase.contentID)
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err))
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_internalContentImpl_PurgeSpace(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodGet,
+		"wiki/rest/api/content?limit=50&spaceKey=DUMMY&start=0&status=trashed&type=page",
+		"",
+		nil).
+		Return(&http.Request{}, nil)
+
+	client.On("Call",
+		&http.Request{},
+		&model.ContentPageScheme{}).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*model.ContentPageScheme).Results = []*model.ContentScheme{{ID: "100001"}, {ID: "100002"}}
+		}).
+		Return(&model.ResponseScheme{}, nil)
+
+	purgeRequest := &http.Request{Method: http.MethodDelete}
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodDelete,
+		"wiki/rest/api/content/100001?status=trashed",
+		"",
+		nil).
+		Return(purgeRequest, nil)
+
+	client.On("Call",
+		purgeRequest,
+		nil).
+		Return(&model.ResponseScheme{}, nil)
+
+	client.On("NewRequest",
+		context.Background(),
+		http.MethodDelete,
+		"wiki/rest/api/content/100002?status=trashed",
+		"",
+		nil).
+		Return(&http.Request{}, model.ErrNotFound)
+
+	newService := NewContentService(client, &ContentSubServices{})
+
+	result, _, err := newService.PurgeSpace(context.Background(), "DUMMY", "page")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Total)
+	assert.Equal(t, []string{"100001"}, result.Purged)
+	assert.True(t, errors.Is(result.Errors["100002"], model.ErrNotFound))
+
+	_, _, err = newService.PurgeSpace(context.Background(), "", "page")
+	assert.True(t, errors.Is(err, model.ErrNoSpaceKey))
+}
+
+func Test_internalContentImpl_Tasks(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		contentID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    []model.InlineTaskScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "65611",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/65611?expand=body.storage&version=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentScheme).Body = &model.BodyScheme{Storage: &model.BodyNodeScheme{
+							Value: `<ac:task-list><ac:task><ac:task-id>1</ac:task-id><ac:task-status>incomplete</ac:task-status><ac:task-body>Review</ac:task-body></ac:task></ac:task-list>`,
+						}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: []model.InlineTaskScheme{{ID: "1", Status: model.InlineTaskStatusIncomplete, Body: "Review"}},
+		},
+
+		{
+			name: "when the storage body is malformed",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "65611",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/65611?expand=body.storage&version=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentScheme).Body = &model.BodyScheme{Storage: &model.BodyNodeScheme{Value: `<ac:task-list><ac:task>`}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     &storage.ValidationError{Line: 1, Column: 24, Message: "unexpected EOF"},
+		},
+
+		{
+			name: "when the content id is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentID,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "65611",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/65611?expand=body.storage&version=0",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewContentService(testCase.fields.c, &ContentSubServices{})
+
+			gotResult, gotResponse, err := newService.Tasks(testCase.args.ctx, testCase.args.contentID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult)
+			}
+
+		})
+	}
+}
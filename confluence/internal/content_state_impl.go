@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+)
+
+// NewContentStateService creates a new instance of ContentStateService.
+// It takes a service.Connector as input and returns a pointer to ContentStateService.
+func NewContentStateService(client service.Connector) *ContentStateService {
+	return &ContentStateService{
+		internalClient: &internalContentStateImpl{c: client},
+	}
+}
+
+// ContentStateService provides methods to interact with the content states in Confluence.
+type ContentStateService struct {
+	// internalClient is the connector interface for content state operations.
+	internalClient confluence.ContentStateConnector
+}
+
+// Get returns the content state of the current version of a piece of content.
+//
+// GET /wiki/rest/api/content/{id}/state
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/states#get-content-state
+func (s *ContentStateService) Get(ctx context.Context, contentID string) (*model.ContentStateResponseScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Get(ctx, contentID)
+}
+
+// Set applies a content state to a piece of content, a new version of the content is published with the state.
+//
+// The state ID must be one of the states available on the space of the content, see Space.ContentStates.
+//
+// PUT /wiki/rest/api/content/{id}/state
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/states#set-content-state
+func (s *ContentStateService) Set(ctx context.Context, contentID string, stateID int) (*model.ContentStateResponseScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Set(ctx, contentID, stateID)
+}
+
+type internalContentStateImpl struct {
+	c service.Connector
+}
+
+func (i *internalContentStateImpl) Get(ctx context.Context, contentID string) (*model.ContentStateResponseScheme, *model.ResponseScheme, error) {
+
+	if contentID == "" {
+		return nil, nil, model.ErrNoContentID
+	}
+
+	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/state", contentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	state := new(model.ContentStateResponseScheme)
+	response, err := i.c.Call(request, state)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return state, response, nil
+}
+
+func (i *internalContentStateImpl) Set(ctx context.Context, contentID string, stateID int) (*model.ContentStateResponseScheme, *model.ResponseScheme, error) {
+
+	if contentID == "" {
+		return nil, nil, model.ErrNoContentID
+	}
+
+	if stateID <= 0 {
+		return nil, nil, model.ErrNoContentStateID
+	}
+
+	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/state?status=current", contentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", &model.ContentStateScheme{ID: stateID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	state := new(model.ContentStateResponseScheme)
+	response, err := i.c.Call(request, state)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return state, response, nil
+}
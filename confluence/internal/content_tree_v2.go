@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+)
+
+// getContentAncestors returns the ancestors of the content stored on the REST v2 collection, e.g. whiteboards.
+func getContentAncestors(ctx context.Context, c service.Connector, collection string, contentID, limit int) (*model.ContentAncestorsScheme, *model.ResponseScheme, error) {
+
+	query := url.Values{}
+	if limit > 0 {
+		query.Add("limit", strconv.Itoa(limit))
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/%v/%v/ancestors", collection, contentID)
+	if len(query) != 0 {
+		endpoint = fmt.Sprintf("%v?%v", endpoint, query.Encode())
+	}
+
+	request, err := c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ancestors := new(model.ContentAncestorsScheme)
+	response, err := c.Call(request, ancestors)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return ancestors, response, nil
+}
+
+// getContentChildren returns a chunk of the direct children of the content stored on the REST v2 collection.
+func getContentChildren(ctx context.Context, c service.Connector, collection string, contentID int, cursor string, limit int) (*model.ContentChildrenChunkScheme, *model.ResponseScheme, error) {
+
+	query := url.Values{}
+	query.Add("limit", strconv.Itoa(limit))
+
+	if cursor != "" {
+		query.Add("cursor", cursor)
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/%v/%v/direct-children?%v", collection, contentID, query.Encode())
+
+	request, err := c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	chunk := new(model.ContentChildrenChunkScheme)
+	response, err := c.Call(request, chunk)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return chunk, response, nil
+}
+
+// moveContent moves the content relative to the target content using the REST v1 move endpoint,
+// the REST v2 API doesn't provide one.
+func moveContent(ctx context.Context, c service.Connector, contentID int, position string, targetID int) (*model.ContentMoveScheme, *model.ResponseScheme, error) {
+
+	if position == "" {
+		return nil, nil, model.ErrNoPosition
+	}
+
+	if _, ok := model.ValidPositions[position]; !ok {
+		return nil, nil, model.ErrInvalidPosition
+	}
+
+	if targetID == 0 {
+		return nil, nil, model.ErrNoTargetID
+	}
+
+	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/move/%v/%v", contentID, position, targetID)
+
+	request, err := c.NewRequest(ctx, http.MethodPut, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	movement := new(model.ContentMoveScheme)
+	response, err := c.Call(request, movement)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return movement, response, nil
+}
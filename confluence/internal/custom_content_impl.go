@@ -142,6 +142,10 @@ func (i *internalCustomContentServiceImpl) Gets(ctx context.Context, typ string,
 
 func (i *internalCustomContentServiceImpl) Create(ctx context.Context, payload *model.CustomContentPayloadScheme) (*model.CustomContentScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := "wiki/api/v2/custom-content"
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -201,6 +205,10 @@ func (i *internalCustomContentServiceImpl) Update(ctx context.Context, customCon
 		return nil, nil, model.ErrNoCustomContentID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("wiki/api/v2/custom-content/%v", customContentID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
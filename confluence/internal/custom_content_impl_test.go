@@ -227,6 +227,18 @@ func Test_internalCustomContentServiceImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to create the http request"),
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -358,6 +370,19 @@ func Test_internalCustomContentServiceImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoCustomContentID,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:             context.Background(),
+				customContentID: 10001,
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -0,0 +1,173 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+)
+
+// NewDatabaseService creates a new instance of DatabaseService.
+// It takes a service.Connector as input and returns a pointer to DatabaseService.
+func NewDatabaseService(client service.Connector) *DatabaseService {
+	return &DatabaseService{internalClient: &internalDatabaseImpl{c: client}}
+}
+
+// DatabaseService provides methods to interact with database operations in Confluence.
+type DatabaseService struct {
+	// internalClient is the connector interface for database operations.
+	internalClient confluence.DatabaseConnector
+}
+
+// Create creates a database in the space.
+//
+// The database is created at the space root unless the parent ID is set.
+//
+// POST /wiki/api/v2/databases
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/database#create-database
+func (d *DatabaseService) Create(ctx context.Context, payload *model.DatabaseCreatePayloadScheme) (*model.DatabaseScheme, *model.ResponseScheme, error) {
+	return d.internalClient.Create(ctx, payload)
+}
+
+// Get returns a specific database.
+//
+// GET /wiki/api/v2/databases/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/database#get-database-by-id
+func (d *DatabaseService) Get(ctx context.Context, databaseID int) (*model.DatabaseScheme, *model.ResponseScheme, error) {
+	return d.internalClient.Get(ctx, databaseID)
+}
+
+// Delete deletes a database, the database is moved to the trash.
+//
+// DELETE /wiki/api/v2/databases/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/database#delete-database
+func (d *DatabaseService) Delete(ctx context.Context, databaseID int) (*model.ResponseScheme, error) {
+	return d.internalClient.Delete(ctx, databaseID)
+}
+
+// Ancestors returns the ancestors of the database in the content tree, from the top level to the parent.
+//
+// GET /wiki/api/v2/databases/{id}/ancestors
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/database#get-database-ancestors
+func (d *DatabaseService) Ancestors(ctx context.Context, databaseID, limit int) (*model.ContentAncestorsScheme, *model.ResponseScheme, error) {
+	return d.internalClient.Ancestors(ctx, databaseID, limit)
+}
+
+// Children returns the direct children of the database in the content tree.
+//
+// The results are paginated with a cursor, the cursor of the next chunk is available on the next link of the chunk.
+//
+// GET /wiki/api/v2/databases/{id}/direct-children
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/database#get-database-direct-children
+func (d *DatabaseService) Children(ctx context.Context, databaseID int, cursor string, limit int) (*model.ContentChildrenChunkScheme, *model.ResponseScheme, error) {
+	return d.internalClient.Children(ctx, databaseID, cursor, limit)
+}
+
+// Move moves the database relative to the target content in the content tree.
+//
+// The position is one of before, after or append, the REST v2 API doesn't provide a move endpoint,
+// so the REST v1 content move endpoint is used.
+//
+// PUT /wiki/rest/api/content/{id}/move/{position}/{targetID}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/database#move-database
+func (d *DatabaseService) Move(ctx context.Context, databaseID int, position string, targetID int) (*model.ContentMoveScheme, *model.ResponseScheme, error) {
+	return d.internalClient.Move(ctx, databaseID, position, targetID)
+}
+
+type internalDatabaseImpl struct {
+	c service.Connector
+}
+
+func (i *internalDatabaseImpl) Create(ctx context.Context, payload *model.DatabaseCreatePayloadScheme) (*model.DatabaseScheme, *model.ResponseScheme, error) {
+
+	if payload == nil {
+		return nil, nil, model.ErrNoContentPayload
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, "wiki/api/v2/databases", "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	database := new(model.DatabaseScheme)
+	response, err := i.c.Call(request, database)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return database, response, nil
+}
+
+func (i *internalDatabaseImpl) Get(ctx context.Context, databaseID int) (*model.DatabaseScheme, *model.ResponseScheme, error) {
+
+	if databaseID == 0 {
+		return nil, nil, model.ErrNoDatabaseID
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/databases/%v", databaseID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	database := new(model.DatabaseScheme)
+	response, err := i.c.Call(request, database)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return database, response, nil
+}
+
+func (i *internalDatabaseImpl) Delete(ctx context.Context, databaseID int) (*model.ResponseScheme, error) {
+
+	if databaseID == 0 {
+		return nil, model.ErrNoDatabaseID
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/databases/%v", databaseID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalDatabaseImpl) Ancestors(ctx context.Context, databaseID, limit int) (*model.ContentAncestorsScheme, *model.ResponseScheme, error) {
+
+	if databaseID == 0 {
+		return nil, nil, model.ErrNoDatabaseID
+	}
+
+	return getContentAncestors(ctx, i.c, "databases", databaseID, limit)
+}
+
+func (i *internalDatabaseImpl) Children(ctx context.Context, databaseID int, cursor string, limit int) (*model.ContentChildrenChunkScheme, *model.ResponseScheme, error) {
+
+	if databaseID == 0 {
+		return nil, nil, model.ErrNoDatabaseID
+	}
+
+	return getContentChildren(ctx, i.c, "databases", databaseID, cursor, limit)
+}
+
+func (i *internalDatabaseImpl) Move(ctx context.Context, databaseID int, position string, targetID int) (*model.ContentMoveScheme, *model.ResponseScheme, error) {
+
+	if databaseID == 0 {
+		return nil, nil, model.ErrNoDatabaseID
+	}
+
+	return moveContent(ctx, i.c, databaseID, position, targetID)
+}
@@ -0,0 +1,119 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+)
+
+// NewGroupService creates a new instance of GroupService.
+// It takes a service.Connector as input and returns a pointer to GroupService.
+func NewGroupService(client service.Connector) *GroupService {
+	return &GroupService{
+		internalClient: &internalGroupImpl{c: client},
+	}
+}
+
+// GroupService provides methods to interact with the groups in Confluence.
+type GroupService struct {
+	// internalClient is the connector interface for group operations.
+	internalClient confluence.GroupConnector
+}
+
+// Members returns a page of the users that are members of a group.
+//
+// GET /wiki/rest/api/group/member
+//
+// https://docs.go-atlassian.io/confluence-cloud/groups#get-group-members
+func (g *GroupService) Members(ctx context.Context, groupName string, start, limit int) (*model.ContentGroupMemberPageScheme, *model.ResponseScheme, error) {
+	return g.internalClient.Members(ctx, groupName, start, limit)
+}
+
+// HasMembers checks which of the accounts are members of a group, the result is keyed by the account ID.
+//
+// Every page of the group members is fetched before answering, so a single walk of the group serves any number of accounts.
+//
+// GET /wiki/rest/api/group/member
+//
+// https://docs.go-atlassian.io/confluence-cloud/groups#check-group-members
+func (g *GroupService) HasMembers(ctx context.Context, groupName string, accountIDs []string) (map[string]bool, *model.ResponseScheme, error) {
+	return g.internalClient.HasMembers(ctx, groupName, accountIDs)
+}
+
+type internalGroupImpl struct {
+	c service.Connector
+}
+
+func (i *internalGroupImpl) Members(ctx context.Context, groupName string, start, limit int) (*model.ContentGroupMemberPageScheme, *model.ResponseScheme, error) {
+
+	if groupName == "" {
+		return nil, nil, model.ErrNoGroupName
+	}
+
+	query := url.Values{}
+	query.Add("name", groupName)
+	query.Add("start", strconv.Itoa(start))
+	query.Add("limit", strconv.Itoa(limit))
+
+	endpoint := fmt.Sprintf("wiki/rest/api/group/member?%v", query.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.ContentGroupMemberPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// groupMemberPageSize is the number of members fetched per page by HasMembers.
+const groupMemberPageSize = 200
+
+func (i *internalGroupImpl) HasMembers(ctx context.Context, groupName string, accountIDs []string) (map[string]bool, *model.ResponseScheme, error) {
+
+	if groupName == "" {
+		return nil, nil, model.ErrNoGroupName
+	}
+
+	if len(accountIDs) == 0 {
+		return nil, nil, model.ErrNoAccountID
+	}
+
+	members := make(map[string]bool, len(accountIDs))
+	for _, accountID := range accountIDs {
+		members[accountID] = false
+	}
+
+	var response *model.ResponseScheme
+
+	for start := 0; ; start += groupMemberPageSize {
+
+		page, pageResponse, err := i.Members(ctx, groupName, start, groupMemberPageSize)
+		if err != nil {
+			return nil, pageResponse, err
+		}
+
+		response = pageResponse
+
+		for _, member := range page.Results {
+			if _, ok := members[member.AccountID]; ok {
+				members[member.AccountID] = true
+			}
+		}
+
+		if len(page.Results) < groupMemberPageSize {
+			return members, response, nil
+		}
+	}
+}
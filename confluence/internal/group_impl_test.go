@@ -0,0 +1,187 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalGroupImpl_Members(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		groupName string
+		start     int
+		limit     int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				groupName: "confluence-users",
+				start:     0,
+				limit:     50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/group/member?limit=50&name=confluence-users&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentGroupMemberPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the group name is not provided",
+			args: args{
+				ctx:       context.Background(),
+				groupName: "",
+				start:     0,
+				limit:     50,
+			},
+			wantErr: true,
+			Err:     model.ErrNoGroupName,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				groupName: "confluence-users",
+				start:     0,
+				limit:     50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/group/member?limit=50&name=confluence-users&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewGroupService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Members(testCase.args.ctx, testCase.args.groupName, testCase.args.start, testCase.args.limit)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalGroupImpl_HasMembers(t *testing.T) {
+
+	client := mocks.NewConnector(t)
+
+	firstPage := make([]*model.ContentUserScheme, groupMemberPageSize)
+	for index := range firstPage {
+		firstPage[index] = &model.ContentUserScheme{AccountID: fmt.Sprintf("account-%d", index)}
+	}
+	firstPage[10] = &model.ContentUserScheme{AccountID: "5b10a2844c20165700ede21g"}
+
+	pages := []struct {
+		endpoint string
+		results  []*model.ContentUserScheme
+	}{
+		{endpoint: "wiki/rest/api/group/member?limit=200&name=confluence-users&start=0", results: firstPage},
+		{endpoint: "wiki/rest/api/group/member?limit=200&name=confluence-users&start=200", results: []*model.ContentUserScheme{{AccountID: "5b10ac8d82e05b22cc7d4ef5"}}},
+	}
+
+	for _, page := range pages {
+
+		request := &http.Request{RequestURI: page.endpoint}
+		results := page.results
+
+		client.On("NewRequest", context.Background(), http.MethodGet, page.endpoint, "", nil).
+			Return(request, nil)
+
+		client.On("Call", request, &model.ContentGroupMemberPageScheme{}).
+			Run(func(args mock.Arguments) {
+				args.Get(1).(*model.ContentGroupMemberPageScheme).Results = results
+			}).
+			Return(&model.ResponseScheme{}, nil)
+	}
+
+	newService := NewGroupService(client)
+
+	members, _, err := newService.HasMembers(context.Background(), "confluence-users",
+		[]string{"5b10a2844c20165700ede21g", "5b10ac8d82e05b22cc7d4ef5", "5b10ac8d82e05b22cc7d4ef6"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{
+		"5b10a2844c20165700ede21g": true,
+		"5b10ac8d82e05b22cc7d4ef5": true,
+		"5b10ac8d82e05b22cc7d4ef6": false,
+	}, members)
+
+	_, _, err = newService.HasMembers(context.Background(), "confluence-users", nil)
+	assert.True(t, errors.Is(err, model.ErrNoAccountID))
+
+	_, _, err = newService.HasMembers(context.Background(), "", []string{"5b10a2844c20165700ede21g"})
+	assert.True(t, errors.Is(err, model.ErrNoGroupName))
+}
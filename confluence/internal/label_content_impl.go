@@ -3,11 +3,13 @@ package internal
 import (
 	"context"
 	"fmt"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/fanout"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -53,6 +55,20 @@ func (c *ContentLabelService) Remove(ctx context.Context, contentID, labelName s
 	return c.internalClient.Remove(ctx, contentID, labelName)
 }
 
+// RemoveByPrefix removes the labels whose name starts with the prefix from a piece of content, e.g. every status- label.
+//
+// The labels of the content are listed and the matching ones are removed with a bounded concurrency.
+// The failed labels don't stop the operation, their errors are returned on the result keyed by the label name.
+//
+// GET /wiki/rest/api/content/{id}/label
+//
+// DELETE /wiki/rest/api/content/{id}/label/{label}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/labels#remove-labels-by-prefix
+func (c *ContentLabelService) RemoveByPrefix(ctx context.Context, contentID, prefix string) (*model.ContentLabelRemovalScheme, *model.ResponseScheme, error) {
+	return c.internalClient.RemoveByPrefix(ctx, contentID, prefix)
+}
+
 type internalContentLabelImpl struct {
 	c service.Connector
 }
@@ -136,3 +152,65 @@ func (i *internalContentLabelImpl) Remove(ctx context.Context, contentID, labelN
 
 	return i.c.Call(request, nil)
 }
+
+// contentLabelRemovalWorkers is the number of labels removed concurrently by RemoveByPrefix.
+const contentLabelRemovalWorkers = 5
+
+// contentLabelPageSize is the number of labels fetched per page by RemoveByPrefix.
+const contentLabelPageSize = 200
+
+func (i *internalContentLabelImpl) RemoveByPrefix(ctx context.Context, contentID, prefix string) (*model.ContentLabelRemovalScheme, *model.ResponseScheme, error) {
+
+	if contentID == "" {
+		return nil, nil, model.ErrNoContentID
+	}
+
+	if prefix == "" {
+		return nil, nil, model.ErrNoLabelPrefix
+	}
+
+	var (
+		labels   []string
+		response *model.ResponseScheme
+	)
+
+	for startAt := 0; ; startAt += contentLabelPageSize {
+
+		page, pageResponse, err := i.Gets(ctx, contentID, "", startAt, contentLabelPageSize)
+		if err != nil {
+			return nil, pageResponse, err
+		}
+
+		response = pageResponse
+
+		for _, label := range page.Results {
+			if strings.HasPrefix(label.Name, prefix) {
+				labels = append(labels, label.Name)
+			}
+		}
+
+		if len(page.Results) < contentLabelPageSize {
+			break
+		}
+	}
+
+	result := &model.ContentLabelRemovalScheme{Total: len(labels), Errors: make(map[string]error)}
+
+	errs := fanout.Map(labels, contentLabelRemovalWorkers, func(label string) error {
+		_, err := i.Remove(ctx, contentID, label)
+		return err
+	})
+
+	for index, err := range errs {
+
+		if err != nil {
+			result.Errors[labels[index]] = err
+		} else {
+			result.Removed = append(result.Removed, labels[index])
+		}
+	}
+
+	slices.Sort(result.Removed)
+
+	return result, response, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"testing"
 )
@@ -368,3 +369,156 @@ func Test_internalContentLabelImpl_Add(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalContentLabelImpl_RemoveByPrefix(t *testing.T) {
+
+	testCases := []struct {
+		name        string
+		contentID   string
+		prefix      string
+		on          func(*mocks.Connector)
+		wantRemoved []string
+		wantErrors  []string
+		wantErr     bool
+		Err         error
+	}{
+		{
+			name:      "when the labels matching the prefix are removed",
+			contentID: "100100101",
+			prefix:    "status-",
+			on: func(client *mocks.Connector) {
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100100101/label?limit=200&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil).
+					Once()
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentLabelPageScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentLabelPageScheme).Results = []*model.ContentLabelScheme{
+							{Name: "status-done"}, {Name: "status-wip"}, {Name: "team-a"},
+						}
+					}).
+					Return(&model.ResponseScheme{}, nil).
+					Once()
+
+				for _, label := range []string{"status-done", "status-wip"} {
+
+					request := &http.Request{Method: http.MethodDelete, RequestURI: label}
+
+					client.On("NewRequest",
+						context.Background(),
+						http.MethodDelete,
+						"wiki/rest/api/content/100100101/label/"+label,
+						"",
+						nil).
+						Return(request, nil)
+
+					var err error
+					if label == "status-wip" {
+						err = model.ErrUnauthorized
+					}
+
+					client.On("Call",
+						request,
+						nil).
+						Return(&model.ResponseScheme{}, err)
+				}
+			},
+			wantRemoved: []string{"status-done"},
+			wantErrors:  []string{"status-wip"},
+		},
+
+		{
+			name:      "when no label matches the prefix",
+			contentID: "100100101",
+			prefix:    "status-",
+			on: func(client *mocks.Connector) {
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100100101/label?limit=200&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentLabelPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+			},
+		},
+
+		{
+			name:      "when the labels cannot be listed",
+			contentID: "100100101",
+			prefix:    "status-",
+			on: func(client *mocks.Connector) {
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100100101/label?limit=200&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentLabelPageScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrNotFound)
+			},
+			wantErr: true,
+			Err:     model.ErrNotFound,
+		},
+
+		{
+			name:      "when the prefix is not provided",
+			contentID: "100100101",
+			wantErr:   true,
+			Err:       model.ErrNoLabelPrefix,
+		},
+
+		{
+			name:    "when the content id is not provided",
+			prefix:  "status-",
+			wantErr: true,
+			Err:     model.ErrNoContentID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			client := mocks.NewConnector(t)
+			if testCase.on != nil {
+				testCase.on(client)
+			}
+
+			newService := NewContentLabelService(client)
+
+			gotResult, gotResponse, err := newService.RemoveByPrefix(context.Background(), testCase.contentID, testCase.prefix)
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, gotResponse)
+			assert.Equal(t, len(testCase.wantRemoved)+len(testCase.wantErrors), gotResult.Total)
+			assert.Equal(t, testCase.wantRemoved, gotResult.Removed)
+
+			for _, label := range testCase.wantErrors {
+				assert.Error(t, gotResult.Errors[label])
+			}
+		})
+	}
+}
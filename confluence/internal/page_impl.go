@@ -320,6 +320,10 @@ func (i *internalPageImpl) GetsByParent(ctx context.Context, parentID int, curso
 
 func (i *internalPageImpl) Create(ctx context.Context, payload *model.PageCreatePayloadScheme) (*model.PageScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := "wiki/api/v2/pages"
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -342,6 +346,10 @@ func (i *internalPageImpl) Update(ctx context.Context, pageID int, payload *mode
 		return nil, nil, model.ErrNoPageID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("wiki/api/v2/pages/%v", pageID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -941,6 +941,18 @@ func Test_internalPageImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to create the http request"),
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1092,6 +1104,19 @@ func Test_internalPageImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoPageID,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:    context.Background(),
+				pageID: 215646235,
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -71,6 +71,10 @@ func (i *internalSpacePermissionImpl) Add(ctx context.Context, spaceKey string,
 		return nil, nil, model.ErrNoSpaceKey
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("wiki/rest/api/space/%v/permission", spaceKey)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -101,6 +101,19 @@ func Test_internalSpacePermissionImpl_Add(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoSpaceKey,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
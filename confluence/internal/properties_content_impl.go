@@ -102,6 +102,10 @@ func (i *internalPropertyImpl) Create(ctx context.Context, contentID string, pay
 		return nil, nil, model.ErrNoContentID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/property", contentID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -459,6 +459,19 @@ func Test_internalPropertyImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoContentID,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "11101",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
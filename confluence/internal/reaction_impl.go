@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+)
+
+// NewReactionService creates a new instance of ReactionService.
+// It takes a service.Connector as input and returns a pointer to ReactionService.
+func NewReactionService(client service.Connector) *ReactionService {
+	return &ReactionService{
+		internalClient: &internalReactionImpl{c: client},
+	}
+}
+
+// ReactionService provides methods to interact with the content reactions in Confluence.
+type ReactionService struct {
+	// internalClient is the connector interface for reaction operations.
+	internalClient confluence.ReactionConnector
+}
+
+// Gets returns the reactions of a piece of content, grouped by emoji.
+//
+// GET /wiki/rest/reactions/1.0/content/{contentID}/reactions
+//
+// https://docs.go-atlassian.io/confluence-cloud/reactions#get-reactions
+func (r *ReactionService) Gets(ctx context.Context, contentID string) (*model.ContentReactionSummaryScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Gets(ctx, contentID)
+}
+
+// Add reacts to a piece of content with the emoji, on behalf of the current user.
+//
+// The emoji is the emoji ID, e.g. 1f44d.
+//
+// PUT /wiki/rest/reactions/1.0/content/{contentID}/reactions/{emoji}
+//
+// https://docs.go-atlassian.io/confluence-cloud/reactions#add-reaction
+func (r *ReactionService) Add(ctx context.Context, contentID, emoji string) (*model.ResponseScheme, error) {
+	return r.internalClient.Add(ctx, contentID, emoji)
+}
+
+// Remove removes the reaction of the current user to a piece of content.
+//
+// DELETE /wiki/rest/reactions/1.0/content/{contentID}/reactions/{emoji}
+//
+// https://docs.go-atlassian.io/confluence-cloud/reactions#remove-reaction
+func (r *ReactionService) Remove(ctx context.Context, contentID, emoji string) (*model.ResponseScheme, error) {
+	return r.internalClient.Remove(ctx, contentID, emoji)
+}
+
+type internalReactionImpl struct {
+	c service.Connector
+}
+
+func (i *internalReactionImpl) Gets(ctx context.Context, contentID string) (*model.ContentReactionSummaryScheme, *model.ResponseScheme, error) {
+
+	if contentID == "" {
+		return nil, nil, model.ErrNoContentID
+	}
+
+	endpoint := fmt.Sprintf("wiki/rest/reactions/1.0/content/%v/reactions", contentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	summary := new(model.ContentReactionSummaryScheme)
+	response, err := i.c.Call(request, summary)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return summary, response, nil
+}
+
+func (i *internalReactionImpl) Add(ctx context.Context, contentID, emoji string) (*model.ResponseScheme, error) {
+	return i.react(ctx, http.MethodPut, contentID, emoji)
+}
+
+func (i *internalReactionImpl) Remove(ctx context.Context, contentID, emoji string) (*model.ResponseScheme, error) {
+	return i.react(ctx, http.MethodDelete, contentID, emoji)
+}
+
+func (i *internalReactionImpl) react(ctx context.Context, method, contentID, emoji string) (*model.ResponseScheme, error) {
+
+	if contentID == "" {
+		return nil, model.ErrNoContentID
+	}
+
+	if emoji == "" {
+		return nil, model.ErrNoReactionEmoji
+	}
+
+	endpoint := fmt.Sprintf("wiki/rest/reactions/1.0/content/%v/reactions/%v", contentID, url.PathEscape(emoji))
+
+	request, err := i.c.NewRequest(ctx, method, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
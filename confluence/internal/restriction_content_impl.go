@@ -65,6 +65,19 @@ func (r *RestrictionService) Update(ctx context.Context, contentID string, paylo
 	return r.internalClient.Update(ctx, contentID, payload, expand)
 }
 
+// Effective returns the restrictions applied to a piece of content, including the ones inherited from its ancestors.
+//
+// The read restrictions are inherited from every ancestor and a user must satisfy all of them,
+// the update restrictions only apply to the content itself. Each restriction includes the content defining it, and
+// ReadPrincipals and UpdatePrincipals hold the users and the groups allowed by all of them.
+//
+// GET /wiki/rest/api/content/{id}/restriction/byOperation/{operationKey}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/restrictions#get-effective-restrictions
+func (r *RestrictionService) Effective(ctx context.Context, contentID string) (*model.ContentEffectiveRestrictionScheme, *model.ResponseScheme, error) {
+	return r.internalClient.Effective(ctx, contentID)
+}
+
 type internalRestrictionImpl struct {
 	c service.Connector
 }
@@ -105,6 +118,10 @@ func (i *internalRestrictionImpl) Add(ctx context.Context, contentID string, pay
 		return nil, nil, model.ErrNoContentID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	var endpoint strings.Builder
 	endpoint.WriteString(fmt.Sprintf("wiki/rest/api/content/%v/restriction", contentID))
 
@@ -165,6 +182,10 @@ func (i *internalRestrictionImpl) Update(ctx context.Context, contentID string,
 		return nil, nil, model.ErrNoContentID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	var endpoint strings.Builder
 	endpoint.WriteString(fmt.Sprintf("wiki/rest/api/content/%v/restriction", contentID))
 
@@ -188,3 +209,180 @@ func (i *internalRestrictionImpl) Update(ctx context.Context, contentID string,
 
 	return page, response, nil
 }
+
+func (i *internalRestrictionImpl) Effective(ctx context.Context, contentID string) (*model.ContentEffectiveRestrictionScheme, *model.ResponseScheme, error) {
+
+	if contentID == "" {
+		return nil, nil, model.ErrNoContentID
+	}
+
+	endpoint := fmt.Sprintf("wiki/rest/api/content/%v?expand=ancestors", contentID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content := new(model.ContentScheme)
+	response, err := i.c.Call(request, content)
+	if err != nil {
+		return nil, response, err
+	}
+
+	effective := &model.ContentEffectiveRestrictionScheme{ContentID: contentID}
+
+	source, response, err := i.restrictionSource(ctx, contentID, "update", false)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if source != nil {
+		effective.Update = append(effective.Update, source)
+	}
+
+	contentIDs := []string{contentID}
+	for _, ancestor := range content.Ancestors {
+		contentIDs = append(contentIDs, ancestor.ID)
+	}
+
+	for _, id := range contentIDs {
+
+		source, response, err = i.restrictionSource(ctx, id, "read", id != contentID)
+		if err != nil {
+			return nil, response, err
+		}
+
+		if source != nil {
+			effective.Read = append(effective.Read, source)
+		}
+	}
+
+	effective.ReadPrincipals = mergeRestrictionSources(effective.Read)
+	effective.UpdatePrincipals = mergeRestrictionSources(append(append([]*model.ContentRestrictionSourceScheme{}, effective.Update...), effective.Read...))
+
+	return effective, response, nil
+}
+
+// restrictionPrincipalsPageSize is the number of users and groups requested on each page of a restriction.
+const restrictionPrincipalsPageSize = 200
+
+// restrictionSource returns the principals allowed by the restriction of the operation, or nil if the content is not restricted.
+//
+// The users and the groups are paginated together, the pages are requested until both of them are exhausted.
+func (i *internalRestrictionImpl) restrictionSource(ctx context.Context, contentID, operationKey string, inherited bool) (*model.ContentRestrictionSourceScheme, *model.ResponseScheme, error) {
+
+	operation := &internalRestrictionOperationImpl{c: i.c}
+	source := &model.ContentRestrictionSourceScheme{ContentID: contentID, Inherited: inherited}
+
+	for startAt := 0; ; startAt += restrictionPrincipalsPageSize {
+
+		restriction, response, err := operation.Get(ctx, contentID, operationKey, []string{"restrictions.user", "restrictions.group"}, startAt, restrictionPrincipalsPageSize)
+		if err != nil {
+			return nil, response, err
+		}
+
+		if startAt == 0 && !isContentRestricted(restriction) {
+			return nil, response, nil
+		}
+
+		var users, groups int
+
+		if restriction.Restrictions != nil {
+
+			if page := restriction.Restrictions.User; page != nil {
+				users = len(page.Results)
+				source.Users = append(source.Users, page.Results...)
+			}
+
+			if page := restriction.Restrictions.Group; page != nil {
+				groups = len(page.Results)
+				source.Groups = append(source.Groups, page.Results...)
+			}
+		}
+
+		if users < restrictionPrincipalsPageSize && groups < restrictionPrincipalsPageSize {
+			return source, response, nil
+		}
+	}
+}
+
+// mergeRestrictionSources returns the users and the groups listed by every restriction source, or nil if there's no source.
+//
+// The group memberships aren't resolved, a user allowed through a group by one restriction and listed by another one
+// isn't part of the merged users.
+func mergeRestrictionSources(sources []*model.ContentRestrictionSourceScheme) *model.ContentRestrictionPrincipalsScheme {
+
+	if len(sources) == 0 {
+		return nil
+	}
+
+	principals := &model.ContentRestrictionPrincipalsScheme{}
+
+	for _, user := range sources[0].Users {
+
+		listed := true
+		for _, source := range sources[1:] {
+			if !containsRestrictionUser(source.Users, user) {
+				listed = false
+				break
+			}
+		}
+
+		if listed && !containsRestrictionUser(principals.Users, user) {
+			principals.Users = append(principals.Users, user)
+		}
+	}
+
+	for _, group := range sources[0].Groups {
+
+		listed := true
+		for _, source := range sources[1:] {
+			if !containsRestrictionGroup(source.Groups, group) {
+				listed = false
+				break
+			}
+		}
+
+		if listed && !containsRestrictionGroup(principals.Groups, group) {
+			principals.Groups = append(principals.Groups, group)
+		}
+	}
+
+	return principals
+}
+
+func containsRestrictionUser(users []*model.ContentUserScheme, user *model.ContentUserScheme) bool {
+
+	for _, candidate := range users {
+		if candidate.AccountID != "" || user.AccountID != "" {
+			if candidate.AccountID == user.AccountID {
+				return true
+			}
+			continue
+		}
+
+		if candidate.UserKey == user.UserKey && candidate.Username == user.Username {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsRestrictionGroup(groups []*model.SpaceGroupScheme, group *model.SpaceGroupScheme) bool {
+
+	for _, candidate := range groups {
+		if candidate.ID != "" && group.ID != "" {
+			if candidate.ID == group.ID {
+				return true
+			}
+			continue
+		}
+
+		if candidate.Name == group.Name {
+			return true
+		}
+	}
+
+	return false
+}
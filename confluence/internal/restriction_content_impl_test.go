@@ -3,10 +3,12 @@ package internal
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -219,6 +221,20 @@ func Test_internalRestrictionImpl_Add(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoContentID,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "100001",
+				expand:    []string{"restrictions.user"},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -451,6 +467,20 @@ func Test_internalRestrictionImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoContentID,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "100001",
+				expand:    []string{"restrictions.user"},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -483,3 +513,263 @@ func Test_internalRestrictionImpl_Update(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalRestrictionImpl_Effective(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		contentID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.ContentEffectiveRestrictionScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the restriction is inherited from an ancestor",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "100",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100?expand=ancestors",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentScheme).Ancestors = []*model.ContentScheme{{ID: "200"}}
+					}).
+					Once().
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100/restriction/byOperation/update?expand=restrictions.user%2Crestrictions.group&limit=200&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100/restriction/byOperation/read?expand=restrictions.user%2Crestrictions.group&limit=200&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/200/restriction/byOperation/read?expand=restrictions.user%2Crestrictions.group&limit=200&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentRestrictionScheme{}).
+					Once().
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentRestrictionScheme{}).
+					Once().
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentRestrictionScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentRestrictionScheme).Restrictions = &model.ContentRestrictionDetailScheme{
+							Group: &model.GroupPermissionScheme{Results: []*model.SpaceGroupScheme{{Name: "confluence-admins"}}, Size: 1},
+						}
+					}).
+					Once().
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.ContentEffectiveRestrictionScheme{
+				ContentID: "100",
+				Read: []*model.ContentRestrictionSourceScheme{
+					{ContentID: "200", Inherited: true, Groups: []*model.SpaceGroupScheme{{Name: "confluence-admins"}}},
+				},
+				ReadPrincipals:   &model.ContentRestrictionPrincipalsScheme{Groups: []*model.SpaceGroupScheme{{Name: "confluence-admins"}}},
+				UpdatePrincipals: &model.ContentRestrictionPrincipalsScheme{Groups: []*model.SpaceGroupScheme{{Name: "confluence-admins"}}},
+			},
+		},
+
+		{
+			name: "when the principals are paginated and restricted by several contents",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "100",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100?expand=ancestors",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentScheme).Ancestors = []*model.ContentScheme{{ID: "200"}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				pages := map[string]*model.ContentRestrictionDetailScheme{
+					"100/restriction/byOperation/update?expand=restrictions.user%2Crestrictions.group&limit=200&start=0": {
+						User: &model.UserPermissionScheme{Results: []*model.ContentUserScheme{{AccountID: "account-id-1"}, {AccountID: "account-id-3"}}, Size: 2},
+					},
+					"100/restriction/byOperation/read?expand=restrictions.user%2Crestrictions.group&limit=200&start=0": {
+						User:  &model.UserPermissionScheme{Results: make([]*model.ContentUserScheme, 200), Size: 201},
+						Group: &model.GroupPermissionScheme{Results: []*model.SpaceGroupScheme{{ID: "group-id-1"}}, Size: 1},
+					},
+					"100/restriction/byOperation/read?expand=restrictions.user%2Crestrictions.group&limit=200&start=200": {
+						User: &model.UserPermissionScheme{Results: []*model.ContentUserScheme{{AccountID: "account-id-1"}}, Size: 201},
+					},
+					"200/restriction/byOperation/read?expand=restrictions.user%2Crestrictions.group&limit=200&start=0": {
+						User:  &model.UserPermissionScheme{Results: []*model.ContentUserScheme{{AccountID: "account-id-1"}, {AccountID: "account-id-2"}}, Size: 2},
+						Group: &model.GroupPermissionScheme{Results: []*model.SpaceGroupScheme{{ID: "group-id-1"}, {ID: "group-id-2"}}, Size: 2},
+					},
+				}
+
+				for i := 0; i < 200; i++ {
+					pages["100/restriction/byOperation/read?expand=restrictions.user%2Crestrictions.group&limit=200&start=0"].User.Results[i] =
+						&model.ContentUserScheme{AccountID: fmt.Sprintf("account-id-%v", 100+i)}
+				}
+
+				for endpoint, restrictions := range pages {
+
+					restrictions, request := restrictions, &http.Request{RequestURI: endpoint}
+
+					client.On("NewRequest",
+						context.Background(),
+						http.MethodGet,
+						"wiki/rest/api/content/"+endpoint,
+						"",
+						nil).
+						Return(request, nil)
+
+					client.On("Call",
+						request,
+						&model.ContentRestrictionScheme{}).
+						Run(func(args mock.Arguments) {
+							args.Get(1).(*model.ContentRestrictionScheme).Restrictions = restrictions
+						}).
+						Return(&model.ResponseScheme{}, nil)
+				}
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the content id is not provided",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentID,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "100",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100?expand=ancestors",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewRestrictionService(testCase.fields.c, nil)
+
+			gotResult, gotResponse, err := newService.Effective(testCase.args.ctx, testCase.args.contentID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				if testCase.want != nil {
+					assert.Equal(t, testCase.want, gotResult)
+					return
+				}
+
+				assert.Len(t, gotResult.Read, 2)
+				assert.Len(t, gotResult.Read[0].Users, 201)
+				assert.Len(t, gotResult.Update, 1)
+				assert.Equal(t, &model.ContentRestrictionPrincipalsScheme{
+					Users:  []*model.ContentUserScheme{{AccountID: "account-id-1"}},
+					Groups: []*model.SpaceGroupScheme{{ID: "group-id-1"}},
+				}, gotResult.ReadPrincipals)
+				assert.Equal(t, &model.ContentRestrictionPrincipalsScheme{
+					Users: []*model.ContentUserScheme{{AccountID: "account-id-1"}},
+				}, gotResult.UpdatePrincipals)
+			}
+
+		})
+	}
+}
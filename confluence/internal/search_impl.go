@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 )
 
 // NewSearchService creates a new instance of SearchService.
@@ -48,6 +49,22 @@ func (s *SearchService) Users(ctx context.Context, cql string, start, limit int,
 	return s.internalClient.Users(ctx, cql, start, limit, expand)
 }
 
+// CQLStream searches the contents matching the CQL query and calls fn for every content, fetched with the expand provided.
+//
+// The search pages are walked using the cursor and the contents are fetched by a pool of concurrency workers,
+// 4 when the concurrency is 0 or less, so only the contents being fetched are kept in memory. fn is called from
+// the calling goroutine as the contents arrive, not in the search order. The first error returned by the search,
+// a fetch or fn stops the stream and it's returned.
+//
+// GET /wiki/rest/api/search
+//
+// GET /wiki/rest/api/content/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/search#stream-content
+func (s *SearchService) CQLStream(ctx context.Context, cql string, expand []string, concurrency int, fn func(content *model.ContentScheme) error) error {
+	return s.internalClient.CQLStream(ctx, cql, expand, concurrency, fn)
+}
+
 type internalSearchImpl struct {
 	c service.Connector
 }
@@ -156,3 +173,159 @@ func (i *internalSearchImpl) Users(ctx context.Context, cql string, start, limit
 
 	return page, response, nil
 }
+
+func (i *internalSearchImpl) CQLStream(ctx context.Context, cql string, expand []string, concurrency int, fn func(content *model.ContentScheme) error) error {
+
+	if cql == "" {
+		return model.ErrNoCQL
+	}
+
+	if concurrency <= 0 {
+		concurrency = searchStreamDefaultConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type fetched struct {
+		content *model.ContentScheme
+		err     error
+	}
+
+	var (
+		ids       = make(chan string)
+		contents  = make(chan fetched)
+		searched  = make(chan struct{})
+		wg        sync.WaitGroup
+		searchErr error
+	)
+
+	go func() {
+		defer close(searched)
+		defer close(ids)
+		searchErr = i.searchContentIDs(ctx, cql, ids)
+	}()
+
+	for worker := 0; worker < concurrency; worker++ {
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for contentID := range ids {
+
+				content, err := i.getContent(ctx, contentID, expand)
+
+				select {
+				case contents <- fetched{content: content, err: err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(contents)
+	}()
+
+	var streamErr error
+	for result := range contents {
+
+		if streamErr != nil {
+			continue
+		}
+
+		if result.err != nil {
+			streamErr = result.err
+		} else {
+			streamErr = fn(result.content)
+		}
+
+		if streamErr != nil {
+			cancel()
+		}
+	}
+
+	// The workers stop early when the context is canceled, the search must be finished before reading its error.
+	cancel()
+	<-searched
+
+	if streamErr != nil {
+		return streamErr
+	}
+
+	return searchErr
+}
+
+// searchStreamDefaultConcurrency is the number of contents fetched at the same time by CQLStream when no concurrency is set.
+const searchStreamDefaultConcurrency = 4
+
+// searchStreamPageSize is the number of results requested on every search page by CQLStream.
+const searchStreamPageSize = 50
+
+// searchContentIDs walks the search pages using the cursor and sends the ID of every content found.
+func (i *internalSearchImpl) searchContentIDs(ctx context.Context, cql string, ids chan<- string) error {
+
+	options := &model.SearchContentOptions{Limit: searchStreamPageSize}
+
+	for {
+
+		page, _, err := i.Content(ctx, cql, options)
+		if err != nil {
+			return err
+		}
+
+		for _, result := range page.Results {
+
+			if result.Content == nil || result.Content.ID == "" {
+				continue
+			}
+
+			select {
+			case ids <- result.Content.ID:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		if page.Links == nil || page.Links.Next == "" {
+			return nil
+		}
+
+		next, err := url.Parse(page.Links.Next)
+		if err != nil {
+			return err
+		}
+
+		options.Cursor = next.Query().Get("cursor")
+		if options.Cursor == "" {
+			return nil
+		}
+	}
+}
+
+func (i *internalSearchImpl) getContent(ctx context.Context, contentID string, expand []string) (*model.ContentScheme, error) {
+
+	endpoint := fmt.Sprintf("wiki/rest/api/content/%v", contentID)
+
+	if len(expand) != 0 {
+		query := url.Values{}
+		query.Add("expand", strings.Join(expand, ","))
+
+		endpoint = fmt.Sprintf("%v?%v", endpoint, query.Encode())
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	content := new(model.ContentScheme)
+	if _, err = i.c.Call(request, content); err != nil {
+		return nil, err
+	}
+
+	return content, nil
+}
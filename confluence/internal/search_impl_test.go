@@ -7,7 +7,9 @@ import (
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
+	"sync"
 	"testing"
 )
 
@@ -267,3 +269,106 @@ func Test_internalSearchImpl_Users(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalSearchImpl_CQLStream(t *testing.T) {
+
+	newClient := func(t *testing.T) *mocks.Connector {
+
+		client := mocks.NewConnector(t)
+
+		pages := []struct {
+			endpoint string
+			page     *model.SearchPageScheme
+		}{
+			{
+				endpoint: "wiki/rest/api/search?cql=type+%3D+page&limit=50",
+				page: &model.SearchPageScheme{
+					Results: []*model.SearchResultScheme{{Content: &model.ContentScheme{ID: "100001"}}, {Content: &model.ContentScheme{ID: "100002"}}},
+					Links:   &model.SearchPageLinksScheme{Next: "/rest/api/search?cql=type+%3D+page&limit=50&cursor=raNDoMsTRiNg"},
+				},
+			},
+			{
+				endpoint: "wiki/rest/api/search?cql=type+%3D+page&cursor=raNDoMsTRiNg&limit=50",
+				page: &model.SearchPageScheme{
+					Results: []*model.SearchResultScheme{{Content: &model.ContentScheme{ID: "100003"}}, {User: &model.ContentUserScheme{}}},
+				},
+			},
+		}
+
+		for _, page := range pages {
+
+			request := &http.Request{RequestURI: page.endpoint}
+			result := page.page
+
+			client.On("NewRequest", mock.Anything, http.MethodGet, page.endpoint, "", nil).
+				Return(request, nil).Maybe()
+
+			client.On("Call", request, &model.SearchPageScheme{}).
+				Run(func(args mock.Arguments) {
+					*args.Get(1).(*model.SearchPageScheme) = *result
+				}).
+				Return(&model.ResponseScheme{}, nil).Maybe()
+		}
+
+		for _, contentID := range []string{"100001", "100002", "100003"} {
+
+			endpoint := "wiki/rest/api/content/" + contentID + "?expand=body.storage"
+			request := &http.Request{RequestURI: endpoint}
+			id := contentID
+
+			client.On("NewRequest", mock.Anything, http.MethodGet, endpoint, "", nil).
+				Return(request, nil).Maybe()
+
+			client.On("Call", request, &model.ContentScheme{}).
+				Run(func(args mock.Arguments) {
+					args.Get(1).(*model.ContentScheme).ID = id
+				}).
+				Return(&model.ResponseScheme{}, nil).Maybe()
+		}
+
+		return client
+	}
+
+	t.Run("when every content is streamed", func(t *testing.T) {
+
+		newService := NewSearchService(newClient(t))
+
+		var (
+			mu  sync.Mutex
+			ids []string
+		)
+
+		err := newService.CQLStream(context.Background(), "type = page", []string{"body.storage"}, 2, func(content *model.ContentScheme) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			ids = append(ids, content.ID)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"100001", "100002", "100003"}, ids)
+	})
+
+	t.Run("when the callback fails", func(t *testing.T) {
+
+		newService := NewSearchService(newClient(t))
+
+		var calls int
+		err := newService.CQLStream(context.Background(), "type = page", []string{"body.storage"}, 2, func(content *model.ContentScheme) error {
+			calls++
+			return errors.New("error, unable to export the content")
+		})
+
+		assert.EqualError(t, err, "error, unable to export the content")
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("when the cql is not provided", func(t *testing.T) {
+
+		newService := NewSearchService(mocks.NewConnector(t))
+
+		err := newService.CQLStream(context.Background(), "", nil, 2, func(content *model.ContentScheme) error { return nil })
+		assert.True(t, errors.Is(err, model.ErrNoCQL))
+	})
+}
@@ -2,6 +2,7 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -77,7 +78,7 @@ func (s *SpaceService) Update(ctx context.Context, spaceKey string, payload *mod
 //
 // Therefore, the space may not be deleted yet when this method has returned.
 //
-// Clients should poll the status link that is returned to the response until the task completes.
+// Clients should poll the status link that is returned to the response until the task completes, e.g. with LongTask.Await.
 //
 // DELETE /wiki/rest/api/space/{spaceKey}
 //
@@ -108,6 +109,84 @@ func (s *SpaceService) ContentByType(ctx context.Context, spaceKey, contentType,
 	return s.internalClient.ContentByType(ctx, spaceKey, contentType, depth, expand, startAt, maxResults)
 }
 
+// GetLabels returns the labels of a space.
+//
+// The labels can be filtered by prefix, valid values: global, my, team.
+//
+// GET /wiki/rest/api/space/{spaceKey}/label
+//
+// https://docs.go-atlassian.io/confluence-cloud/space#get-space-labels
+func (s *SpaceService) GetLabels(ctx context.Context, spaceKey, prefix string, startAt, maxResults int) (*model.ContentLabelPageScheme, *model.ResponseScheme, error) {
+	return s.internalClient.GetLabels(ctx, spaceKey, prefix, startAt, maxResults)
+}
+
+// AddLabels adds labels to a space.
+//
+// Labels without a prefix are added using the global prefix.
+//
+// POST /wiki/rest/api/space/{spaceKey}/label
+//
+// https://docs.go-atlassian.io/confluence-cloud/space#add-labels-to-space
+func (s *SpaceService) AddLabels(ctx context.Context, spaceKey string, payload []*model.ContentLabelPayloadScheme) (*model.ContentLabelPageScheme, *model.ResponseScheme, error) {
+	return s.internalClient.AddLabels(ctx, spaceKey, payload)
+}
+
+// RemoveLabel removes a label from a space.
+//
+// The prefix is optional, labels are removed from the global prefix by default.
+//
+// DELETE /wiki/rest/api/space/{spaceKey}/label
+//
+// https://docs.go-atlassian.io/confluence-cloud/space#remove-label-from-space
+func (s *SpaceService) RemoveLabel(ctx context.Context, spaceKey, labelName, prefix string) (*model.ResponseScheme, error) {
+	return s.internalClient.RemoveLabel(ctx, spaceKey, labelName, prefix)
+}
+
+// AnonymousAccess returns whether anonymous users can view a space.
+//
+// The access is granted when the space permissions include the read operation for anonymous users.
+//
+// GET /wiki/rest/api/space/{spaceKey}?expand=permissions
+//
+// https://docs.go-atlassian.io/confluence-cloud/space#get-space-anonymous-access
+func (s *SpaceService) AnonymousAccess(ctx context.Context, spaceKey string) (*model.AnonymousAccessScheme, *model.ResponseScheme, error) {
+	return s.internalClient.AnonymousAccess(ctx, spaceKey)
+}
+
+// Exists checks if a space key is taken, use it to verify a key is available before creating a space.
+//
+// The space not found is reported as false instead of an error, any other error is returned.
+//
+// GET /wiki/rest/api/space/{spaceKey}
+//
+// https://docs.go-atlassian.io/confluence-cloud/space#check-space-exists
+func (s *SpaceService) Exists(ctx context.Context, spaceKey string) (bool, *model.ResponseScheme, error) {
+	return s.internalClient.Exists(ctx, spaceKey)
+}
+
+// ContentStates returns the content states available on a space, they can be applied to the contents of the space.
+//
+// GET /wiki/rest/api/space/{spaceKey}/state
+//
+// https://docs.go-atlassian.io/confluence-cloud/space#get-space-content-states
+func (s *SpaceService) ContentStates(ctx context.Context, spaceKey string) ([]*model.ContentStateScheme, *model.ResponseScheme, error) {
+	return s.internalClient.ContentStates(ctx, spaceKey)
+}
+
+// ApplyPermissionTemplate grants every operation of the template to every principal of the template on a space.
+//
+// The permissions already granted by the space are skipped, so the template can be applied many times. The first
+// permission failed stops the operation, the permissions added before it are kept.
+//
+// GET /wiki/rest/api/space/{spaceKey}?expand=permissions
+//
+// POST /wiki/rest/api/space/{spaceKey}/permission
+//
+// https://docs.go-atlassian.io/confluence-cloud/space#apply-space-permission-template
+func (s *SpaceService) ApplyPermissionTemplate(ctx context.Context, spaceKey string, template *model.SpacePermissionTemplate) (*model.SpacePermissionTemplateResultScheme, *model.ResponseScheme, error) {
+	return s.internalClient.ApplyPermissionTemplate(ctx, spaceKey, template)
+}
+
 type internalSpaceImpl struct {
 	c service.Connector
 }
@@ -177,6 +256,10 @@ func (i *internalSpaceImpl) Gets(ctx context.Context, options *model.GetSpacesOp
 
 func (i *internalSpaceImpl) Create(ctx context.Context, payload *model.CreateSpaceScheme, private bool) (*model.SpaceScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	if payload != nil {
 
 		if payload.Name == "" {
@@ -246,6 +329,10 @@ func (i *internalSpaceImpl) Update(ctx context.Context, spaceKey string, payload
 		return nil, nil, model.ErrNoSpaceKey
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("wiki/rest/api/space/%v", spaceKey)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -351,3 +438,231 @@ func (i *internalSpaceImpl) ContentByType(ctx context.Context, spaceKey, content
 
 	return page, response, nil
 }
+
+func (i *internalSpaceImpl) GetLabels(ctx context.Context, spaceKey, prefix string, startAt, maxResults int) (*model.ContentLabelPageScheme, *model.ResponseScheme, error) {
+
+	if spaceKey == "" {
+		return nil, nil, model.ErrNoSpaceKey
+	}
+
+	query := url.Values{}
+	query.Add("start", strconv.Itoa(startAt))
+	query.Add("limit", strconv.Itoa(maxResults))
+
+	if prefix != "" {
+		query.Add("prefix", prefix)
+	}
+
+	endpoint := fmt.Sprintf("wiki/rest/api/space/%v/label?%v", spaceKey, query.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.ContentLabelPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalSpaceImpl) AddLabels(ctx context.Context, spaceKey string, payload []*model.ContentLabelPayloadScheme) (*model.ContentLabelPageScheme, *model.ResponseScheme, error) {
+
+	if spaceKey == "" {
+		return nil, nil, model.ErrNoSpaceKey
+	}
+
+	if len(payload) == 0 {
+		return nil, nil, model.ErrNoContentLabel
+	}
+
+	endpoint := fmt.Sprintf("wiki/rest/api/space/%v/label", spaceKey)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.ContentLabelPageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+func (i *internalSpaceImpl) RemoveLabel(ctx context.Context, spaceKey, labelName, prefix string) (*model.ResponseScheme, error) {
+
+	if spaceKey == "" {
+		return nil, model.ErrNoSpaceKey
+	}
+
+	if labelName == "" {
+		return nil, model.ErrNoContentLabel
+	}
+
+	query := url.Values{}
+	query.Add("name", labelName)
+
+	if prefix != "" {
+		query.Add("prefix", prefix)
+	}
+
+	endpoint := fmt.Sprintf("wiki/rest/api/space/%v/label?%v", spaceKey, query.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalSpaceImpl) AnonymousAccess(ctx context.Context, spaceKey string) (*model.AnonymousAccessScheme, *model.ResponseScheme, error) {
+
+	space, response, err := i.Get(ctx, spaceKey, []string{"permissions"})
+	if err != nil {
+		return nil, response, err
+	}
+
+	for _, permission := range space.Permissions {
+
+		if permission == nil || !permission.AnonymousAccess || permission.Operation == nil {
+			continue
+		}
+
+		if permission.Operation.Operation == "read" && permission.Operation.TargetType == "space" {
+			return &model.AnonymousAccessScheme{
+				Granted: true,
+				Level:   model.AnonymousAccessSpaceLevel,
+				Reason:  fmt.Sprintf("the space %v grants the read permission to anonymous users", spaceKey),
+			}, response, nil
+		}
+	}
+
+	return &model.AnonymousAccessScheme{
+		Granted: false,
+		Level:   model.AnonymousAccessSpaceLevel,
+		Reason:  fmt.Sprintf("the space %v does not grant the read permission to anonymous users", spaceKey),
+	}, response, nil
+}
+
+func (i *internalSpaceImpl) Exists(ctx context.Context, spaceKey string) (bool, *model.ResponseScheme, error) {
+
+	_, response, err := i.Get(ctx, spaceKey, nil)
+	if err != nil {
+
+		if errors.Is(err, model.ErrNotFound) {
+			return false, response, nil
+		}
+
+		return false, response, err
+	}
+
+	return true, response, nil
+}
+
+func (i *internalSpaceImpl) ContentStates(ctx context.Context, spaceKey string) ([]*model.ContentStateScheme, *model.ResponseScheme, error) {
+
+	if spaceKey == "" {
+		return nil, nil, model.ErrNoSpaceKey
+	}
+
+	endpoint := fmt.Sprintf("wiki/rest/api/space/%v/state", spaceKey)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var states []*model.ContentStateScheme
+	response, err := i.c.Call(request, &states)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return states, response, nil
+}
+
+func (i *internalSpaceImpl) ApplyPermissionTemplate(ctx context.Context, spaceKey string, template *model.SpacePermissionTemplate) (*model.SpacePermissionTemplateResultScheme, *model.ResponseScheme, error) {
+
+	if spaceKey == "" {
+		return nil, nil, model.ErrNoSpaceKey
+	}
+
+	if template == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
+	if len(template.Principals) == 0 {
+		return nil, nil, model.ErrNoPermissionPrincipals
+	}
+
+	if len(template.Operations) == 0 {
+		return nil, nil, model.ErrNoPermissionOperations
+	}
+
+	space, response, err := i.Get(ctx, spaceKey, []string{"permissions"})
+	if err != nil {
+		return nil, response, err
+	}
+
+	granted := make(map[string]bool)
+	for _, permission := range space.Permissions {
+
+		if permission == nil || permission.Subject == nil || permission.Operation == nil {
+			continue
+		}
+
+		if permission.Subject.User != nil {
+			for _, user := range permission.Subject.User.Results {
+				granted[spacePermissionKey("user", user.AccountID, permission.Operation.Operation, permission.Operation.TargetType)] = true
+			}
+		}
+
+		if permission.Subject.Group != nil {
+			for _, group := range permission.Subject.Group.Results {
+				granted[spacePermissionKey("group", group.Name, permission.Operation.Operation, permission.Operation.TargetType)] = true
+				granted[spacePermissionKey("group", group.ID, permission.Operation.Operation, permission.Operation.TargetType)] = true
+			}
+		}
+	}
+
+	result := &model.SpacePermissionTemplateResultScheme{Template: template.Name}
+	permissions := &internalSpacePermissionImpl{c: i.c}
+
+	for _, principal := range template.Principals {
+		for _, operation := range template.Operations {
+
+			payload := &model.SpacePermissionPayloadScheme{
+				Subject:   &model.PermissionSubjectScheme{Type: principal.Type, Identifier: principal.Identifier},
+				Operation: &model.SpacePermissionOperationScheme{Key: operation.Key, Target: operation.Target},
+			}
+
+			key := spacePermissionKey(principal.Type, principal.Identifier, operation.Key, operation.Target)
+			if granted[key] {
+				result.Skipped = append(result.Skipped, payload)
+				continue
+			}
+
+			_, response, err = permissions.Add(ctx, spaceKey, payload)
+			if err != nil {
+				return nil, response, err
+			}
+
+			granted[key] = true
+			result.Applied = append(result.Applied, payload)
+		}
+	}
+
+	return result, response, nil
+}
+
+// spacePermissionKey identifies a space permission by its subject and its operation.
+func spacePermissionKey(subjectType, identifier, operation, target string) string {
+	return strings.Join([]string{subjectType, identifier, operation, target}, "/")
+}
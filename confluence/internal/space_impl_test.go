@@ -7,6 +7,7 @@ import (
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"testing"
 )
@@ -707,6 +708,19 @@ func Test_internalSpaceImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoSpaceKey,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:     context.Background(),
+				private: true,
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -825,6 +839,19 @@ func Test_internalSpaceImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     model.ErrNoSpaceKey,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -856,3 +883,922 @@ func Test_internalSpaceImpl_Update(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalSpaceImpl_GetLabels(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx        context.Context
+		spaceKey   string
+		prefix     string
+		startAt    int
+		maxResults int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:        context.Background(),
+				spaceKey:   "DUMMY",
+				prefix:     "team",
+				startAt:    50,
+				maxResults: 25,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/space/DUMMY/label?limit=25&prefix=team&start=50",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentLabelPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the space key is not provided",
+			args: args{
+				ctx:        context.Background(),
+				spaceKey:   "",
+				prefix:     "team",
+				startAt:    50,
+				maxResults: 25,
+			},
+			wantErr: true,
+			Err:     model.ErrNoSpaceKey,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:        context.Background(),
+				spaceKey:   "DUMMY",
+				prefix:     "team",
+				startAt:    50,
+				maxResults: 25,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/space/DUMMY/label?limit=25&prefix=team&start=50",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewSpaceService(testCase.fields.c, nil)
+
+			gotResult, gotResponse, err := newService.GetLabels(testCase.args.ctx, testCase.args.spaceKey, testCase.args.prefix, testCase.args.startAt,
+				testCase.args.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalSpaceImpl_AddLabels(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx      context.Context
+		spaceKey string
+		payload  []*model.ContentLabelPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+				payload:  []*model.ContentLabelPayloadScheme{{Prefix: "team", Name: "department-finance"}},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/space/DUMMY/label",
+					"",
+					[]*model.ContentLabelPayloadScheme{{Prefix: "team", Name: "department-finance"}}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentLabelPageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the space key is not provided",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "",
+				payload:  []*model.ContentLabelPayloadScheme{{Prefix: "team", Name: "department-finance"}},
+			},
+			wantErr: true,
+			Err:     model.ErrNoSpaceKey,
+		},
+
+		{
+			name: "when the labels are not provided",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+				payload:  nil,
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentLabel,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+				payload:  []*model.ContentLabelPayloadScheme{{Prefix: "team", Name: "department-finance"}},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"wiki/rest/api/space/DUMMY/label",
+					"",
+					[]*model.ContentLabelPayloadScheme{{Prefix: "team", Name: "department-finance"}}).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewSpaceService(testCase.fields.c, nil)
+
+			gotResult, gotResponse, err := newService.AddLabels(testCase.args.ctx, testCase.args.spaceKey, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalSpaceImpl_RemoveLabel(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx       context.Context
+		spaceKey  string
+		labelName string
+		prefix    string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:       context.Background(),
+				spaceKey:  "DUMMY",
+				labelName: "department-finance",
+				prefix:    "team",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"wiki/rest/api/space/DUMMY/label?name=department-finance&prefix=team",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the space key is not provided",
+			args: args{
+				ctx:       context.Background(),
+				spaceKey:  "",
+				labelName: "department-finance",
+				prefix:    "team",
+			},
+			wantErr: true,
+			Err:     model.ErrNoSpaceKey,
+		},
+
+		{
+			name: "when the label name is not provided",
+			args: args{
+				ctx:       context.Background(),
+				spaceKey:  "DUMMY",
+				labelName: "",
+				prefix:    "team",
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentLabel,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:       context.Background(),
+				spaceKey:  "DUMMY",
+				labelName: "department-finance",
+				prefix:    "team",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"wiki/rest/api/space/DUMMY/label?name=department-finance&prefix=team",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewSpaceService(testCase.fields.c, nil)
+
+			gotResponse, err := newService.RemoveLabel(testCase.args.ctx, testCase.args.spaceKey, testCase.args.labelName, testCase.args.prefix)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalSpaceImpl_AnonymousAccess(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx      context.Context
+		spaceKey string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the space grants the read permission to anonymous users",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/space/DUMMY?expand=permissions",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SpaceScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.SpaceScheme).Permissions = []*model.SpacePermissionScheme{
+							{AnonymousAccess: true, Operation: &model.OperationPermissionScheme{Operation: "read", TargetType: "space"}},
+						}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the space does not grant the read permission to anonymous users",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/space/DUMMY?expand=permissions",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SpaceScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the space key is not provided",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoSpaceKey,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/space/DUMMY?expand=permissions",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewSpaceService(testCase.fields.c, nil)
+
+			gotResult, gotResponse, err := newService.AnonymousAccess(testCase.args.ctx, testCase.args.spaceKey)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalSpaceImpl_Exists(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		spaceKey string
+		err      error
+		want     bool
+		wantErr  bool
+		Err      error
+	}{
+		{
+			name:     "when the space exists",
+			spaceKey: "DUMMY",
+			want:     true,
+		},
+
+		{
+			name:     "when the space does not exist",
+			spaceKey: "DUMMY",
+			err:      model.ErrNotFound,
+		},
+
+		{
+			name:     "when the space cannot be fetched",
+			spaceKey: "DUMMY",
+			err:      model.ErrUnauthorized,
+			wantErr:  true,
+			Err:      model.ErrUnauthorized,
+		},
+
+		{
+			name:    "when the space key is not provided",
+			wantErr: true,
+			Err:     model.ErrNoSpaceKey,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			client := mocks.NewConnector(t)
+
+			if testCase.spaceKey != "" {
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/space/DUMMY",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SpaceScheme{}).
+					Return(&model.ResponseScheme{}, testCase.err)
+			}
+
+			newService := NewSpaceService(client, nil)
+
+			gotResult, gotResponse, err := newService.Exists(context.Background(), testCase.spaceKey)
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err))
+				assert.False(t, gotResult)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, gotResponse)
+			assert.Equal(t, testCase.want, gotResult)
+		})
+	}
+}
+
+func Test_internalSpaceImpl_ContentStates(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx      context.Context
+		spaceKey string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/space/DUMMY/state",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					mock.Anything).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the space key is not provided",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoSpaceKey,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/space/DUMMY/state",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewSpaceService(testCase.fields.c, nil)
+
+			gotResult, gotResponse, err := newService.ContentStates(testCase.args.ctx, testCase.args.spaceKey)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalSpaceImpl_ApplyPermissionTemplate(t *testing.T) {
+
+	template := &model.SpacePermissionTemplate{
+		Name: "team-space",
+		Principals: []*model.PermissionSubjectScheme{
+			{Type: "group", Identifier: "confluence-users"},
+			{Type: "user", Identifier: "account-id-sample"},
+		},
+		Operations: []*model.SpacePermissionOperationScheme{
+			{Key: "read", Target: "space"},
+			{Key: "create", Target: "page"},
+		},
+	}
+
+	permissions := []*model.SpacePermissionScheme{
+		{
+			Subject: &model.SubjectPermissionScheme{
+				Group: &model.GroupPermissionScheme{Results: []*model.SpaceGroupScheme{{Name: "confluence-users", ID: "group-id-sample"}}},
+			},
+			Operation: &model.OperationPermissionScheme{Operation: "read", TargetType: "space"},
+		},
+	}
+
+	addPermission := func(client *mocks.Connector, subjectType, identifier, operation, target string) *mock.Call {
+		return client.On("NewRequest",
+			context.Background(),
+			http.MethodPost,
+			"wiki/rest/api/space/DUMMY/permission",
+			"",
+			&model.SpacePermissionPayloadScheme{
+				Subject:   &model.PermissionSubjectScheme{Type: subjectType, Identifier: identifier},
+				Operation: &model.SpacePermissionOperationScheme{Key: operation, Target: target},
+			})
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx      context.Context
+		spaceKey string
+		template *model.SpacePermissionTemplate
+	}
+
+	testCases := []struct {
+		name        string
+		fields      fields
+		args        args
+		on          func(*fields)
+		wantApplied int
+		wantSkipped int
+		wantErr     bool
+		Err         error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+				template: template,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/space/DUMMY?expand=permissions",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SpaceScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.SpaceScheme).Permissions = permissions
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				addPermission(client, "group", "confluence-users", "create", "page").Return(&http.Request{}, nil)
+				addPermission(client, "user", "account-id-sample", "read", "space").Return(&http.Request{}, nil)
+				addPermission(client, "user", "account-id-sample", "create", "page").Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SpacePermissionV2Scheme{}).
+					Return(&model.ResponseScheme{}, nil).
+					Times(3)
+
+				fields.c = client
+			},
+			wantApplied: 3,
+			wantSkipped: 1,
+		},
+
+		{
+			name: "when the permission cannot be added",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+				template: template,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/space/DUMMY?expand=permissions",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SpaceScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				addPermission(client, "group", "confluence-users", "read", "space").Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SpacePermissionV2Scheme{}).
+					Return(&model.ResponseScheme{}, errors.New("error, request failed"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, request failed"),
+		},
+
+		{
+			name: "when the space key is not provided",
+			args: args{
+				ctx:      context.Background(),
+				template: template,
+			},
+			wantErr: true,
+			Err:     model.ErrNoSpaceKey,
+		},
+
+		{
+			name: "when the template is not provided",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
+
+		{
+			name: "when the template principals are not provided",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+				template: &model.SpacePermissionTemplate{Operations: template.Operations},
+			},
+			wantErr: true,
+			Err:     model.ErrNoPermissionPrincipals,
+		},
+
+		{
+			name: "when the template operations are not provided",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+				template: &model.SpacePermissionTemplate{Principals: template.Principals},
+			},
+			wantErr: true,
+			Err:     model.ErrNoPermissionOperations,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			spaceService := NewSpaceService(testCase.fields.c, nil)
+
+			gotResult, gotResponse, err := spaceService.ApplyPermissionTemplate(testCase.args.ctx, testCase.args.spaceKey, testCase.args.template)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, "team-space", gotResult.Template)
+				assert.Len(t, gotResult.Applied, testCase.wantApplied)
+				assert.Len(t, gotResult.Skipped, testCase.wantSkipped)
+			}
+
+		})
+	}
+}
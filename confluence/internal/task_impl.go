@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/task"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
 	"net/http"
@@ -47,6 +48,17 @@ func (t *TaskService) Get(ctx context.Context, taskID string) (*model.LongTaskSc
 	return t.internalClient.Get(ctx, taskID)
 }
 
+// Await polls a long-running task until it finishes, e.g. the tasks of the space deletion or the page hierarchy copy.
+//
+// The unsuccessful tasks return an error wrapping model.ErrTaskFailed, see task.Await for the polling.
+//
+// GET /wiki/rest/api/longtask/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/long-task#get-long-running-task
+func (t *TaskService) Await(ctx context.Context, taskID string, options ...task.Option) (*model.LongTaskScheme, error) {
+	return task.Await(ctx, task.ConfluenceLongTask(t, taskID), options...)
+}
+
 type internalTaskImpl struct {
 	c service.Connector
 }
@@ -2,7 +2,12 @@ package internal
 
 import (
 	"context"
+	"fmt"
+	"html"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -50,6 +55,61 @@ func (t *TemplateService) Get(ctx context.Context, templateID string) (*models.C
 	return t.internalClient.Get(ctx, templateID)
 }
 
+// Render returns the template storage body with the @@VARIABLE@@ placeholders replaced by the variables values.
+//
+// The values are escaped, so they're rendered as text and can't inject markup on the storage body.
+//
+// The rendered content can be used as the body of a new page, the placeholders without a variable are kept.
+//
+// GET /wiki/rest/api/template/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/template#render-content-template
+func (t *TemplateService) Render(ctx context.Context, templateID string, variables map[string]string) (*models.ContentScheme, *models.ResponseScheme, error) {
+	return t.internalClient.Render(ctx, templateID, variables)
+}
+
+// CreateFromTemplate creates a content using the rendered body of a template.
+//
+// The payload provides the type, title, space and ancestors of the new content, its body is overridden by the rendered template.
+//
+// POST /wiki/rest/api/content
+//
+// https://docs.go-atlassian.io/confluence-cloud/template#create-content-from-template
+func (t *TemplateService) CreateFromTemplate(ctx context.Context, templateID string, variables map[string]string, payload *models.ContentScheme) (*models.ContentScheme, *models.ResponseScheme, error) {
+	return t.internalClient.CreateFromTemplate(ctx, templateID, variables, payload)
+}
+
+// Gets returns the content templates of a space, or the global content templates if no space key is provided.
+//
+// GET /wiki/rest/api/template/page
+//
+// https://docs.go-atlassian.io/confluence-cloud/template#get-content-templates
+func (t *TemplateService) Gets(ctx context.Context, spaceKey string, start, limit int) (*models.ContentTemplatePageScheme, *models.ResponseScheme, error) {
+	return t.internalClient.Gets(ctx, spaceKey, start, limit)
+}
+
+// Blueprints returns the blueprint templates of a space, or the global blueprint templates if no space key is provided.
+//
+// The blueprint of every template is referenced by its OriginalTemplate plugin and module keys.
+//
+// GET /wiki/rest/api/template/blueprint
+//
+// https://docs.go-atlassian.io/confluence-cloud/template#get-blueprint-templates
+func (t *TemplateService) Blueprints(ctx context.Context, spaceKey string, start, limit int) (*models.ContentTemplatePageScheme, *models.ResponseScheme, error) {
+	return t.internalClient.Blueprints(ctx, spaceKey, start, limit)
+}
+
+// CreateFromBlueprint publishes the draft created from a blueprint, e.g. by the blueprint wizard.
+//
+// The draft ID is the ID of the content created from the blueprint, the payload sets its title, space and parent.
+//
+// POST /wiki/rest/api/content/blueprint/instance/{draftID}
+//
+// https://docs.go-atlassian.io/confluence-cloud/template#publish-blueprint-draft
+func (t *TemplateService) CreateFromBlueprint(ctx context.Context, draftID string, payload *models.ContentBlueprintDraftScheme) (*models.ContentScheme, *models.ResponseScheme, error) {
+	return t.internalClient.CreateFromBlueprint(ctx, draftID, payload)
+}
+
 // internalTemplateImpl is the internal implementation of TemplateService.
 type internalTemplateImpl struct {
 	c service.Connector
@@ -57,6 +117,10 @@ type internalTemplateImpl struct {
 
 // Create implements TemplateService.Create.
 func (i *internalTemplateImpl) Create(ctx context.Context, payload *models.CreateTemplateScheme) (*models.ContentTemplateScheme, *models.ResponseScheme, error) {
+	if payload == nil {
+		return nil, nil, models.ErrNilPayload
+	}
+
 	endpoint := "/wiki/rest/api/template"
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -75,6 +139,10 @@ func (i *internalTemplateImpl) Create(ctx context.Context, payload *models.Creat
 
 // Update implements TemplateService.Update.
 func (i *internalTemplateImpl) Update(ctx context.Context, payload *models.UpdateTemplateScheme) (*models.ContentTemplateScheme, *models.ResponseScheme, error) {
+	if payload == nil {
+		return nil, nil, models.ErrNilPayload
+	}
+
 	endpoint := "/wiki/rest/api/template"
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -108,3 +176,133 @@ func (i *internalTemplateImpl) Get(ctx context.Context, templateID string) (*mod
 
 	return result, response, nil
 }
+
+// Render implements TemplateService.Render.
+func (i *internalTemplateImpl) Render(ctx context.Context, templateID string, variables map[string]string) (*models.ContentScheme, *models.ResponseScheme, error) {
+
+	if templateID == "" {
+		return nil, nil, models.ErrNoTemplateID
+	}
+
+	template, response, err := i.Get(ctx, templateID)
+	if err != nil {
+		return nil, response, err
+	}
+
+	if template.Body == nil || template.Body.Storage == nil {
+		return nil, response, models.ErrNoTemplateStorageBody
+	}
+
+	replacements := make([]string, 0, len(variables)*2)
+	for name, value := range variables {
+		replacements = append(replacements, "@@"+name+"@@", html.EscapeString(value))
+	}
+
+	content := &models.ContentScheme{
+		Type: "page",
+		Body: &models.BodyScheme{
+			Storage: &models.BodyNodeScheme{
+				Value:          strings.NewReplacer(replacements...).Replace(template.Body.Storage.Value),
+				Representation: "storage",
+			},
+		},
+	}
+
+	return content, response, nil
+}
+
+// CreateFromTemplate implements TemplateService.CreateFromTemplate.
+func (i *internalTemplateImpl) CreateFromTemplate(ctx context.Context, templateID string, variables map[string]string, payload *models.ContentScheme) (*models.ContentScheme, *models.ResponseScheme, error) {
+
+	if payload == nil {
+		return nil, nil, models.ErrNilPayload
+	}
+
+	rendered, response, err := i.Render(ctx, templateID, variables)
+	if err != nil {
+		return nil, response, err
+	}
+
+	content := *payload
+	content.Body = rendered.Body
+
+	if content.Type == "" {
+		content.Type = rendered.Type
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, "/wiki/rest/api/content", "", &content)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(models.ContentScheme)
+	response, err = i.c.Call(request, result)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return result, response, nil
+}
+
+// Gets implements TemplateService.Gets.
+func (i *internalTemplateImpl) Gets(ctx context.Context, spaceKey string, start, limit int) (*models.ContentTemplatePageScheme, *models.ResponseScheme, error) {
+	return i.list(ctx, "page", spaceKey, start, limit)
+}
+
+// Blueprints implements TemplateService.Blueprints.
+func (i *internalTemplateImpl) Blueprints(ctx context.Context, spaceKey string, start, limit int) (*models.ContentTemplatePageScheme, *models.ResponseScheme, error) {
+	return i.list(ctx, "blueprint", spaceKey, start, limit)
+}
+
+func (i *internalTemplateImpl) list(ctx context.Context, kind, spaceKey string, start, limit int) (*models.ContentTemplatePageScheme, *models.ResponseScheme, error) {
+
+	query := url.Values{}
+	query.Add("start", strconv.Itoa(start))
+	query.Add("limit", strconv.Itoa(limit))
+
+	if spaceKey != "" {
+		query.Add("spaceKey", spaceKey)
+	}
+
+	endpoint := fmt.Sprintf("/wiki/rest/api/template/%v?%v", kind, query.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(models.ContentTemplatePageScheme)
+	response, err := i.c.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// CreateFromBlueprint implements TemplateService.CreateFromBlueprint.
+func (i *internalTemplateImpl) CreateFromBlueprint(ctx context.Context, draftID string, payload *models.ContentBlueprintDraftScheme) (*models.ContentScheme, *models.ResponseScheme, error) {
+
+	if draftID == "" {
+		return nil, nil, models.ErrNoContentID
+	}
+
+	if payload == nil {
+		return nil, nil, models.ErrNilPayload
+	}
+
+	endpoint := fmt.Sprintf("/wiki/rest/api/content/blueprint/instance/%v", draftID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content := new(models.ContentScheme)
+	response, err := i.c.Call(request, content)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return content, response, nil
+}
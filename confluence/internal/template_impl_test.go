@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -129,6 +130,18 @@ func Test_internalTemplateImpl_Create(t *testing.T) {
 			},
 			wantErr: false,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     models.ErrNilPayload,
+		},
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -271,6 +284,18 @@ func Test_internalTemplateImpl_Update(t *testing.T) {
 			},
 			wantErr: false,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     models.ErrNilPayload,
+		},
 	}
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -378,3 +403,630 @@ func Test_internalTemplateImpl_Get(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalTemplateImpl_Render(t *testing.T) {
+	type fields struct {
+		c service.Connector
+	}
+	type args struct {
+		ctx        context.Context
+		templateID string
+		variables  map[string]string
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    string
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "TemplateService.Render success",
+			args: args{
+				ctx:        context.Background(),
+				templateID: "123456789",
+				variables:  map[string]string{"OWNER": "Carlos", "DATE": "2024-01-01"},
+			},
+			on: func(fields *fields) {
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"/wiki/rest/api/template/123456789",
+					"",
+					nil,
+				).
+					Return(&http.Request{}, nil)
+
+				client.On("Call", &http.Request{}, &models.ContentTemplateScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*models.ContentTemplateScheme).Body = &models.ContentTemplateBodySchema{
+							Storage: &models.ContentBodyCreateScheme{
+								Value:          "<p>Owner: @@OWNER@@, due @@DATE@@, reviewer @@REVIEWER@@</p>",
+								Representation: "storage",
+							},
+						}
+					}).
+					Return(&models.ResponseScheme{Code: 200}, nil)
+
+				fields.c = client
+			},
+			want: "<p>Owner: Carlos, due 2024-01-01, reviewer @@REVIEWER@@</p>",
+		},
+		{
+			name: "TemplateService.Render escapes the variables values",
+			args: args{
+				ctx:        context.Background(),
+				templateID: "123456789",
+				variables:  map[string]string{"OWNER": `<script>R&D "ops"</script>`},
+			},
+			on: func(fields *fields) {
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"/wiki/rest/api/template/123456789",
+					"",
+					nil,
+				).
+					Return(&http.Request{}, nil)
+
+				client.On("Call", &http.Request{}, &models.ContentTemplateScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*models.ContentTemplateScheme).Body = &models.ContentTemplateBodySchema{
+							Storage: &models.ContentBodyCreateScheme{
+								Value:          "<p>Owner: @@OWNER@@</p>",
+								Representation: "storage",
+							},
+						}
+					}).
+					Return(&models.ResponseScheme{Code: 200}, nil)
+
+				fields.c = client
+			},
+			want: "<p>Owner: &lt;script&gt;R&amp;D &#34;ops&#34;&lt;/script&gt;</p>",
+		},
+		{
+			name: "TemplateService.Render without storage body",
+			args: args{
+				ctx:        context.Background(),
+				templateID: "123456789",
+			},
+			on: func(fields *fields) {
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"/wiki/rest/api/template/123456789",
+					"",
+					nil,
+				).
+					Return(&http.Request{}, nil)
+
+				client.On("Call", &http.Request{}, &models.ContentTemplateScheme{}).
+					Return(&models.ResponseScheme{Code: 200}, nil)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     models.ErrNoTemplateStorageBody,
+		},
+		{
+			name: "TemplateService.Render without template id",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     models.ErrNoTemplateID,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewTemplateService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Render(testCase.args.ctx, testCase.args.templateID, testCase.args.variables)
+
+			if testCase.wantErr {
+				assert.EqualError(t, err, testCase.Err.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult.Body.Storage.Value)
+			}
+		})
+	}
+}
+
+func Test_internalTemplateImpl_CreateFromTemplate(t *testing.T) {
+	type fields struct {
+		c service.Connector
+	}
+	type args struct {
+		ctx        context.Context
+		templateID string
+		variables  map[string]string
+		payload    *models.ContentScheme
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "TemplateService.CreateFromTemplate success",
+			args: args{
+				ctx:        context.Background(),
+				templateID: "123456789",
+				variables:  map[string]string{"OWNER": "Carlos"},
+				payload: &models.ContentScheme{
+					Title: "Weekly report",
+					Space: &models.SpaceScheme{Key: "TEST"},
+				},
+			},
+			on: func(fields *fields) {
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"/wiki/rest/api/template/123456789",
+					"",
+					nil,
+				).
+					Return(&http.Request{}, nil)
+
+				client.On("Call", &http.Request{}, &models.ContentTemplateScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*models.ContentTemplateScheme).Body = &models.ContentTemplateBodySchema{
+							Storage: &models.ContentBodyCreateScheme{Value: "<p>Owner: @@OWNER@@</p>", Representation: "storage"},
+						}
+					}).
+					Return(&models.ResponseScheme{Code: 200}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"/wiki/rest/api/content",
+					"",
+					&models.ContentScheme{
+						Type:  "page",
+						Title: "Weekly report",
+						Space: &models.SpaceScheme{Key: "TEST"},
+						Body: &models.BodyScheme{
+							Storage: &models.BodyNodeScheme{Value: "<p>Owner: Carlos</p>", Representation: "storage"},
+						},
+					},
+				).
+					Return(&http.Request{}, nil)
+
+				client.On("Call", &http.Request{}, &models.ContentScheme{}).
+					Return(&models.ResponseScheme{Code: 200}, nil)
+
+				fields.c = client
+			},
+			wantErr: false,
+		},
+		{
+			name: "TemplateService.CreateFromTemplate without payload",
+			args: args{
+				ctx:        context.Background(),
+				templateID: "123456789",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     models.ErrNilPayload,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewTemplateService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.CreateFromTemplate(testCase.args.ctx, testCase.args.templateID, testCase.args.variables, testCase.args.payload)
+
+			if testCase.wantErr {
+				assert.EqualError(t, err, testCase.Err.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+		})
+	}
+}
+
+func Test_internalTemplateImpl_Gets(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx      context.Context
+		spaceKey string
+		start    int
+		limit    int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+				start:    0,
+				limit:    25,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"/wiki/rest/api/template/page?limit=25&spaceKey=DUMMY&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&models.ContentTemplatePageScheme{}).
+					Return(&models.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the space key is not provided",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "",
+				start:    0,
+				limit:    25,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"/wiki/rest/api/template/page?limit=25&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&models.ContentTemplatePageScheme{}).
+					Return(&models.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+				start:    0,
+				limit:    25,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"/wiki/rest/api/template/page?limit=25&spaceKey=DUMMY&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewTemplateService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Gets(testCase.args.ctx, testCase.args.spaceKey, testCase.args.start, testCase.args.limit)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalTemplateImpl_Blueprints(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx      context.Context
+		spaceKey string
+		start    int
+		limit    int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+				start:    0,
+				limit:    25,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"/wiki/rest/api/template/blueprint?limit=25&spaceKey=DUMMY&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&models.ContentTemplatePageScheme{}).
+					Return(&models.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:      context.Background(),
+				spaceKey: "DUMMY",
+				start:    0,
+				limit:    25,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"/wiki/rest/api/template/blueprint?limit=25&spaceKey=DUMMY&start=0",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewTemplateService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Blueprints(testCase.args.ctx, testCase.args.spaceKey, testCase.args.start, testCase.args.limit)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalTemplateImpl_CreateFromBlueprint(t *testing.T) {
+
+	payloadMocked := &models.ContentBlueprintDraftScheme{
+		Version: &models.ContentBlueprintDraftVersionScheme{Number: 1},
+		Title:   "Meeting notes",
+		Type:    "page",
+		Space:   &models.SpaceScheme{Key: "DUMMY"},
+	}
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx     context.Context
+		draftID string
+		payload *models.ContentBlueprintDraftScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:     context.Background(),
+				draftID: "65611",
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"/wiki/rest/api/content/blueprint/instance/65611",
+					"",
+					payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&models.ContentScheme{}).
+					Return(&models.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the draft id is not provided",
+			args: args{
+				ctx:     context.Background(),
+				draftID: "",
+				payload: payloadMocked,
+			},
+			wantErr: true,
+			Err:     models.ErrNoContentID,
+		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:     context.Background(),
+				draftID: "65611",
+				payload: nil,
+			},
+			wantErr: true,
+			Err:     models.ErrNilPayload,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:     context.Background(),
+				draftID: "65611",
+				payload: payloadMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"/wiki/rest/api/content/blueprint/instance/65611",
+					"",
+					payloadMocked).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewTemplateService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.CreateFromBlueprint(testCase.args.ctx, testCase.args.draftID, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
@@ -2,12 +2,14 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
 	"net/http"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 )
@@ -57,7 +59,11 @@ func (v *VersionService) Restore(ctx context.Context, contentID string, payload
 //
 // # This does not delete the changes made to the content in that version, rather the changes for the deleted version
 //
-// are rolled up into the next version. Note, you cannot delete the current version.
+// are rolled up into the next version.
+//
+// The current version cannot be deleted, model.ErrCurrentContentVersion is returned without deleting anything.
+//
+// GET /wiki/rest/api/content/{id}/version
 //
 // DELETE /wiki/rest/api/content/{id}/version/{versionNumber}
 //
@@ -66,6 +72,17 @@ func (v *VersionService) Delete(ctx context.Context, contentID string, versionNu
 	return v.internalClient.Delete(ctx, contentID, versionNumber)
 }
 
+// Diff compares the storage bodies of two versions of a piece of content.
+//
+// The differences are returned as an ordered list of equal, added and removed word segments.
+//
+// GET /wiki/rest/api/content/{id}?version={versionNumber}&expand=body.storage
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/versions#diff-content-versions
+func (v *VersionService) Diff(ctx context.Context, contentID string, fromVersion, toVersion int) (*model.ContentDiffScheme, *model.ResponseScheme, error) {
+	return v.internalClient.Diff(ctx, contentID, fromVersion, toVersion)
+}
+
 type internalVersionImpl struct {
 	c service.Connector
 }
@@ -166,6 +183,20 @@ func (i *internalVersionImpl) Delete(ctx context.Context, contentID string, vers
 		return nil, model.ErrNoContentID
 	}
 
+	if versionNumber <= 0 {
+		return nil, model.ErrNoContentVersion
+	}
+
+	// The versions are listed from the newest one, so the first version is the current one.
+	latest, response, err := i.Gets(ctx, contentID, nil, 0, 1)
+	if err != nil {
+		return response, err
+	}
+
+	if len(latest.Results) != 0 && latest.Results[0].Number == versionNumber {
+		return nil, model.ErrCurrentContentVersion
+	}
+
 	endpoint := fmt.Sprintf("wiki/rest/api/content/%v/version/%v", contentID, versionNumber)
 
 	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
@@ -175,3 +206,239 @@ func (i *internalVersionImpl) Delete(ctx context.Context, contentID string, vers
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalVersionImpl) Diff(ctx context.Context, contentID string, fromVersion, toVersion int) (*model.ContentDiffScheme, *model.ResponseScheme, error) {
+
+	if contentID == "" {
+		return nil, nil, model.ErrNoContentID
+	}
+
+	if fromVersion <= 0 || toVersion <= 0 {
+		return nil, nil, model.ErrNoContentVersion
+	}
+
+	from, response, err := i.storageBody(ctx, contentID, fromVersion)
+	if err != nil {
+		return nil, response, err
+	}
+
+	to, response, err := i.storageBody(ctx, contentID, toVersion)
+	if err != nil {
+		return nil, response, err
+	}
+
+	diff := &model.ContentDiffScheme{
+		ContentID:   contentID,
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Segments:    diffContentTokens(contentTokenizer.FindAllString(from, -1), contentTokenizer.FindAllString(to, -1)),
+	}
+
+	for _, segment := range diff.Segments {
+
+		words := len(strings.Fields(segment.Text))
+
+		switch segment.Operation {
+		case model.ContentDiffAdded:
+			diff.Added += words
+		case model.ContentDiffRemoved:
+			diff.Removed += words
+		}
+	}
+
+	return diff, response, nil
+}
+
+func (i *internalVersionImpl) storageBody(ctx context.Context, contentID string, versionNumber int) (string, *model.ResponseScheme, error) {
+
+	query := url.Values{}
+	query.Add("version", strconv.Itoa(versionNumber))
+	query.Add("expand", "body.storage")
+
+	endpoint := fmt.Sprintf("wiki/rest/api/content/%v?%v", contentID, query.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	content := new(model.ContentScheme)
+	response, err := i.c.Call(request, content)
+	if err != nil {
+
+		if errors.Is(err, model.ErrNotFound) {
+			return "", response, fmt.Errorf("%w: %v", model.ErrContentVersionNotFound, versionNumber)
+		}
+
+		return "", response, err
+	}
+
+	if content.Body == nil || content.Body.Storage == nil {
+		return "", response, nil
+	}
+
+	return content.Body.Storage.Value, response, nil
+}
+
+// contentTokenizer splits a storage body into markup tags, whitespaces and words.
+var contentTokenizer = regexp.MustCompile(`<[^>]*>|\s+|[^\s<]+`)
+
+// diffContentTokens computes the shortest edit script between two token lists using the linear space variant of
+// the Myers algorithm, the consecutive tokens sharing the same operation are merged into a single segment.
+func diffContentTokens(from, to []string) []*model.ContentDiffSegmentScheme {
+
+	var segments []*model.ContentDiffSegmentScheme
+	compareContentTokens(from, to, &segments)
+
+	return mergeContentSegments(segments)
+}
+
+// compareContentTokens appends the edit script of the token lists to the segments, the lists are split on the
+// middle snake of the shortest edit script and compared recursively, so the memory used is linear.
+func compareContentTokens(from, to []string, segments *[]*model.ContentDiffSegmentScheme) {
+
+	appendTokens := func(operation model.ContentDiffOperation, tokens []string) {
+		for _, token := range tokens {
+			*segments = append(*segments, &model.ContentDiffSegmentScheme{Operation: operation, Text: token})
+		}
+	}
+
+	prefix := 0
+	for prefix < len(from) && prefix < len(to) && from[prefix] == to[prefix] {
+		prefix++
+	}
+
+	appendTokens(model.ContentDiffEqual, from[:prefix])
+	from, to = from[prefix:], to[prefix:]
+
+	suffix := 0
+	for suffix < len(from) && suffix < len(to) && from[len(from)-suffix-1] == to[len(to)-suffix-1] {
+		suffix++
+	}
+
+	common := from[len(from)-suffix:]
+	from, to = from[:len(from)-suffix], to[:len(to)-suffix]
+
+	switch x, y := contentMiddleSnake(from, to); {
+	case len(from) == 0:
+		appendTokens(model.ContentDiffAdded, to)
+	case len(to) == 0:
+		appendTokens(model.ContentDiffRemoved, from)
+	case x == -1:
+		appendTokens(model.ContentDiffRemoved, from)
+		appendTokens(model.ContentDiffAdded, to)
+	default:
+		compareContentTokens(from[:x], to[:y], segments)
+		compareContentTokens(from[x:], to[y:], segments)
+	}
+
+	appendTokens(model.ContentDiffEqual, common)
+}
+
+// contentMiddleSnake returns the point splitting the shortest edit script of the token lists in two halves, found
+// by running the Myers algorithm from both ends until the paths overlap. It returns -1, -1 if no token is shared.
+func contentMiddleSnake(from, to []string) (int, int) {
+
+	n, m := len(from), len(to)
+	if n == 0 || m == 0 {
+		return -1, -1
+	}
+
+	maxD := (n + m + 1) / 2
+	offset, length := maxD, 2*maxD+2
+
+	forward, backward := make([]int, length), make([]int, length)
+	for index := range forward {
+		forward[index], backward[index] = -1, -1
+	}
+
+	forward[offset+1], backward[offset+1] = 0, 0
+
+	delta := n - m
+	// The paths overlap on the forward pass when the delta is odd, and on the backward pass otherwise.
+	odd := delta%2 != 0
+
+	var forwardStart, forwardEnd, backwardStart, backwardEnd int
+
+	for d := 0; d < maxD; d++ {
+
+		for k := -d + forwardStart; k <= d-forwardEnd; k += 2 {
+
+			index := offset + k
+
+			var x int
+			if k == -d || (k != d && forward[index-1] < forward[index+1]) {
+				x = forward[index+1]
+			} else {
+				x = forward[index-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && from[x] == to[y] {
+				x, y = x+1, y+1
+			}
+
+			forward[index] = x
+
+			switch {
+			case x > n:
+				forwardEnd += 2
+			case y > m:
+				forwardStart += 2
+			case odd:
+				if reverse := offset + delta - k; reverse >= 0 && reverse < length && backward[reverse] != -1 && x >= n-backward[reverse] {
+					return x, y
+				}
+			}
+		}
+
+		for k := -d + backwardStart; k <= d-backwardEnd; k += 2 {
+
+			index := offset + k
+
+			var x int
+			if k == -d || (k != d && backward[index-1] < backward[index+1]) {
+				x = backward[index+1]
+			} else {
+				x = backward[index-1] + 1
+			}
+
+			y := x - k
+			for x < n && y < m && from[n-x-1] == to[m-y-1] {
+				x, y = x+1, y+1
+			}
+
+			backward[index] = x
+
+			switch {
+			case x > n:
+				backwardEnd += 2
+			case y > m:
+				backwardStart += 2
+			case !odd:
+				if reverse := offset + delta - k; reverse >= 0 && reverse < length && forward[reverse] != -1 && forward[reverse] >= n-x {
+					return forward[reverse], offset + forward[reverse] - reverse
+				}
+			}
+		}
+	}
+
+	return -1, -1
+}
+
+func mergeContentSegments(segments []*model.ContentDiffSegmentScheme) []*model.ContentDiffSegmentScheme {
+
+	var merged []*model.ContentDiffSegmentScheme
+
+	for _, segment := range segments {
+
+		if last := len(merged) - 1; last >= 0 && merged[last].Operation == segment.Operation {
+			merged[last].Text += segment.Text
+			continue
+		}
+
+		merged = append(merged, segment)
+	}
+
+	return merged
+}
@@ -3,11 +3,14 @@ package internal
 import (
 	"context"
 	"errors"
+	"fmt"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
+	"strings"
 	"testing"
 )
 
@@ -404,6 +407,21 @@ func Test_internalVersionImpl_Delete(t *testing.T) {
 
 				client := mocks.NewConnector(t)
 
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/3838282/version?limit=1&start=0",
+					"", nil).
+					Return(&http.Request{RequestURI: "versions"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "versions"},
+					&model.ContentVersionPageScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentVersionPageScheme).Results = []*model.ContentVersionScheme{{Number: 30}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
 				client.On("NewRequest",
 					context.Background(),
 					http.MethodDelete,
@@ -432,6 +450,21 @@ func Test_internalVersionImpl_Delete(t *testing.T) {
 
 				client := mocks.NewConnector(t)
 
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/3838282/version?limit=1&start=0",
+					"", nil).
+					Return(&http.Request{RequestURI: "versions"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "versions"},
+					&model.ContentVersionPageScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentVersionPageScheme).Results = []*model.ContentVersionScheme{{Number: 30}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
 				client.On("NewRequest",
 					context.Background(),
 					http.MethodDelete,
@@ -446,6 +479,74 @@ func Test_internalVersionImpl_Delete(t *testing.T) {
 			Err:     errors.New("error, unable to create the http request"),
 		},
 
+		{
+			name: "when the version is the current one",
+			args: args{
+				ctx:           context.Background(),
+				contentID:     "3838282",
+				versionNumber: 29,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/3838282/version?limit=1&start=0",
+					"", nil).
+					Return(&http.Request{RequestURI: "versions"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "versions"},
+					&model.ContentVersionPageScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentVersionPageScheme).Results = []*model.ContentVersionScheme{{Number: 29}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+
+			},
+			wantErr: true,
+			Err:     model.ErrCurrentContentVersion,
+		},
+
+		{
+			name: "when the versions cannot be listed",
+			args: args{
+				ctx:           context.Background(),
+				contentID:     "3838282",
+				versionNumber: 29,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/3838282/version?limit=1&start=0",
+					"", nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name: "when the version number is not provided",
+			args: args{
+				ctx:       context.Background(),
+				contentID: "3838282",
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentVersion,
+		},
+
 		{
 			name: "when the content id is not provided",
 			args: args{
@@ -484,3 +585,331 @@ func Test_internalVersionImpl_Delete(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalVersionImpl_Diff(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx         context.Context
+		contentID   string
+		fromVersion int
+		toVersion   int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:         context.Background(),
+				contentID:   "100100101",
+				fromVersion: 1,
+				toVersion:   2,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100100101?expand=body.storage&version=1",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentScheme).Body = &model.BodyScheme{Storage: &model.BodyNodeScheme{Value: "<p>Hello world</p>"}}
+					}).
+					Once().
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100100101?expand=body.storage&version=2",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ContentScheme).Body = &model.BodyScheme{Storage: &model.BodyNodeScheme{Value: "<p>Hello Confluence world</p>"}}
+					}).
+					Once().
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the version no longer exists",
+			args: args{
+				ctx:         context.Background(),
+				contentID:   "100100101",
+				fromVersion: 1,
+				toVersion:   2,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100100101?expand=body.storage&version=1",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.ContentScheme{}).
+					Return(&model.ResponseScheme{}, model.ErrNotFound)
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     fmt.Errorf("%w: %v", model.ErrContentVersionNotFound, 1),
+		},
+
+		{
+			name: "when the content id is not provided",
+			args: args{
+				ctx:         context.Background(),
+				contentID:   "",
+				fromVersion: 1,
+				toVersion:   2,
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentID,
+		},
+
+		{
+			name: "when the version number is not provided",
+			args: args{
+				ctx:         context.Background(),
+				contentID:   "100100101",
+				fromVersion: 1,
+				toVersion:   0,
+			},
+			wantErr: true,
+			Err:     model.ErrNoContentVersion,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:         context.Background(),
+				contentID:   "100100101",
+				fromVersion: 1,
+				toVersion:   2,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"wiki/rest/api/content/100100101?expand=body.storage&version=1",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService := NewVersionService(testCase.fields.c)
+
+			gotResult, gotResponse, err := newService.Diff(testCase.args.ctx, testCase.args.contentID, testCase.args.fromVersion, testCase.args.toVersion)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_diffContentTokens(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		from string
+		to   string
+		want []*model.ContentDiffSegmentScheme
+	}{
+		{
+			name: "when a word is added",
+			from: "<p>Hello world</p>",
+			to:   "<p>Hello Confluence world</p>",
+			want: []*model.ContentDiffSegmentScheme{
+				{Operation: model.ContentDiffEqual, Text: "<p>Hello "},
+				{Operation: model.ContentDiffAdded, Text: "Confluence "},
+				{Operation: model.ContentDiffEqual, Text: "world</p>"},
+			},
+		},
+
+		{
+			name: "when a word is replaced",
+			from: "<p>draft version</p>",
+			to:   "<p>final version</p>",
+			want: []*model.ContentDiffSegmentScheme{
+				{Operation: model.ContentDiffEqual, Text: "<p>"},
+				{Operation: model.ContentDiffRemoved, Text: "draft"},
+				{Operation: model.ContentDiffAdded, Text: "final"},
+				{Operation: model.ContentDiffEqual, Text: " version</p>"},
+			},
+		},
+
+		{
+			name: "when the bodies are equal",
+			from: "<p>Hello</p>",
+			to:   "<p>Hello</p>",
+			want: []*model.ContentDiffSegmentScheme{
+				{Operation: model.ContentDiffEqual, Text: "<p>Hello</p>"},
+			},
+		},
+
+		{
+			name: "when both bodies are empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got := diffContentTokens(contentTokenizer.FindAllString(testCase.from, -1), contentTokenizer.FindAllString(testCase.to, -1))
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
+
+func Test_diffContentTokens_Large(t *testing.T) {
+
+	// Every third word is replaced, the distance between the bodies grows with their size, so a quadratic trace
+	// of the edit script would take gigabytes.
+	const words = 30000
+
+	var kept int
+
+	from, to := make([]string, 0, 2*words), make([]string, 0, 2*words)
+	for index := 0; index < words; index++ {
+
+		word := fmt.Sprintf("word-%d", index)
+		from = append(from, word, " ")
+
+		if index%3 == 0 {
+			to = append(to, fmt.Sprintf("changed-%d", index), " ")
+			kept++
+		} else {
+			to = append(to, word, " ")
+			kept += len(word) + 1
+		}
+	}
+
+	segments := diffContentTokens(from, to)
+
+	var gotFrom, gotTo, equal strings.Builder
+	for _, segment := range segments {
+
+		switch segment.Operation {
+		case model.ContentDiffEqual:
+			gotFrom.WriteString(segment.Text)
+			gotTo.WriteString(segment.Text)
+			equal.WriteString(segment.Text)
+		case model.ContentDiffRemoved:
+			gotFrom.WriteString(segment.Text)
+		case model.ContentDiffAdded:
+			gotTo.WriteString(segment.Text)
+		}
+	}
+
+	assert.Equal(t, strings.Join(from, ""), gotFrom.String())
+	assert.Equal(t, strings.Join(to, ""), gotTo.String())
+
+	// The unchanged words and every separator are kept, so the edit script is the shortest one.
+	assert.Equal(t, kept, equal.Len())
+}
+
+func Test_diffContentTokens_Shortest(t *testing.T) {
+
+	// The edit scripts of small token lists are compared with the longest common subsequence computed by dynamic programming.
+	alphabet := []string{"a", "b", "c"}
+
+	for seed := 0; seed < 500; seed++ {
+
+		from := make([]string, seed%7)
+		for index := range from {
+			from[index] = alphabet[(seed*7+index*5)%len(alphabet)]
+		}
+
+		to := make([]string, seed%5+1)
+		for index := range to {
+			to[index] = alphabet[(seed*3+index*11)%len(alphabet)]
+		}
+
+		var equal int
+		for _, segment := range diffContentTokens(from, to) {
+			if segment.Operation == model.ContentDiffEqual {
+				equal += len(segment.Text)
+			}
+		}
+
+		lcs := make([][]int, len(from)+1)
+		for index := range lcs {
+			lcs[index] = make([]int, len(to)+1)
+		}
+
+		for x := 1; x <= len(from); x++ {
+			for y := 1; y <= len(to); y++ {
+
+				if from[x-1] == to[y-1] {
+					lcs[x][y] = lcs[x-1][y-1] + 1
+				} else {
+					lcs[x][y] = max(lcs[x-1][y], lcs[x][y-1])
+				}
+			}
+		}
+
+		assert.Equal(t, lcs[len(from)][len(to)], equal, "from %v to %v", from, to)
+	}
+}
@@ -0,0 +1,160 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/fanout"
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+)
+
+// NewContentWatchService creates a new instance of ContentWatchService.
+// It takes a service.Connector as input and returns a pointer to ContentWatchService.
+func NewContentWatchService(client service.Connector) *ContentWatchService {
+	return &ContentWatchService{
+		internalClient: &internalContentWatchImpl{c: client},
+	}
+}
+
+// ContentWatchService provides methods to interact with the content watchers in Confluence.
+type ContentWatchService struct {
+	// internalClient is the connector interface for content watcher operations.
+	internalClient confluence.ContentWatchConnector
+}
+
+// Get returns whether a user is watching a piece of content.
+//
+// GET /wiki/rest/api/user/watch/content/{contentID}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/watchers#get-content-watch-status
+func (w *ContentWatchService) Get(ctx context.Context, contentID, accountID string) (*model.ContentWatchStatusScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Get(ctx, contentID, accountID)
+}
+
+// Add adds a user as a watcher to a piece of content.
+//
+// POST /wiki/rest/api/user/watch/content/{contentID}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/watchers#add-content-watcher
+func (w *ContentWatchService) Add(ctx context.Context, contentID, accountID string) (*model.ResponseScheme, error) {
+	return w.internalClient.Add(ctx, contentID, accountID)
+}
+
+// AddBulk adds many users as watchers to a piece of content.
+//
+// The watchers are added concurrently, the errors are reported per account and the remaining accounts
+// are still added.
+//
+// POST /wiki/rest/api/user/watch/content/{contentID}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/watchers#add-content-watchers
+func (w *ContentWatchService) AddBulk(ctx context.Context, contentID string, accountIDs []string) (*model.ContentWatchBulkResultScheme, error) {
+	return w.internalClient.AddBulk(ctx, contentID, accountIDs)
+}
+
+// Remove removes a user as a watcher from a piece of content.
+//
+// DELETE /wiki/rest/api/user/watch/content/{contentID}
+//
+// https://docs.go-atlassian.io/confluence-cloud/content/watchers#remove-content-watcher
+func (w *ContentWatchService) Remove(ctx context.Context, contentID, accountID string) (*model.ResponseScheme, error) {
+	return w.internalClient.Remove(ctx, contentID, accountID)
+}
+
+type internalContentWatchImpl struct {
+	c service.Connector
+}
+
+func (i *internalContentWatchImpl) Get(ctx context.Context, contentID, accountID string) (*model.ContentWatchStatusScheme, *model.ResponseScheme, error) {
+
+	request, err := i.request(ctx, http.MethodGet, contentID, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	status := new(model.ContentWatchStatusScheme)
+	response, err := i.c.Call(request, status)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return status, response, nil
+}
+
+func (i *internalContentWatchImpl) Add(ctx context.Context, contentID, accountID string) (*model.ResponseScheme, error) {
+
+	request, err := i.request(ctx, http.MethodPost, contentID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// contentWatchBulkWorkers is the number of watchers added concurrently by AddBulk.
+const contentWatchBulkWorkers = 5
+
+func (i *internalContentWatchImpl) AddBulk(ctx context.Context, contentID string, accountIDs []string) (*model.ContentWatchBulkResultScheme, error) {
+
+	if contentID == "" {
+		return nil, model.ErrNoContentID
+	}
+
+	if len(accountIDs) == 0 {
+		return nil, model.ErrNoContentWatcherAccountID
+	}
+
+	result := &model.ContentWatchBulkResultScheme{Total: len(accountIDs), Errors: make(map[string]error)}
+
+	errs := fanout.Map(accountIDs, contentWatchBulkWorkers, func(accountID string) error {
+		_, err := i.Add(ctx, contentID, accountID)
+		return err
+	})
+
+	for index, err := range errs {
+
+		if err != nil {
+			result.Errors[accountIDs[index]] = err
+		} else {
+			result.Added = append(result.Added, accountIDs[index])
+		}
+	}
+
+	slices.Sort(result.Added)
+
+	return result, nil
+}
+
+func (i *internalContentWatchImpl) Remove(ctx context.Context, contentID, accountID string) (*model.ResponseScheme, error) {
+
+	request, err := i.request(ctx, http.MethodDelete, contentID, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+// request builds the request of the watch status of the user on the content.
+func (i *internalContentWatchImpl) request(ctx context.Context, method, contentID, accountID string) (*http.Request, error) {
+
+	if contentID == "" {
+		return nil, model.ErrNoContentID
+	}
+
+	if accountID == "" {
+		return nil, model.ErrNoContentWatcherAccountID
+	}
+
+	params := url.Values{}
+	params.Add("accountId", accountID)
+
+	endpoint := fmt.Sprintf("wiki/rest/api/user/watch/content/%v?%v", contentID, params.Encode())
+
+	return i.c.NewRequest(ctx, method, endpoint, "", nil)
+}
@@ -0,0 +1,173 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+)
+
+// NewWhiteboardService creates a new instance of WhiteboardService.
+// It takes a service.Connector as input and returns a pointer to WhiteboardService.
+func NewWhiteboardService(client service.Connector) *WhiteboardService {
+	return &WhiteboardService{internalClient: &internalWhiteboardImpl{c: client}}
+}
+
+// WhiteboardService provides methods to interact with whiteboard operations in Confluence.
+type WhiteboardService struct {
+	// internalClient is the connector interface for whiteboard operations.
+	internalClient confluence.WhiteboardConnector
+}
+
+// Create creates a whiteboard in the space.
+//
+// The whiteboard is created at the space root unless the parent ID is set.
+//
+// POST /wiki/api/v2/whiteboards
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/whiteboard#create-whiteboard
+func (w *WhiteboardService) Create(ctx context.Context, payload *model.WhiteboardCreatePayloadScheme) (*model.WhiteboardScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Create(ctx, payload)
+}
+
+// Get returns a specific whiteboard.
+//
+// GET /wiki/api/v2/whiteboards/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/whiteboard#get-whiteboard-by-id
+func (w *WhiteboardService) Get(ctx context.Context, whiteboardID int) (*model.WhiteboardScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Get(ctx, whiteboardID)
+}
+
+// Delete deletes a whiteboard, the whiteboard is moved to the trash.
+//
+// DELETE /wiki/api/v2/whiteboards/{id}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/whiteboard#delete-whiteboard
+func (w *WhiteboardService) Delete(ctx context.Context, whiteboardID int) (*model.ResponseScheme, error) {
+	return w.internalClient.Delete(ctx, whiteboardID)
+}
+
+// Ancestors returns the ancestors of the whiteboard in the content tree, from the top level to the parent.
+//
+// GET /wiki/api/v2/whiteboards/{id}/ancestors
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/whiteboard#get-whiteboard-ancestors
+func (w *WhiteboardService) Ancestors(ctx context.Context, whiteboardID, limit int) (*model.ContentAncestorsScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Ancestors(ctx, whiteboardID, limit)
+}
+
+// Children returns the direct children of the whiteboard in the content tree.
+//
+// The results are paginated with a cursor, the cursor of the next chunk is available on the next link of the chunk.
+//
+// GET /wiki/api/v2/whiteboards/{id}/direct-children
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/whiteboard#get-whiteboard-direct-children
+func (w *WhiteboardService) Children(ctx context.Context, whiteboardID int, cursor string, limit int) (*model.ContentChildrenChunkScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Children(ctx, whiteboardID, cursor, limit)
+}
+
+// Move moves the whiteboard relative to the target content in the content tree.
+//
+// The position is one of before, after or append, the REST v2 API doesn't provide a move endpoint,
+// so the REST v1 content move endpoint is used.
+//
+// PUT /wiki/rest/api/content/{id}/move/{position}/{targetID}
+//
+// https://docs.go-atlassian.io/confluence-cloud/v2/whiteboard#move-whiteboard
+func (w *WhiteboardService) Move(ctx context.Context, whiteboardID int, position string, targetID int) (*model.ContentMoveScheme, *model.ResponseScheme, error) {
+	return w.internalClient.Move(ctx, whiteboardID, position, targetID)
+}
+
+type internalWhiteboardImpl struct {
+	c service.Connector
+}
+
+func (i *internalWhiteboardImpl) Create(ctx context.Context, payload *model.WhiteboardCreatePayloadScheme) (*model.WhiteboardScheme, *model.ResponseScheme, error) {
+
+	if payload == nil {
+		return nil, nil, model.ErrNoContentPayload
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodPost, "wiki/api/v2/whiteboards", "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	whiteboard := new(model.WhiteboardScheme)
+	response, err := i.c.Call(request, whiteboard)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return whiteboard, response, nil
+}
+
+func (i *internalWhiteboardImpl) Get(ctx context.Context, whiteboardID int) (*model.WhiteboardScheme, *model.ResponseScheme, error) {
+
+	if whiteboardID == 0 {
+		return nil, nil, model.ErrNoWhiteboardID
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/whiteboards/%v", whiteboardID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	whiteboard := new(model.WhiteboardScheme)
+	response, err := i.c.Call(request, whiteboard)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return whiteboard, response, nil
+}
+
+func (i *internalWhiteboardImpl) Delete(ctx context.Context, whiteboardID int) (*model.ResponseScheme, error) {
+
+	if whiteboardID == 0 {
+		return nil, model.ErrNoWhiteboardID
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/whiteboards/%v", whiteboardID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalWhiteboardImpl) Ancestors(ctx context.Context, whiteboardID, limit int) (*model.ContentAncestorsScheme, *model.ResponseScheme, error) {
+
+	if whiteboardID == 0 {
+		return nil, nil, model.ErrNoWhiteboardID
+	}
+
+	return getContentAncestors(ctx, i.c, "whiteboards", whiteboardID, limit)
+}
+
+func (i *internalWhiteboardImpl) Children(ctx context.Context, whiteboardID int, cursor string, limit int) (*model.ContentChildrenChunkScheme, *model.ResponseScheme, error) {
+
+	if whiteboardID == 0 {
+		return nil, nil, model.ErrNoWhiteboardID
+	}
+
+	return getContentChildren(ctx, i.c, "whiteboards", whiteboardID, cursor, limit)
+}
+
+func (i *internalWhiteboardImpl) Move(ctx context.Context, whiteboardID int, position string, targetID int) (*model.ContentMoveScheme, *model.ResponseScheme, error) {
+
+	if whiteboardID == 0 {
+		return nil, nil, model.ErrNoWhiteboardID
+	}
+
+	return moveContent(ctx, i.c, whiteboardID, position, targetID)
+}
@@ -0,0 +1,191 @@
+// Package migrate eases the transition from the Confluence REST v1 services to the REST v2 ones.
+//
+// The REST v2 endpoints identify the content with numeric IDs scoped by the content type, e.g. a page is
+// available on /wiki/api/v2/pages/{id} and a blog post on /wiki/api/v2/blogposts/{id}. ContentIDToV2 resolves
+// a REST v1 content ID to its canonical REST v2 reference:
+//
+//	reference, err := migrate.ContentIDToV2(ctx, confluenceV1Client, "65611")
+//
+// The adapters expose the REST v2 services with the REST v1 method shapes, so the code can be migrated
+// one call at a time:
+//
+//	Content.Get(ctx, contentID, expand, version)  -> PageAdapter.Get(ctx, contentID, version)
+//	Content.Update(ctx, contentID, payload)       -> PageAdapter.Update(ctx, contentID, payload)
+//	Content.Delete(ctx, contentID, status)        -> PageAdapter.Delete(ctx, contentID)
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+)
+
+// v2Collections maps the REST v1 content types to the REST v2 collections storing them.
+var v2Collections = map[string]string{
+	"page":       "pages",
+	"blogpost":   "blogposts",
+	"attachment": "attachments",
+	"comment":    "footer-comments",
+}
+
+// ContentIDToV2 verifies the REST v1 content ID resolves under the REST v2 endpoints and returns its canonical reference.
+//
+// The client is the Confluence REST v1 client, the content type is fetched from the REST v1 endpoint and the
+// REST v2 endpoint of the type is requested afterward. The content not belonging to a built-in type is
+// resolved as custom content.
+func ContentIDToV2(ctx context.Context, client service.Connector, contentID string) (*model.ContentV2ReferenceScheme, error) {
+
+	if contentID == "" {
+		return nil, model.ErrNoContentID
+	}
+
+	id, err := strconv.Atoi(contentID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrInvalidContentID, contentID)
+	}
+
+	request, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("wiki/rest/api/content/%v", contentID), "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	content := new(model.ContentScheme)
+	if _, err = client.Call(request, content); err != nil {
+		return nil, err
+	}
+
+	if content.Type == "" {
+		return nil, model.ErrNoContentType
+	}
+
+	collection, ok := v2Collections[content.Type]
+	if !ok {
+		collection = "custom-content"
+	}
+
+	endpoint := fmt.Sprintf("wiki/api/v2/%v/%v", collection, id)
+
+	request, err = client.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := new(struct {
+		ID string `json:"id"`
+	})
+
+	if _, err = client.Call(request, resolved); err != nil {
+		return nil, err
+	}
+
+	if resolved.ID != contentID {
+		return nil, fmt.Errorf("%w: %v", model.ErrUnsupportedContentType, content.Type)
+	}
+
+	return &model.ContentV2ReferenceScheme{ID: id, Type: content.Type, Endpoint: endpoint}, nil
+}
+
+// NewPageAdapter creates a new PageAdapter backed by the REST v2 page service, e.g. the Page service of the confluence/v2 client.
+func NewPageAdapter(pages confluence.PageConnector) *PageAdapter {
+	return &PageAdapter{pages: pages}
+}
+
+// PageAdapter exposes the REST v2 page operations with the REST v1 content method shapes.
+type PageAdapter struct {
+	pages confluence.PageConnector
+}
+
+// Get returns the page with the storage body, the version 0 returns the latest version.
+//
+// It replaces the REST v1 Content.Get method.
+func (p *PageAdapter) Get(ctx context.Context, contentID string, version int) (*model.PageScheme, *model.ResponseScheme, error) {
+
+	pageID, err := pageIDToV2(contentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return p.pages.Get(ctx, pageID, "storage", false, version)
+}
+
+// Update updates the page using the REST v1 content payload.
+//
+// The title, status, version, storage body and the closest ancestor of the payload are mapped to the REST v2 payload.
+//
+// It replaces the REST v1 Content.Update method.
+func (p *PageAdapter) Update(ctx context.Context, contentID string, payload *model.ContentScheme) (*model.PageScheme, *model.ResponseScheme, error) {
+
+	pageID, err := pageIDToV2(contentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if payload == nil {
+		return nil, nil, model.ErrNoContentPayload
+	}
+
+	return p.pages.Update(ctx, pageID, PageUpdatePayload(contentID, payload))
+}
+
+// Delete deletes the page, the page is moved to the trash.
+//
+// It replaces the REST v1 Content.Delete method.
+func (p *PageAdapter) Delete(ctx context.Context, contentID string) (*model.ResponseScheme, error) {
+
+	pageID, err := pageIDToV2(contentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.pages.Delete(ctx, pageID)
+}
+
+// PageUpdatePayload converts a REST v1 content payload to the REST v2 page update payload.
+func PageUpdatePayload(contentID string, payload *model.ContentScheme) *model.PageUpdatePayloadScheme {
+
+	update := &model.PageUpdatePayloadScheme{
+		ID:     contentID,
+		Status: payload.Status,
+		Title:  payload.Title,
+	}
+
+	if update.Status == "" {
+		update.Status = "current"
+	}
+
+	if payload.Version != nil {
+		update.Version = &model.PageUpdatePayloadVersionScheme{Number: payload.Version.Number, Message: payload.Version.Message}
+	}
+
+	if payload.Body != nil && payload.Body.Storage != nil {
+		update.Body = &model.PageBodyRepresentationScheme{
+			Representation: "storage",
+			Value:          payload.Body.Storage.Value,
+		}
+	}
+
+	if len(payload.Ancestors) != 0 {
+		update.ParentID = payload.Ancestors[len(payload.Ancestors)-1].ID
+	}
+
+	return update
+}
+
+func pageIDToV2(contentID string) (int, error) {
+
+	if contentID == "" {
+		return 0, model.ErrNoContentID
+	}
+
+	pageID, err := strconv.Atoi(contentID)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", model.ErrInvalidContentID, contentID)
+	}
+
+	return pageID, nil
+}
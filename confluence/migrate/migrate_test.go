@@ -0,0 +1,140 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ctreminiom/go-atlassian/v2/confluence/internal"
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/testutil"
+)
+
+func TestContentIDToV2(t *testing.T) {
+
+	testCases := []struct {
+		name      string
+		contentID string
+		responses []string
+		want      *model.ContentV2ReferenceScheme
+		wantPaths []string
+		wantErr   bool
+		Err       error
+	}{
+		{
+			name:      "when the content is a page",
+			contentID: "65611",
+			responses: []string{`{"id":"65611","type":"page"}`, `{"id":"65611"}`},
+			want:      &model.ContentV2ReferenceScheme{ID: 65611, Type: "page", Endpoint: "wiki/api/v2/pages/65611"},
+			wantPaths: []string{"wiki/rest/api/content/65611", "wiki/api/v2/pages/65611"},
+		},
+
+		{
+			name:      "when the content is a custom content",
+			contentID: "65611",
+			responses: []string{`{"id":"65611","type":"ac:app:forge:issue"}`, `{"id":"65611"}`},
+			want:      &model.ContentV2ReferenceScheme{ID: 65611, Type: "ac:app:forge:issue", Endpoint: "wiki/api/v2/custom-content/65611"},
+			wantPaths: []string{"wiki/rest/api/content/65611", "wiki/api/v2/custom-content/65611"},
+		},
+
+		{
+			name:      "when the content does not resolve under v2",
+			contentID: "65611",
+			responses: []string{`{"id":"65611","type":"page"}`, `{}`},
+			wantPaths: []string{"wiki/rest/api/content/65611", "wiki/api/v2/pages/65611"},
+			wantErr:   true,
+			Err:       model.ErrUnsupportedContentType,
+		},
+
+		{
+			name:      "when the content id is not numeric",
+			contentID: "DUMMY-1",
+			wantErr:   true,
+			Err:       model.ErrInvalidContentID,
+		},
+
+		{
+			name:    "when the content id is not provided",
+			wantErr: true,
+			Err:     model.ErrNoContentID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			recorder := testutil.NewRecorder()
+			for _, response := range testCase.responses {
+				recorder.Respond(response)
+			}
+
+			got, err := ContentIDToV2(context.Background(), recorder, testCase.contentID)
+
+			for _, path := range testCase.wantPaths {
+				testutil.ExpectRequest(t, recorder, testutil.Request{Method: http.MethodGet, Path: path})
+			}
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
+
+func TestPageAdapter(t *testing.T) {
+
+	recorder := testutil.NewRecorder()
+	recorder.Respond(`{"id":"65611","title":"Release notes"}`)
+
+	adapter := NewPageAdapter(internal.NewPageService(recorder))
+
+	page, _, err := adapter.Get(context.Background(), "65611", 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "Release notes", page.Title)
+
+	_, _, err = adapter.Update(context.Background(), "65611", &model.ContentScheme{
+		Title:     "Release notes",
+		Version:   &model.ContentVersionScheme{Number: 3, Message: "Reparented"},
+		Body:      &model.BodyScheme{Storage: &model.BodyNodeScheme{Value: "<p>Notes</p>", Representation: "storage"}},
+		Ancestors: []*model.ContentScheme{{ID: "100"}, {ID: "200"}},
+	})
+	assert.NoError(t, err)
+
+	_, err = adapter.Delete(context.Background(), "65611")
+	assert.NoError(t, err)
+
+	testutil.ExpectRequest(t, recorder, testutil.Request{
+		Method: http.MethodGet,
+		Path:   "wiki/api/v2/pages/65611",
+		Query:  url.Values{"body-format": {"storage"}, "version": {"2"}},
+	})
+
+	testutil.ExpectRequest(t, recorder, testutil.Request{
+		Method: http.MethodPut,
+		Path:   "wiki/api/v2/pages/65611",
+		Body: `{
+			"id": "65611",
+			"status": "current",
+			"title": "Release notes",
+			"parentId": "200",
+			"body": {"representation": "storage", "value": "<p>Notes</p>"},
+			"version": {"number": 3, "message": "Reparented"}
+		}`,
+	})
+
+	testutil.ExpectRequest(t, recorder, testutil.Request{Method: http.MethodDelete, Path: "wiki/api/v2/pages/65611"})
+
+	_, _, err = adapter.Get(context.Background(), "DUMMY-1", 0)
+	assert.True(t, errors.Is(err, model.ErrInvalidContentID))
+
+	_, _, err = adapter.Update(context.Background(), "65611", nil)
+	assert.True(t, errors.Is(err, model.ErrNoContentPayload))
+}
@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"regexp"
+	"strings"
+)
+
+// markupPattern matches the markup of a storage body, the text nodes are the content between the matches.
+var markupPattern = regexp.MustCompile(`(?s)<!\[CDATA\[.*?\]\]>|<!--.*?-->|<[^>]*>`)
+
+// textEscaper escapes the characters reserved on the text nodes of the storage format.
+var textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// ReplaceText replaces the plain text find with replace on the text nodes of the storage format body, and returns
+// the body updated and the number of occurrences replaced.
+//
+// The markup, e.g. the ac: and ri: elements and their attributes, the macro parameters, the CDATA sections and the
+// comments, is left untouched. Both texts are escaped, so they're provided as displayed, e.g. R&D instead of R&amp;D.
+func ReplaceText(body, find, replace string) (string, int) {
+
+	if find == "" {
+		return body, 0
+	}
+
+	find, replace = textEscaper.Replace(find), textEscaper.Replace(replace)
+
+	var (
+		builder     strings.Builder
+		occurrences int
+		position    int
+		// parameters is the depth of the macro parameters, their text is the value of the parameter.
+		parameters int
+	)
+
+	appendText := func(text string) {
+
+		if parameters > 0 {
+			builder.WriteString(text)
+			return
+		}
+
+		occurrences += strings.Count(text, find)
+		builder.WriteString(strings.ReplaceAll(text, find, replace))
+	}
+
+	for _, match := range markupPattern.FindAllStringIndex(body, -1) {
+
+		appendText(body[position:match[0]])
+
+		markup := body[match[0]:match[1]]
+		builder.WriteString(markup)
+
+		switch {
+		case strings.HasPrefix(markup, "</ac:parameter"):
+			parameters = max(parameters-1, 0)
+		case strings.HasPrefix(markup, "<ac:parameter") && !strings.HasSuffix(markup, "/>"):
+			parameters++
+		}
+
+		position = match[1]
+	}
+
+	appendText(body[position:])
+
+	return builder.String(), occurrences
+}
@@ -0,0 +1,65 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReplaceText(t *testing.T) {
+
+	testCases := []struct {
+		name            string
+		body            string
+		find, replace   string
+		want            string
+		wantOccurrences int
+	}{
+		{
+			name:            "when the text is on several text nodes",
+			body:            `<p>The page is <strong>a page</strong></p>`,
+			find:            "page",
+			replace:         "document",
+			want:            `<p>The document is <strong>a document</strong></p>`,
+			wantOccurrences: 2,
+		},
+
+		{
+			name: "when the text matches the markup",
+			body: `<ac:link><ri:page ri:content-title="page" /><ac:plain-text-link-body><![CDATA[page]]></ac:plain-text-link-body></ac:link>` +
+				`<ac:structured-macro ac:name="include"><ac:parameter ac:name="page">page</ac:parameter></ac:structured-macro><!-- page --><p>page</p>`,
+			find:    "page",
+			replace: "document",
+			want: `<ac:link><ri:page ri:content-title="page" /><ac:plain-text-link-body><![CDATA[page]]></ac:plain-text-link-body></ac:link>` +
+				`<ac:structured-macro ac:name="include"><ac:parameter ac:name="page">page</ac:parameter></ac:structured-macro><!-- page --><p>document</p>`,
+			wantOccurrences: 1,
+		},
+
+		{
+			name:            "when the texts have reserved characters",
+			body:            `<p>R&amp;D team</p>`,
+			find:            "R&D",
+			replace:         "<Research>",
+			want:            `<p>&lt;Research&gt; team</p>`,
+			wantOccurrences: 1,
+		},
+
+		{
+			name:    "when the text is not found",
+			body:    `<p>Release notes</p>`,
+			find:    "roadmap",
+			replace: "plan",
+			want:    `<p>Release notes</p>`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, occurrences := ReplaceText(testCase.body, testCase.find, testCase.replace)
+
+			assert.Equal(t, testCase.want, got)
+			assert.Equal(t, testCase.wantOccurrences, occurrences)
+		})
+	}
+}
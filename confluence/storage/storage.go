@@ -0,0 +1,220 @@
+// Package storage provides helpers to process the Confluence storage format and the export view HTML.
+//
+// RewriteLinks rewrites the links to the Confluence pages and attachments, so the exported content
+// can be published outside the wiki, e.g. on a static site:
+//
+//	page, _, err := instance.Content.Get(ctx, "65611", []string{"body.storage"}, 0)
+//
+//	html, err := storage.RewriteLinks(page.Body.Storage.Value, func(contentID string) string {
+//		return "/docs/" + contentID + ".html"
+//	})
+//
+// Validate checks a generated storage body is well-formed before it's sent, reporting the line and column of the
+// first problem found:
+//
+//	if err := storage.Validate(body); err != nil {
+//		return err
+//	}
+//
+// Tasks extracts the inline tasks of a storage body, e.g. to roll up the open action items of the pages:
+//
+//	tasks, err := storage.Tasks(page.Body.Storage.Value)
+//
+// ReplaceText replaces a text on the text nodes only, leaving the markup and the macro parameters untouched:
+//
+//	body, occurrences := storage.ReplaceText(page.Body.Storage.Value, "Jira Core", "Jira Work Management")
+package storage
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// The types of the references.
+const (
+	ReferencePage       = "page"
+	ReferenceBlogPost   = "blogpost"
+	ReferenceAttachment = "attachment"
+)
+
+// Reference describes a Confluence content referenced by a link.
+type Reference struct {
+	Type      string // The type of the content, page, blogpost or attachment.
+	ContentID string // The ID of the content, or the ID of the attachment container when only the file name is known.
+	SpaceKey  string // The key of the space of the content, if provided.
+	Title     string // The title of the content, if provided.
+	FileName  string // The file name of the attachment.
+}
+
+var (
+	linkPattern       = regexp.MustCompile(`(?s)<ac:link(?:\s[^>]*?)?(?:/>|>(.*?)</ac:link>)`)
+	linkStartPattern  = regexp.MustCompile(`<ac:link[\s/>]`)
+	anchorPattern     = regexp.MustCompile(`<a\b[^>]*>`)
+	hrefPattern       = regexp.MustCompile(`(\s)href="[^"]*"`)
+	attributePattern  = regexp.MustCompile(`([\w:-]+)="([^"]*)"`)
+	pagePattern       = regexp.MustCompile(`<ri:(page|blog-post)\b([^>]*?)/?>`)
+	attachmentPattern = regexp.MustCompile(`<ri:attachment\b([^>]*?)/?>`)
+	plainBodyPattern  = regexp.MustCompile(`(?s)<ac:plain-text-link-body>\s*<!\[CDATA\[(.*?)\]\]>\s*</ac:plain-text-link-body>`)
+	richBodyPattern   = regexp.MustCompile(`(?s)<ac:link-body>(.*?)</ac:link-body>`)
+)
+
+// RewriteLinks rewrites the links to the pages, blog posts and attachments identified by their content ID.
+//
+// The ac:link elements of the storage format referencing a ri:page or ri:blog-post with a ri:content-id are
+// replaced by HTML anchors, and the href of the export view anchors carrying a data-linked-resource-id is replaced.
+// The links are left untouched when the resolver returns an empty string.
+//
+// Use RewriteReferences to resolve the references without content ID, such as the pages referenced by title
+// or the attachments referenced by file name.
+func RewriteLinks(html string, resolver func(contentID string) string) (string, error) {
+
+	return RewriteReferences(html, func(reference *Reference) string {
+
+		// The storage attachments are referenced by file name, their content ID is the container one.
+		if reference.ContentID == "" || (reference.Type == ReferenceAttachment && reference.FileName != "") {
+			return ""
+		}
+
+		return resolver(reference.ContentID)
+	})
+}
+
+// RewriteReferences rewrites the links to the pages, blog posts and attachments using the reference resolver.
+//
+// It handles the ac:link elements of the storage format, referencing a ri:page, ri:blog-post or ri:attachment,
+// and the anchors of the export view carrying a data-linked-resource-id.
+// The links are left untouched when the resolver returns an empty string.
+func RewriteReferences(content string, resolver func(reference *Reference) string) (string, error) {
+
+	if len(linkStartPattern.FindAllStringIndex(content, -1)) != len(linkPattern.FindAllStringIndex(content, -1)) {
+		return "", model.ErrMalformedStorage
+	}
+
+	content = linkPattern.ReplaceAllStringFunc(content, func(link string) string {
+
+		reference := storageReference(link)
+		if reference == nil {
+			return link
+		}
+
+		href := resolver(reference)
+		if href == "" {
+			return link
+		}
+
+		return fmt.Sprintf(`<a href="%v">%v</a>`, html.EscapeString(href), linkBody(link, reference))
+	})
+
+	content = anchorPattern.ReplaceAllStringFunc(content, func(anchor string) string {
+
+		attributes := parseAttributes(anchor)
+
+		reference := &Reference{
+			Type:      attributes["data-linked-resource-type"],
+			ContentID: attributes["data-linked-resource-id"],
+			Title:     attributes["data-linked-resource-default-alias"],
+		}
+
+		switch reference.Type {
+		case ReferencePage, ReferenceBlogPost, ReferenceAttachment:
+		default:
+			return anchor
+		}
+
+		if reference.ContentID == "" {
+			return anchor
+		}
+
+		href := resolver(reference)
+		if href == "" {
+			return anchor
+		}
+
+		escaped := `href="` + html.EscapeString(href) + `"`
+
+		if _, ok := attributes["href"]; !ok {
+			return strings.Replace(anchor, "<a", "<a "+escaped, 1)
+		}
+
+		return hrefPattern.ReplaceAllString(anchor, "${1}"+strings.ReplaceAll(escaped, "$", "$$"))
+	})
+
+	return content, nil
+}
+
+func storageReference(link string) *Reference {
+
+	if match := attachmentPattern.FindStringSubmatch(link); match != nil {
+
+		attributes := parseAttributes(match[1])
+		reference := &Reference{Type: ReferenceAttachment, FileName: attributes["ri:filename"]}
+
+		// The attachment container is referenced by the nested ri:page, if any.
+		if container := pagePattern.FindStringSubmatch(link); container != nil {
+			attributes = parseAttributes(container[2])
+			reference.ContentID = attributes["ri:content-id"]
+			reference.SpaceKey = attributes["ri:space-key"]
+			reference.Title = attributes["ri:content-title"]
+		}
+
+		return reference
+	}
+
+	if match := pagePattern.FindStringSubmatch(link); match != nil {
+
+		attributes := parseAttributes(match[2])
+
+		reference := &Reference{
+			Type:      ReferencePage,
+			ContentID: attributes["ri:content-id"],
+			SpaceKey:  attributes["ri:space-key"],
+			Title:     attributes["ri:content-title"],
+		}
+
+		if match[1] == "blog-post" {
+			reference.Type = ReferenceBlogPost
+		}
+
+		return reference
+	}
+
+	return nil
+}
+
+func linkBody(link string, reference *Reference) string {
+
+	if match := plainBodyPattern.FindStringSubmatch(link); match != nil {
+		return html.EscapeString(match[1])
+	}
+
+	if match := richBodyPattern.FindStringSubmatch(link); match != nil {
+		return match[1]
+	}
+
+	texts := []string{reference.Title, reference.FileName, reference.ContentID}
+	if reference.Type == ReferenceAttachment {
+		texts = []string{reference.FileName, reference.Title, reference.ContentID}
+	}
+
+	for _, text := range texts {
+		if text != "" {
+			return html.EscapeString(text)
+		}
+	}
+
+	return ""
+}
+
+func parseAttributes(element string) map[string]string {
+
+	attributes := make(map[string]string)
+	for _, match := range attributePattern.FindAllStringSubmatch(element, -1) {
+		attributes[match[1]] = html.UnescapeString(match[2])
+	}
+
+	return attributes
+}
@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestRewriteLinks(t *testing.T) {
+
+	resolver := func(contentID string) string {
+		if contentID == "404" {
+			return ""
+		}
+
+		return "/docs/" + contentID + ".html"
+	}
+
+	testCases := []struct {
+		name    string
+		html    string
+		want    string
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the storage link has a plain text body",
+			html: `<p>See <ac:link><ri:page ri:content-id="65611" ri:content-title="Release notes" /><ac:plain-text-link-body><![CDATA[the notes & more]]></ac:plain-text-link-body></ac:link>.</p>`,
+			want: `<p>See <a href="/docs/65611.html">the notes &amp; more</a>.</p>`,
+		},
+
+		{
+			name: "when the storage link has a rich body",
+			html: `<ac:link ac:anchor="intro"><ri:blog-post ri:content-id="65612" /><ac:link-body><strong>Blog</strong></ac:link-body></ac:link>`,
+			want: `<a href="/docs/65612.html"><strong>Blog</strong></a>`,
+		},
+
+		{
+			name: "when the storage link has no body",
+			html: `<ac:link><ri:page ri:content-id="65611" ri:content-title="Release &amp; notes" /></ac:link>`,
+			want: `<a href="/docs/65611.html">Release &amp; notes</a>`,
+		},
+
+		{
+			name: "when the storage link references the page by title",
+			html: `<ac:link><ri:page ri:content-title="Release notes" /></ac:link>`,
+			want: `<ac:link><ri:page ri:content-title="Release notes" /></ac:link>`,
+		},
+
+		{
+			name: "when the storage link references an attachment by file name",
+			html: `<ac:link><ri:attachment ri:filename="diagram.png"><ri:page ri:content-id="65611" /></ri:attachment></ac:link>`,
+			want: `<ac:link><ri:attachment ri:filename="diagram.png"><ri:page ri:content-id="65611" /></ri:attachment></ac:link>`,
+		},
+
+		{
+			name: "when the resolver does not resolve the content",
+			html: `<ac:link><ri:page ri:content-id="404" /></ac:link>`,
+			want: `<ac:link><ri:page ri:content-id="404" /></ac:link>`,
+		},
+
+		{
+			name: "when the export view links the resources",
+			html: `<a href="/wiki/spaces/DUMMY/pages/65611/Release+notes" data-linked-resource-id="65611" data-linked-resource-type="page">Notes</a>` +
+				`<a data-href="/x" href="/wiki/download/attachments/65611/diagram.png" data-linked-resource-id="65700" data-linked-resource-type="attachment">Diagram</a>` +
+				`<a href="https://example.com">External</a>`,
+			want: `<a href="/docs/65611.html" data-linked-resource-id="65611" data-linked-resource-type="page">Notes</a>` +
+				`<a data-href="/x" href="/docs/65700.html" data-linked-resource-id="65700" data-linked-resource-type="attachment">Diagram</a>` +
+				`<a href="https://example.com">External</a>`,
+		},
+
+		{
+			name:    "when the storage format is malformed",
+			html:    `<ac:link><ri:page ri:content-id="65611" />`,
+			wantErr: true,
+			Err:     model.ErrMalformedStorage,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := RewriteLinks(testCase.html, resolver)
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
+
+func TestRewriteReferences(t *testing.T) {
+
+	var references []*Reference
+
+	got, err := RewriteReferences(
+		`<ac:link><ri:attachment ri:filename="diagram.png"><ri:page ri:content-title="Release notes" ri:space-key="DUMMY" /></ri:attachment></ac:link>`,
+		func(reference *Reference) string {
+			references = append(references, reference)
+			return "/docs/" + reference.SpaceKey + "/" + reference.FileName
+		})
+
+	assert.NoError(t, err)
+	assert.Equal(t, `<a href="/docs/DUMMY/diagram.png">diagram.png</a>`, got)
+	assert.Equal(t, []*Reference{{Type: ReferenceAttachment, SpaceKey: "DUMMY", Title: "Release notes", FileName: "diagram.png"}}, references)
+}
@@ -0,0 +1,132 @@
+package storage
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// Tasks extracts the inline tasks of the storage format body, in document order.
+//
+// The assignee of a task is the first user mentioned on its body, and the due date the first date inserted on it.
+// The nested tasks are returned after their parent task, their mentions and dates are not attributed to the parent.
+// A *ValidationError is returned if the body is malformed.
+func Tasks(body string) ([]model.InlineTaskScheme, error) {
+
+	decoder := xml.NewDecoder(strings.NewReader(body))
+	decoder.Strict = true
+	decoder.Entity = xml.HTMLEntity
+
+	var (
+		tasks []model.InlineTaskScheme
+		// stack holds the index of the tasks being parsed, the innermost task last.
+		stack []int
+		// bodyStart holds the offset of the body of the tasks being parsed, -1 outside the body.
+		bodyStart []int
+		field     *string
+	)
+
+	for {
+
+		offset := decoder.InputOffset()
+
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+
+			message := err.Error()
+
+			var syntaxErr *xml.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				message = syntaxErr.Msg
+			}
+
+			return nil, validationError(body, elementOffset(body, int(offset)), message)
+		}
+
+		var current *model.InlineTaskScheme
+		if len(stack) != 0 {
+			current = &tasks[stack[len(stack)-1]]
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+
+			switch name := qualifiedName(element.Name); {
+			case name == "ac:task":
+				tasks = append(tasks, model.InlineTaskScheme{})
+				stack = append(stack, len(tasks)-1)
+				bodyStart = append(bodyStart, -1)
+
+			case current == nil:
+
+			case name == "ac:task-id":
+				field = &current.ID
+
+			case name == "ac:task-uuid":
+				field = &current.UUID
+
+			case name == "ac:task-status":
+				field = &current.Status
+
+			case name == "ac:task-body":
+				bodyStart[len(bodyStart)-1] = int(decoder.InputOffset())
+
+			case bodyStart[len(bodyStart)-1] == -1:
+
+			case name == "ri:user" && current.AccountID == "":
+				current.AccountID = attributeValue(element.Attr, "ri:account-id")
+
+			case name == "time" && current.DueDate == "":
+				current.DueDate = attributeValue(element.Attr, "datetime")
+			}
+
+		case xml.CharData:
+
+			if field != nil {
+				*field += string(element)
+			}
+
+		case xml.EndElement:
+
+			field = nil
+
+			switch qualifiedName(element.Name) {
+			case "ac:task":
+				stack = stack[:len(stack)-1]
+				bodyStart = bodyStart[:len(bodyStart)-1]
+
+			case "ac:task-body":
+				if current != nil && bodyStart[len(bodyStart)-1] != -1 {
+					current.Body = strings.TrimSpace(body[bodyStart[len(bodyStart)-1]:offset])
+					bodyStart[len(bodyStart)-1] = -1
+				}
+			}
+		}
+	}
+
+	for index := range tasks {
+		tasks[index].ID = strings.TrimSpace(tasks[index].ID)
+		tasks[index].UUID = strings.TrimSpace(tasks[index].UUID)
+		tasks[index].Status = strings.TrimSpace(tasks[index].Status)
+	}
+
+	return tasks, nil
+}
+
+func attributeValue(attributes []xml.Attr, name string) string {
+
+	for _, attribute := range attributes {
+		if qualifiedName(attribute.Name) == name {
+			return attribute.Value
+		}
+	}
+
+	return ""
+}
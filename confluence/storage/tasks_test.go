@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestTasks(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		body    string
+		want    []model.InlineTaskScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the tasks are complete and incomplete",
+			body: `<p>Action items</p>
+<ac:task-list>
+<ac:task>
+<ac:task-id>1</ac:task-id>
+<ac:task-uuid>3d1e6c2a-58a6-4c1f-9a3b-2f8e4a0b7c11</ac:task-uuid>
+<ac:task-status>incomplete</ac:task-status>
+<ac:task-body><ac:link><ri:user ri:account-id="account-a" /></ac:link> review the release&nbsp;notes <time datetime="2024-05-01" /></ac:task-body>
+</ac:task>
+<ac:task>
+<ac:task-id>2</ac:task-id>
+<ac:task-status>complete</ac:task-status>
+<ac:task-body>Publish the roadmap</ac:task-body>
+</ac:task>
+</ac:task-list>`,
+			want: []model.InlineTaskScheme{
+				{
+					ID:        "1",
+					UUID:      "3d1e6c2a-58a6-4c1f-9a3b-2f8e4a0b7c11",
+					Status:    model.InlineTaskStatusIncomplete,
+					AccountID: "account-a",
+					DueDate:   "2024-05-01",
+					Body:      `<ac:link><ri:user ri:account-id="account-a" /></ac:link> review the release&nbsp;notes <time datetime="2024-05-01" />`,
+				},
+				{
+					ID:     "2",
+					Status: model.InlineTaskStatusComplete,
+					Body:   "Publish the roadmap",
+				},
+			},
+		},
+
+		{
+			name: "when the tasks are nested",
+			body: `<ac:task-list><ac:task><ac:task-id>1</ac:task-id><ac:task-status>incomplete</ac:task-status>` +
+				`<ac:task-body>Prepare the release<ac:task-list><ac:task><ac:task-id>2</ac:task-id><ac:task-status>complete</ac:task-status>` +
+				`<ac:task-body><ac:link><ri:user ri:account-id="account-b" /></ac:link> tag the build</ac:task-body></ac:task></ac:task-list>` +
+				`</ac:task-body></ac:task></ac:task-list>`,
+			want: []model.InlineTaskScheme{
+				{
+					ID:     "1",
+					Status: model.InlineTaskStatusIncomplete,
+					Body: `Prepare the release<ac:task-list><ac:task><ac:task-id>2</ac:task-id><ac:task-status>complete</ac:task-status>` +
+						`<ac:task-body><ac:link><ri:user ri:account-id="account-b" /></ac:link> tag the build</ac:task-body></ac:task></ac:task-list>`,
+				},
+				{
+					ID:        "2",
+					Status:    model.InlineTaskStatusComplete,
+					AccountID: "account-b",
+					Body:      `<ac:link><ri:user ri:account-id="account-b" /></ac:link> tag the build`,
+				},
+			},
+		},
+
+		{
+			name: "when the body has no tasks",
+			body: `<p>Release notes</p><p><ac:link><ri:user ri:account-id="account-a" /></ac:link></p>`,
+		},
+
+		{
+			name:    "when the body is malformed",
+			body:    `<ac:task-list><ac:task><ac:task-id>1</ac:task-list>`,
+			wantErr: true,
+			Err:     model.ErrMalformedStorage,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := Tasks(testCase.body)
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
+
+func TestInlineTaskScheme_Completed(t *testing.T) {
+
+	tasks, err := Tasks(`<ac:task-list><ac:task><ac:task-status>complete</ac:task-status></ac:task><ac:task><ac:task-status>incomplete</ac:task-status></ac:task></ac:task-list>`)
+	assert.NoError(t, err)
+
+	assert.True(t, tasks[0].Completed())
+	assert.False(t, tasks[1].Completed())
+}
@@ -0,0 +1,167 @@
+package storage
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// ValidationError describes the first problem found on a storage format body.
+//
+// It wraps model.ErrMalformedStorage, the line and column are 1-based and point to the offending element.
+type ValidationError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%v: line %d, column %d: %v", model.ErrMalformedStorage, e.Line, e.Column, e.Message)
+}
+
+func (e *ValidationError) Unwrap() error {
+	return model.ErrMalformedStorage
+}
+
+// elementRules describes the required attributes and the allowed parents of the ac: and ri: elements.
+// Any of the attributes listed is enough, the element can be nested anywhere when no parent is listed.
+var elementRules = map[string]struct {
+	attributes []string
+	parents    []string
+}{
+	"ac:structured-macro":     {attributes: []string{"ac:name"}},
+	"ac:parameter":            {attributes: []string{"ac:name"}, parents: []string{"ac:structured-macro"}},
+	"ac:plain-text-body":      {parents: []string{"ac:structured-macro"}},
+	"ac:rich-text-body":       {parents: []string{"ac:structured-macro"}},
+	"ac:link-body":            {parents: []string{"ac:link"}},
+	"ac:plain-text-link-body": {parents: []string{"ac:link"}},
+	"ac:emoticon":             {attributes: []string{"ac:name"}},
+	"ri:page":                 {attributes: []string{"ri:content-title", "ri:content-id"}},
+	"ri:blog-post":            {attributes: []string{"ri:content-title", "ri:content-id"}},
+	"ri:attachment":           {attributes: []string{"ri:filename"}},
+	"ri:user":                 {attributes: []string{"ri:account-id", "ri:userkey"}},
+	"ri:url":                  {attributes: []string{"ri:value"}},
+	"ri:space":                {attributes: []string{"ri:space-key"}},
+}
+
+// resourceParents are the elements allowed to hold a ri: resource identifier.
+var resourceParents = []string{"ac:link", "ac:image", "ac:parameter", "ri:attachment"}
+
+// Validate checks the storage format body is well-formed XHTML and the ac: and ri: elements are structured
+// as Confluence expects, e.g. the macro parameters are named and nested on a macro.
+//
+// It's meant to be called before sending a generated body, e.g. with Content.Update, to get an actionable
+// *ValidationError instead of a bad request. The HTML named entities, e.g. &nbsp;, are accepted.
+func Validate(body string) error {
+
+	decoder := xml.NewDecoder(strings.NewReader(body))
+	decoder.Strict = true
+	decoder.Entity = xml.HTMLEntity
+
+	var parents []string
+	for {
+
+		offset := decoder.InputOffset()
+
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+
+			message := err.Error()
+
+			var syntaxErr *xml.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				message = syntaxErr.Msg
+			}
+
+			return validationError(body, elementOffset(body, int(offset)), message)
+		}
+
+		switch element := token.(type) {
+		case xml.StartElement:
+
+			name := qualifiedName(element.Name)
+			if err := checkElement(name, element.Attr, parents); err != "" {
+				return validationError(body, elementOffset(body, int(offset)), err)
+			}
+
+			parents = append(parents, name)
+
+		case xml.EndElement:
+			parents = parents[:len(parents)-1]
+		}
+	}
+
+	return nil
+}
+
+func checkElement(name string, attributes []xml.Attr, parents []string) string {
+
+	var parent string
+	if len(parents) != 0 {
+		parent = parents[len(parents)-1]
+	}
+
+	rule, ok := elementRules[name]
+	if !ok && !strings.HasPrefix(name, "ri:") {
+		return ""
+	}
+
+	allowed := rule.parents
+	if strings.HasPrefix(name, "ri:") {
+		allowed = resourceParents
+	}
+
+	if len(allowed) != 0 && !slices.Contains(allowed, parent) {
+		return fmt.Sprintf("<%v> must be nested on <%v>", name, strings.Join(allowed, ">, <"))
+	}
+
+	if len(rule.attributes) == 0 {
+		return ""
+	}
+
+	for _, attribute := range attributes {
+		if slices.Contains(rule.attributes, qualifiedName(attribute.Name)) && attribute.Value != "" {
+			return ""
+		}
+	}
+
+	return fmt.Sprintf("<%v> requires the %v attribute", name, strings.Join(rule.attributes, " or "))
+}
+
+func qualifiedName(name xml.Name) string {
+
+	if name.Space == "" {
+		return name.Local
+	}
+
+	return name.Space + ":" + name.Local
+}
+
+// elementOffset returns the offset of the markup starting after the offset, skipping the text before it.
+func elementOffset(body string, offset int) int {
+
+	if index := strings.IndexByte(body[offset:], '<'); index != -1 {
+		return offset + index
+	}
+
+	return offset
+}
+
+func validationError(body string, offset int, message string) error {
+
+	offset = min(offset, len(body))
+
+	line := 1 + strings.Count(body[:offset], "\n")
+	column := offset - strings.LastIndexByte(body[:offset], '\n')
+
+	return &ValidationError{Line: line, Column: column, Message: message}
+}
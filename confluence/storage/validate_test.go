@@ -0,0 +1,75 @@
+package storage
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestValidate(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		body    string
+		wantErr *ValidationError
+	}{
+		{
+			name: "when the body is valid",
+			body: `<p>Release&nbsp;notes</p>
+<ac:structured-macro ac:name="info"><ac:parameter ac:name="title">Note</ac:parameter><ac:rich-text-body><p>Body</p></ac:rich-text-body></ac:structured-macro>
+<p><ac:link><ri:page ri:content-title="Roadmap" /><ac:plain-text-link-body><![CDATA[Roadmap]]></ac:plain-text-link-body></ac:link></p>
+<ac:image><ri:attachment ri:filename="diagram.png"><ri:page ri:content-title="Architecture" /></ri:attachment></ac:image>
+<p>Line<br/>break</p>`,
+		},
+
+		{
+			name:    "when an element is not closed",
+			body:    "<p>Release notes</p>\n<p>Line<br>break</p>",
+			wantErr: &ValidationError{Line: 2, Column: 17, Message: "element <br> closed by </p>"},
+		},
+
+		{
+			name:    "when the macro is not named",
+			body:    "<p>Intro</p>\n  <ac:structured-macro><ac:rich-text-body/></ac:structured-macro>",
+			wantErr: &ValidationError{Line: 2, Column: 3, Message: "<ac:structured-macro> requires the ac:name attribute"},
+		},
+
+		{
+			name:    "when the parameter is not nested on a macro",
+			body:    `<p><ac:parameter ac:name="title">Note</ac:parameter></p>`,
+			wantErr: &ValidationError{Line: 1, Column: 4, Message: "<ac:parameter> must be nested on <ac:structured-macro>"},
+		},
+
+		{
+			name:    "when the resource identifier is not nested on a link",
+			body:    `<p><ri:page ri:content-title="Roadmap" /></p>`,
+			wantErr: &ValidationError{Line: 1, Column: 4, Message: "<ri:page> must be nested on <ac:link>, <ac:image>, <ac:parameter>, <ri:attachment>"},
+		},
+
+		{
+			name:    "when the attachment has no file name",
+			body:    `<ac:image><ri:attachment /></ac:image>`,
+			wantErr: &ValidationError{Line: 1, Column: 11, Message: "<ri:attachment> requires the ri:filename attribute"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			err := Validate(testCase.body)
+
+			if testCase.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			var validationErr *ValidationError
+			assert.True(t, errors.As(err, &validationErr))
+			assert.True(t, errors.Is(err, model.ErrMalformedStorage))
+			assert.Equal(t, testCase.wantErr, validationErr)
+		})
+	}
+}
@@ -239,6 +239,64 @@ func TestClient_Call(t *testing.T) {
 	}
 }
 
+func TestClient_CallBody(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		response *http.Response
+		want     string
+		wantErr  bool
+		Err      error
+	}{
+		{
+			name: "when the response is successful",
+			response: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader("%PDF-1.7")),
+				Request:    &http.Request{Method: http.MethodGet, URL: &url.URL{}},
+			},
+			want: "%PDF-1.7",
+		},
+
+		{
+			name: "when the response status is a not found",
+			response: &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(strings.NewReader("Hello, world!")),
+				Request:    &http.Request{Method: http.MethodGet, URL: &url.URL{}},
+			},
+			wantErr: true,
+			Err:     model.ErrNotFound,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			client := mocks.NewHTTPClient(t)
+			client.On("Do", (*http.Request)(nil)).
+				Return(testCase.response, nil)
+
+			c := &Client{HTTP: client}
+
+			body, got, err := c.CallBody(nil)
+
+			if testCase.wantErr {
+				assert.EqualError(t, err, testCase.Err.Error())
+				assert.Nil(t, body)
+				assert.Equal(t, testCase.response.StatusCode, got.Code)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, http.StatusOK, got.Code)
+
+				contents, err := io.ReadAll(body)
+				assert.NoError(t, err)
+				assert.NoError(t, body.Close())
+				assert.Equal(t, testCase.want, string(contents))
+			}
+		})
+	}
+}
+
 func TestClient_NewRequest(t *testing.T) {
 
 	authMocked := internal.NewAuthenticationService(nil)
@@ -269,6 +269,10 @@ func (i *internalBoardImpl) Get(ctx context.Context, boardID int) (*model.BoardS
 
 func (i *internalBoardImpl) Create(ctx context.Context, payload *model.BoardPayloadScheme) (*model.BoardScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	url := fmt.Sprintf("rest/agile/%v/board", i.version)
 
 	req, err := i.c.NewRequest(ctx, http.MethodPost, url, "", payload)
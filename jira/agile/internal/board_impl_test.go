@@ -260,6 +260,18 @@ func Test_BoardService_Create(t *testing.T) {
 			Err:     model.ErrNotFound,
 			wantErr: true,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -131,6 +131,17 @@ func (s *SprintService) Move(ctx context.Context, sprintID int, payload *model.S
 	return s.internalClient.Move(ctx, sprintID, payload)
 }
 
+// Report returns the sprint report of a board, with the completed, not completed and removed issues and their estimate sums.
+//
+// The report is provided by the private greenhopper API backing the sprint report chart, it may change without notice.
+//
+// GET /rest/greenhopper/1.0/rapid/charts/sprintreport
+//
+// https://docs.go-atlassian.io/jira-agile/sprints#get-sprint-report
+func (s *SprintService) Report(ctx context.Context, boardID, sprintID int) (*model.SprintReportScheme, *model.ResponseScheme, error) {
+	return s.internalClient.Report(ctx, boardID, sprintID)
+}
+
 type internalSprintImpl struct {
 	c       service.Connector
 	version string
@@ -176,6 +187,10 @@ func (i *internalSprintImpl) Get(ctx context.Context, sprintID int) (*model.Spri
 
 func (i *internalSprintImpl) Create(ctx context.Context, payload *model.SprintPayloadScheme) (*model.SprintScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	url := fmt.Sprintf("rest/agile/%v/sprint", i.version)
 
 	req, err := i.c.NewRequest(ctx, http.MethodPost, url, "", payload)
@@ -198,6 +213,10 @@ func (i *internalSprintImpl) Update(ctx context.Context, sprintID int, payload *
 		return nil, nil, model.ErrNoSprintID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	url := fmt.Sprintf("rest/agile/%v/sprint/%v", i.version, sprintID)
 
 	req, err := i.c.NewRequest(ctx, http.MethodPut, url, "", payload)
@@ -328,3 +347,33 @@ func (i *internalSprintImpl) Close(ctx context.Context, sprintID int) (*model.Re
 
 	return i.c.Call(req, nil)
 }
+
+func (i *internalSprintImpl) Report(ctx context.Context, boardID, sprintID int) (*model.SprintReportScheme, *model.ResponseScheme, error) {
+
+	if boardID == 0 {
+		return nil, nil, model.ErrNoBoardID
+	}
+
+	if sprintID == 0 {
+		return nil, nil, model.ErrNoSprintID
+	}
+
+	params := url.Values{}
+	params.Add("rapidViewId", strconv.Itoa(boardID))
+	params.Add("sprintId", strconv.Itoa(sprintID))
+
+	url := fmt.Sprintf("rest/greenhopper/1.0/rapid/charts/sprintreport?%v", params.Encode())
+
+	req, err := i.c.NewRequest(ctx, http.MethodGet, url, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	report := new(model.SprintReportScheme)
+	res, err := i.c.Call(req, report)
+	if err != nil {
+		return nil, res, err
+	}
+
+	return report, res, nil
+}
@@ -261,6 +261,18 @@ func Test_SprintService_Create(t *testing.T) {
 			Err:     errors.New("unable to create the http request"),
 			wantErr: true,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -402,6 +414,19 @@ func Test_SprintService_Update(t *testing.T) {
 			Err:     errors.New("unable to create the http request"),
 			wantErr: true,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:      context.Background(),
+				sprintID: 1001,
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1318,3 +1343,129 @@ func Test_SprintService_Move(t *testing.T) {
 		})
 	}
 }
+
+func Test_SprintService_Report(t *testing.T) {
+
+	type fields struct {
+		c service.Connector
+	}
+
+	type args struct {
+		ctx      context.Context
+		boardID  int
+		sprintID int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the parameters are correct",
+			args: args{
+				ctx:      context.Background(),
+				boardID:  4,
+				sprintID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/greenhopper/1.0/rapid/charts/sprintreport?rapidViewId=4&sprintId=12",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.SprintReportScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name: "when the board id is not provided",
+			args: args{
+				ctx:      context.Background(),
+				boardID:  0,
+				sprintID: 12,
+			},
+			wantErr: true,
+			Err:     model.ErrNoBoardID,
+		},
+
+		{
+			name: "when the sprint id is not provided",
+			args: args{
+				ctx:      context.Background(),
+				boardID:  4,
+				sprintID: 0,
+			},
+			wantErr: true,
+			Err:     model.ErrNoSprintID,
+		},
+
+		{
+			name: "when the http request cannot be created",
+			args: args{
+				ctx:      context.Background(),
+				boardID:  4,
+				sprintID: 12,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/greenhopper/1.0/rapid/charts/sprintreport?rapidViewId=4&sprintId=12",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			sprintService := NewSprintService(testCase.fields.c, "1.0")
+
+			gotResult, gotResponse, err := sprintService.Report(testCase.args.ctx, testCase.args.boardID, testCase.args.sprintID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
@@ -68,6 +68,10 @@ func (i *internalAnnouncementBannerImpl) Get(ctx context.Context) (*model.Announ
 
 func (i *internalAnnouncementBannerImpl) Update(ctx context.Context, payload *model.AnnouncementBannerPayloadScheme) (*model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/announcementBanner", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -296,6 +296,19 @@ func Test_internalAnnouncementBannerImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to execute the http call"),
 		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/fanout"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
@@ -95,6 +96,17 @@ func (i *IssueAttachmentService) Download(ctx context.Context, attachmentID stri
 	return i.internalClient.Download(ctx, attachmentID, redirect)
 }
 
+// DeleteBulk deletes many attachments, the attachments are deleted with a bounded concurrency.
+//
+// The failed attachments don't stop the operation, their errors are returned on the result keyed by the attachment ID.
+//
+// DELETE /rest/api/{2-3}/attachment/{attachmentID}
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues/attachments#delete-attachments
+func (i *IssueAttachmentService) DeleteBulk(ctx context.Context, attachmentIDs []string) (*model.IssueAttachmentDeleteBulkScheme, error) {
+	return i.internalClient.DeleteBulk(ctx, attachmentIDs)
+}
+
 type internalIssueAttachmentServiceImpl struct {
 	c       service.Connector
 	version string
@@ -247,3 +259,31 @@ func (i *internalIssueAttachmentServiceImpl) Add(ctx context.Context, issueKeyOr
 
 	return attachments, response, nil
 }
+
+// attachmentBulkWorkers is the number of attachments deleted concurrently by DeleteBulk.
+const attachmentBulkWorkers = 5
+
+func (i *internalIssueAttachmentServiceImpl) DeleteBulk(ctx context.Context, attachmentIDs []string) (*model.IssueAttachmentDeleteBulkScheme, error) {
+
+	if len(attachmentIDs) == 0 {
+		return nil, model.ErrNoAttachmentID
+	}
+
+	result := &model.IssueAttachmentDeleteBulkScheme{Total: len(attachmentIDs), Errors: make(map[string]error)}
+
+	errs := fanout.Map(attachmentIDs, attachmentBulkWorkers, func(attachmentID string) error {
+		_, err := i.Delete(ctx, attachmentID)
+		return err
+	})
+
+	for index, err := range errs {
+
+		if err != nil {
+			result.Errors[attachmentIDs[index]] = err
+		} else {
+			result.Deleted = append(result.Deleted, attachmentIDs[index])
+		}
+	}
+
+	return result, nil
+}
@@ -989,3 +989,152 @@ func TestNewIssueAttachmentService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalIssueAttachmentServiceImpl_DeleteBulk(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx           context.Context
+		attachmentIDs []string
+	}
+
+	testCases := []struct {
+		name        string
+		fields      fields
+		args        args
+		on          func(*fields)
+		wantDeleted []string
+		wantFailed  []string
+		wantErr     bool
+		Err         error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:           context.Background(),
+				attachmentIDs: []string{"10001", "10002"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/attachment/10001",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/attachment/10002",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			wantDeleted: []string{"10001", "10002"},
+		},
+
+		{
+			name:   "when an attachment cannot be deleted",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:           context.Background(),
+				attachmentIDs: []string{"10001", "10002"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/attachment/10001",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodDelete,
+					"rest/api/3/attachment/10002",
+					"",
+					nil).
+					Return(&http.Request{RequestURI: "10002"}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "10002"},
+					nil).
+					Return(&model.ResponseScheme{}, model.ErrNotFound)
+
+				fields.c = client
+			},
+			wantDeleted: []string{"10001"},
+			wantFailed:  []string{"10002"},
+		},
+
+		{
+			name:   "when the attachment ids are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoAttachmentID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			attachmentService, err := NewIssueAttachmentService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, err := attachmentService.DeleteBulk(testCase.args.ctx, testCase.args.attachmentIDs)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.Equal(t, len(testCase.args.attachmentIDs), gotResult.Total)
+				assert.ElementsMatch(t, testCase.wantDeleted, gotResult.Deleted)
+				assert.Len(t, gotResult.Errors, len(testCase.wantFailed))
+
+				for _, attachmentID := range testCase.wantFailed {
+					assert.ErrorIs(t, gotResult.Errors[attachmentID], model.ErrNotFound)
+				}
+			}
+
+		})
+	}
+}
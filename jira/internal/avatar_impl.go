@@ -0,0 +1,165 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewAvatarService creates a new instance of AvatarService.
+func NewAvatarService(client service.Connector, version string) (*AvatarService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &AvatarService{
+		internalClient: &internalAvatarImpl{c: client, version: version},
+	}, nil
+}
+
+// AvatarService provides methods to manage the avatars of the projects, issue types and priorities in Jira.
+type AvatarService struct {
+	// internalClient is the connector interface for avatar operations.
+	internalClient jira.AvatarConnector
+}
+
+// Gets returns the system and custom avatars for a project, issue type or priority.
+//
+// GET /rest/api/{2-3}/universal_avatar/type/{type}/owner/{entityId}
+//
+// https://docs.go-atlassian.io/jira-software-cloud/avatars#get-avatars
+func (a *AvatarService) Gets(ctx context.Context, avatarType, entityID string) (*model.AvatarsScheme, *model.ResponseScheme, error) {
+	return a.internalClient.Gets(ctx, avatarType, entityID)
+}
+
+// Upload loads a custom avatar for a project, issue type or priority.
+//
+// The avatar is cropped to a square of the size provided, a size 0 uses the image as it is.
+//
+// POST /rest/api/{2-3}/universal_avatar/type/{type}/owner/{entityId}
+//
+// https://docs.go-atlassian.io/jira-software-cloud/avatars#load-avatar
+func (a *AvatarService) Upload(ctx context.Context, avatarType, entityID string, r io.Reader, size int) (*model.AvatarScheme, *model.ResponseScheme, error) {
+	return a.internalClient.Upload(ctx, avatarType, entityID, r, size)
+}
+
+// Delete deletes an avatar from a project, issue type or priority.
+//
+// DELETE /rest/api/{2-3}/universal_avatar/type/{type}/owner/{owningObjectId}/avatar/{id}
+//
+// https://docs.go-atlassian.io/jira-software-cloud/avatars#delete-avatar
+func (a *AvatarService) Delete(ctx context.Context, avatarType, owningObjectID string, avatarID int) (*model.ResponseScheme, error) {
+	return a.internalClient.Delete(ctx, avatarType, owningObjectID, avatarID)
+}
+
+type internalAvatarImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalAvatarImpl) Gets(ctx context.Context, avatarType, entityID string) (*model.AvatarsScheme, *model.ResponseScheme, error) {
+
+	if err := validateAvatarOwner(avatarType, entityID); err != nil {
+		return nil, nil, err
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/universal_avatar/type/%v/owner/%v", i.version, avatarType, entityID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avatars := new(model.AvatarsScheme)
+	response, err := i.c.Call(request, avatars)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return avatars, response, nil
+}
+
+func (i *internalAvatarImpl) Upload(ctx context.Context, avatarType, entityID string, r io.Reader, size int) (*model.AvatarScheme, *model.ResponseScheme, error) {
+
+	if err := validateAvatarOwner(avatarType, entityID); err != nil {
+		return nil, nil, err
+	}
+
+	if r == nil {
+		return nil, nil, model.ErrNoReader
+	}
+
+	image := &bytes.Buffer{}
+	if _, err := io.Copy(image, r); err != nil {
+		return nil, nil, err
+	}
+
+	params := url.Values{}
+	params.Add("x", "0")
+	params.Add("y", "0")
+
+	if size != 0 {
+		params.Add("size", strconv.Itoa(size))
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/universal_avatar/type/%v/owner/%v?%v", i.version, avatarType, entityID, params.Encode())
+
+	// The content type is sniffed from the image, a content type also sends the X-Atlassian-Token header
+	// required by the endpoint.
+	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, http.DetectContentType(image.Bytes()), image)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	avatar := new(model.AvatarScheme)
+	response, err := i.c.Call(request, avatar)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return avatar, response, nil
+}
+
+func (i *internalAvatarImpl) Delete(ctx context.Context, avatarType, owningObjectID string, avatarID int) (*model.ResponseScheme, error) {
+
+	if err := validateAvatarOwner(avatarType, owningObjectID); err != nil {
+		return nil, err
+	}
+
+	if avatarID == 0 {
+		return nil, model.ErrNoAvatarID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/universal_avatar/type/%v/owner/%v/avatar/%v", i.version, avatarType, owningObjectID, avatarID)
+
+	request, err := i.c.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func validateAvatarOwner(avatarType, entityID string) error {
+
+	switch avatarType {
+	case model.AvatarTypeProject, model.AvatarTypeIssueType, model.AvatarTypePriority:
+	default:
+		return model.ErrNoAvatarType
+	}
+
+	if entityID == "" {
+		return model.ErrNoAvatarOwnerID
+	}
+
+	return nil
+}
@@ -5,6 +5,9 @@ import (
 	"github.com/ctreminiom/go-atlassian/v2/service"
 )
 
+// commentWalkPageSize is the number of comments fetched per page by Walk.
+const commentWalkPageSize = 100
+
 // NewCommentService creates a new instance of CommentADFService and CommentRichTextService.
 // It takes a service.Connector and a version string as input.
 // Returns pointers to CommentADFService and CommentRichTextService, and an error if the version is not provided.
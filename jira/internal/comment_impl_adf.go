@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/collect"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
@@ -55,6 +56,29 @@ func (c *CommentADFService) Add(ctx context.Context, issueKeyOrID string, payloa
 	return c.internalClient.Add(ctx, issueKeyOrID, payload, expand)
 }
 
+// AddText adds a plain text comment to an issue, the text is wrapped in an ADF document.
+//
+// Every line of the text is mapped to a paragraph, use Add to post a rich ADF comment.
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/comment
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues/comments#add-text-comment
+func (c *CommentADFService) AddText(ctx context.Context, issueKeyOrID, text string) (*model.IssueCommentScheme, *model.ResponseScheme, error) {
+	return c.internalClient.AddText(ctx, issueKeyOrID, text)
+}
+
+// Walk returns the comments of an issue and calls visit for every comment, one page after another.
+//
+// The comments are streamed instead of being gathered, so a single page is kept in memory. The first error returned
+// by visit stops the walk and it's returned.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}/comment
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues/comments#walk-comments
+func (c *CommentADFService) Walk(ctx context.Context, issueKeyOrID, orderBy string, expand []string, visit func(comment *model.IssueCommentScheme) error) error {
+	return c.internalClient.Walk(ctx, issueKeyOrID, orderBy, expand, visit)
+}
+
 type internalAdfCommentImpl struct {
 	c       service.Connector
 	version string
@@ -146,6 +170,10 @@ func (i *internalAdfCommentImpl) Add(ctx context.Context, issueKeyOrID string, p
 		return nil, nil, model.ErrNoIssueKeyOrID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	params := url.Values{}
 	if len(expand) != 0 {
 		params.Add("expand", strings.Join(expand, ","))
@@ -171,3 +199,25 @@ func (i *internalAdfCommentImpl) Add(ctx context.Context, issueKeyOrID string, p
 
 	return comment, response, nil
 }
+
+func (i *internalAdfCommentImpl) AddText(ctx context.Context, issueKeyOrID, text string) (*model.IssueCommentScheme, *model.ResponseScheme, error) {
+
+	if strings.TrimSpace(text) == "" {
+		return nil, nil, model.ErrNoCommentBody
+	}
+
+	return i.Add(ctx, issueKeyOrID, &model.CommentPayloadScheme{Body: model.NewCommentTextBody(text)}, nil)
+}
+
+func (i *internalAdfCommentImpl) Walk(ctx context.Context, issueKeyOrID, orderBy string, expand []string, visit func(comment *model.IssueCommentScheme) error) error {
+
+	return collect.WalkPages(ctx, func(ctx context.Context, startAt int) ([]*model.IssueCommentScheme, int, error) {
+
+		page, _, err := i.Gets(ctx, issueKeyOrID, orderBy, expand, startAt, commentWalkPageSize)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Comments, page.Total, nil
+	}, visit)
+}
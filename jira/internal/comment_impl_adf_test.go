@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/testutil"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 )
@@ -549,6 +551,21 @@ func Test_internalAdfCommentImpl_Add(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to create the http request"),
 		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				expand:       []string{"body"},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -582,3 +599,150 @@ func Test_internalAdfCommentImpl_Add(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalAdfCommentImpl_AddText(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueKeyOrID string
+		text         string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the text has several lines",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				text:         "Deployed to staging.\n\nRollback planned on failure.",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/DUMMY-1/comment",
+					"",
+					&model.CommentPayloadScheme{
+						Body: &model.CommentNodeScheme{
+							Version: 1,
+							Type:    "doc",
+							Content: []*model.CommentNodeScheme{
+								{Type: "paragraph", Content: []*model.CommentNodeScheme{{Type: "text", Text: "Deployed to staging."}}},
+								{Type: "paragraph"},
+								{Type: "paragraph", Content: []*model.CommentNodeScheme{{Type: "text", Text: "Rollback planned on failure."}}},
+							},
+						},
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueCommentScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the text is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				text:         " ",
+			},
+			wantErr: true,
+			Err:     model.ErrNoCommentBody,
+		},
+
+		{
+			name:   "when the issue key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "",
+				text:         "Deployed to staging.\n\nRollback planned on failure.",
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			commentService, _, err := NewCommentService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := commentService.AddText(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.text)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalAdfCommentImpl_Walk(t *testing.T) {
+
+	recorder := testutil.NewRecorder()
+	recorder.Respond(`{"startAt":0,"total":3,"comments":[{"id":"10001"},{"id":"10002"}]}`)
+	recorder.Respond(`{"startAt":2,"total":3,"comments":[{"id":"10003"}]}`)
+
+	commentService, _, err := NewCommentService(recorder, "3")
+	assert.NoError(t, err)
+
+	var ids []string
+	err = commentService.Walk(context.Background(), "DUMMY-1", "created", nil, func(comment *model.IssueCommentScheme) error {
+		ids = append(ids, comment.ID)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10001", "10002", "10003"}, ids)
+
+	testutil.ExpectRequest(t, recorder, testutil.Request{
+		Method: http.MethodGet,
+		Path:   "rest/api/3/issue/DUMMY-1/comment",
+		Query:  url.Values{"maxResults": {"100"}, "orderBy": {"created"}, "startAt": {"0"}},
+	})
+
+	testutil.ExpectRequest(t, recorder, testutil.Request{
+		Method: http.MethodGet,
+		Path:   "rest/api/3/issue/DUMMY-1/comment",
+		Query:  url.Values{"maxResults": {"100"}, "orderBy": {"created"}, "startAt": {"2"}},
+	})
+}
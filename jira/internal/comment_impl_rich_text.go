@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/collect"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
@@ -55,6 +56,18 @@ func (c *CommentRichTextService) Add(ctx context.Context, issueKeyOrID string, p
 	return c.internalClient.Add(ctx, issueKeyOrID, payload, expand)
 }
 
+// Walk returns the comments of an issue and calls visit for every comment, one page after another.
+//
+// The comments are streamed instead of being gathered, so a single page is kept in memory. The first error returned
+// by visit stops the walk and it's returned.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}/comment
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues/comments#walk-comments
+func (c *CommentRichTextService) Walk(ctx context.Context, issueKeyOrID, orderBy string, expand []string, visit func(comment *model.IssueCommentSchemeV2) error) error {
+	return c.internalClient.Walk(ctx, issueKeyOrID, orderBy, expand, visit)
+}
+
 type internalRichTextCommentImpl struct {
 	c       service.Connector
 	version string
@@ -146,6 +159,10 @@ func (i *internalRichTextCommentImpl) Add(ctx context.Context, issueKeyOrID stri
 		return nil, nil, model.ErrNoIssueKeyOrID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	params := url.Values{}
 	if len(expand) != 0 {
 		params.Add("expand", strings.Join(expand, ","))
@@ -171,3 +188,16 @@ func (i *internalRichTextCommentImpl) Add(ctx context.Context, issueKeyOrID stri
 
 	return comment, response, nil
 }
+
+func (i *internalRichTextCommentImpl) Walk(ctx context.Context, issueKeyOrID, orderBy string, expand []string, visit func(comment *model.IssueCommentSchemeV2) error) error {
+
+	return collect.WalkPages(ctx, func(ctx context.Context, startAt int) ([]*model.IssueCommentSchemeV2, int, error) {
+
+		page, _, err := i.Gets(ctx, issueKeyOrID, orderBy, expand, startAt, commentWalkPageSize)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return page.Comments, page.Total, nil
+	}, visit)
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/testutil"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 )
@@ -518,6 +520,21 @@ func Test_internalRichTextCommentImpl_Add(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to create the http request"),
 		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				expand:       []string{"body"},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -551,3 +568,34 @@ func Test_internalRichTextCommentImpl_Add(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalRichTextCommentImpl_Walk(t *testing.T) {
+
+	recorder := testutil.NewRecorder()
+	recorder.Respond(`{"startAt":0,"total":3,"comments":[{"id":"10001"},{"id":"10002"}]}`)
+	recorder.Respond(`{"startAt":2,"total":3,"comments":[{"id":"10003"}]}`)
+
+	_, commentService, err := NewCommentService(recorder, "2")
+	assert.NoError(t, err)
+
+	var ids []string
+	err = commentService.Walk(context.Background(), "DUMMY-1", "created", nil, func(comment *model.IssueCommentSchemeV2) error {
+		ids = append(ids, comment.ID)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10001", "10002", "10003"}, ids)
+
+	testutil.ExpectRequest(t, recorder, testutil.Request{
+		Method: http.MethodGet,
+		Path:   "rest/api/2/issue/DUMMY-1/comment",
+		Query:  url.Values{"maxResults": {"100"}, "orderBy": {"created"}, "startAt": {"0"}},
+	})
+
+	testutil.ExpectRequest(t, recorder, testutil.Request{
+		Method: http.MethodGet,
+		Path:   "rest/api/2/issue/DUMMY-1/comment",
+		Query:  url.Values{"maxResults": {"100"}, "orderBy": {"created"}, "startAt": {"2"}},
+	})
+}
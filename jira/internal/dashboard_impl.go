@@ -135,6 +135,10 @@ func (i *internalDashboardImpl) Gets(ctx context.Context, startAt, maxResults in
 
 func (i *internalDashboardImpl) Create(ctx context.Context, payload *model.DashboardPayloadScheme) (*model.DashboardScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/dashboard", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -262,6 +266,10 @@ func (i *internalDashboardImpl) Update(ctx context.Context, dashboardID string,
 		return nil, nil, model.ErrNoDashboardID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/dashboard/%v", i.version, dashboardID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
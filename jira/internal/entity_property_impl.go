@@ -0,0 +1,225 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// propertyEntities are the entity types supporting the entity properties.
+var propertyEntities = []string{model.PropertyEntityIssue, model.PropertyEntityProject, model.PropertyEntityComment, model.PropertyEntityIssueType}
+
+// NewEntityPropertyService creates a new instance of the EntityPropertyService.
+func NewEntityPropertyService(client service.Connector, version string) (*EntityPropertyService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &EntityPropertyService{
+		internalClient: &internalEntityPropertyImpl{c: client, version: version},
+	}, nil
+}
+
+// EntityPropertyService handles the entity properties of the issues, projects, comments and issue types for the Jira Cloud REST API.
+type EntityPropertyService struct {
+	internalClient jira.EntityPropertyConnector
+}
+
+/*
+Get returns the key and value of an entity property.
+
+The entity type is one of model.PropertyEntityIssue, model.PropertyEntityProject, model.PropertyEntityComment
+or model.PropertyEntityIssueType.
+
+Endpoint: GET /rest/api/{apiVersion}/{entityType}/{entityID}/properties/{propertyKey}
+
+You can refer to the documentation: [Get entity property]
+
+[Get entity property]: https://docs.go-atlassian.io/jira-software-cloud/properties#get-entity-property
+*/
+func (e *EntityPropertyService) Get(ctx context.Context, entityType, entityID, propertyKey string) (*model.EntityPropertyScheme, *model.ResponseScheme, error) {
+	return e.internalClient.Get(ctx, entityType, entityID, propertyKey)
+}
+
+/*
+Set sets the value of an entity property.
+  - The value must be a valid, non-empty JSON blob. The maximum length is 32768 characters.
+
+Endpoint: PUT /rest/api/{apiVersion}/{entityType}/{entityID}/properties/{propertyKey}
+
+You can refer to the documentation: [Set entity property]
+
+[Set entity property]: https://docs.go-atlassian.io/jira-software-cloud/properties#set-entity-property
+*/
+func (e *EntityPropertyService) Set(ctx context.Context, entityType, entityID, propertyKey string, value interface{}) (*model.ResponseScheme, error) {
+	return e.internalClient.Set(ctx, entityType, entityID, propertyKey, value)
+}
+
+/*
+Upsert creates or updates an entity property, it returns true if the property was written.
+  - The current value is read first and the property is only written if the value changed.
+  - If ifVersion is set, the current value must be a JSON object with a numeric version field equal to it,
+    the missing property counts as the version 0, otherwise model.ErrPropertyVersionConflict is returned.
+    The new value is expected to carry the next version, so the concurrent updaters are usually detected.
+    The guard is best-effort: Jira has no conditional write, the version is checked on the read and a concurrent
+    write landing between the read and the write is overwritten silently.
+
+Endpoint: GET /rest/api/{apiVersion}/{entityType}/{entityID}/properties/{propertyKey}
+
+Endpoint: PUT /rest/api/{apiVersion}/{entityType}/{entityID}/properties/{propertyKey}
+
+You can refer to the documentation: [Upsert entity property]
+
+[Upsert entity property]: https://docs.go-atlassian.io/jira-software-cloud/properties#upsert-entity-property
+*/
+func (e *EntityPropertyService) Upsert(ctx context.Context, entityType, entityID, propertyKey string, value interface{}, ifVersion *int) (bool, *model.ResponseScheme, error) {
+	return e.internalClient.Upsert(ctx, entityType, entityID, propertyKey, value, ifVersion)
+}
+
+type internalEntityPropertyImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalEntityPropertyImpl) Get(ctx context.Context, entityType, entityID, propertyKey string) (*model.EntityPropertyScheme, *model.ResponseScheme, error) {
+
+	endpoint, err := i.endpoint(entityType, entityID, propertyKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	property := new(model.EntityPropertyScheme)
+	response, err := i.c.Call(request, property)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return property, response, nil
+}
+
+func (i *internalEntityPropertyImpl) Set(ctx context.Context, entityType, entityID, propertyKey string, value interface{}) (*model.ResponseScheme, error) {
+
+	endpoint, err := i.endpoint(entityType, entityID, propertyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = (model.IssuePropertiesScheme{{Key: propertyKey, Value: value}}).Validate(); err != nil {
+		return nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", value)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.c.Call(request, nil)
+}
+
+func (i *internalEntityPropertyImpl) Upsert(ctx context.Context, entityType, entityID, propertyKey string, value interface{}, ifVersion *int) (bool, *model.ResponseScheme, error) {
+
+	if _, err := i.endpoint(entityType, entityID, propertyKey); err != nil {
+		return false, nil, err
+	}
+
+	if err := (model.IssuePropertiesScheme{{Key: propertyKey, Value: value}}).Validate(); err != nil {
+		return false, nil, err
+	}
+
+	current, response, err := i.Get(ctx, entityType, entityID, propertyKey)
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return false, response, err
+	}
+
+	if ifVersion != nil {
+
+		if version := propertyVersion(current); version != *ifVersion {
+			return false, response, fmt.Errorf("%w: expected %v, found %v", model.ErrPropertyVersionConflict, *ifVersion, version)
+		}
+	}
+
+	if current != nil {
+
+		changed, err := propertyChanged(current.Value, value)
+		if err != nil {
+			return false, response, err
+		}
+
+		if !changed {
+			return false, response, nil
+		}
+	}
+
+	response, err = i.Set(ctx, entityType, entityID, propertyKey, value)
+	if err != nil {
+		return false, response, err
+	}
+
+	return true, response, nil
+}
+
+func (i *internalEntityPropertyImpl) endpoint(entityType, entityID, propertyKey string) (string, error) {
+
+	if !slices.Contains(propertyEntities, entityType) {
+		return "", model.ErrInvalidPropertyEntity
+	}
+
+	if entityID == "" {
+		return "", model.ErrNoPropertyEntityID
+	}
+
+	if propertyKey == "" {
+		return "", model.ErrNoPropertyKey
+	}
+
+	return fmt.Sprintf("rest/api/%v/%v/%v/properties/%v", i.version, entityType, entityID, propertyKey), nil
+}
+
+// propertyVersion returns the version field of the property value, the missing property or version counts as 0.
+func propertyVersion(property *model.EntityPropertyScheme) int {
+
+	if property == nil {
+		return 0
+	}
+
+	values, ok := property.Value.(map[string]interface{})
+	if !ok {
+		return 0
+	}
+
+	version, _ := values["version"].(float64)
+	return int(version)
+}
+
+// propertyChanged reports if the values differ once encoded as JSON, so the Go values are compared with the decoded ones.
+func propertyChanged(current, value interface{}) (bool, error) {
+
+	normalized := make([]interface{}, 2)
+	for index, raw := range []interface{}{current, value} {
+
+		encoded, err := json.Marshal(raw)
+		if err != nil {
+			return false, err
+		}
+
+		if err = json.Unmarshal(encoded, &normalized[index]); err != nil {
+			return false, err
+		}
+	}
+
+	return !reflect.DeepEqual(normalized[0], normalized[1]), nil
+}
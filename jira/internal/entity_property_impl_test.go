@@ -0,0 +1,503 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func Test_internalEntityPropertyImpl_Get(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx         context.Context
+		entityType  string
+		entityID    string
+		propertyKey string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entityType:  model.PropertyEntityIssue,
+				entityID:    "KP-1",
+				propertyKey: "app.state",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/KP-1/properties/app.state",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.EntityPropertyScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:         context.Background(),
+				entityType:  model.PropertyEntityIssue,
+				entityID:    "KP-1",
+				propertyKey: "app.state",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/KP-1/properties/app.state",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.EntityPropertyScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the entity type is not supported",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entityType:  "board",
+				entityID:    "KP-1",
+				propertyKey: "app.state",
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidPropertyEntity,
+		},
+
+		{
+			name:   "when the entity id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entityType:  model.PropertyEntityIssue,
+				entityID:    "",
+				propertyKey: "app.state",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPropertyEntityID,
+		},
+
+		{
+			name:   "when the property key is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entityType:  model.PropertyEntityIssue,
+				entityID:    "KP-1",
+				propertyKey: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoPropertyKey,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entityType:  model.PropertyEntityIssue,
+				entityID:    "KP-1",
+				propertyKey: "app.state",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/issue/KP-1/properties/app.state",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewEntityPropertyService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Get(testCase.args.ctx, testCase.args.entityType, testCase.args.entityID, testCase.args.propertyKey)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalEntityPropertyImpl_Set(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx         context.Context
+		entityType  string
+		entityID    string
+		propertyKey string
+		value       interface{}
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entityType:  model.PropertyEntityIssue,
+				entityID:    "KP-1",
+				propertyKey: "app.state",
+				value:       map[string]interface{}{"version": 2},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issue/KP-1/properties/app.state",
+					"",
+					map[string]interface{}{"version": 2}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:         context.Background(),
+				entityType:  model.PropertyEntityIssue,
+				entityID:    "KP-1",
+				propertyKey: "app.state",
+				value:       map[string]interface{}{"version": 2},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/issue/KP-1/properties/app.state",
+					"",
+					map[string]interface{}{"version": 2}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the entity type is not supported",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entityType:  "board",
+				entityID:    "KP-1",
+				propertyKey: "app.state",
+				value:       map[string]interface{}{"version": 2},
+			},
+			wantErr: true,
+			Err:     model.ErrInvalidPropertyEntity,
+		},
+
+		{
+			name:   "when the entity id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entityType:  model.PropertyEntityIssue,
+				entityID:    "",
+				propertyKey: "app.state",
+				value:       map[string]interface{}{"version": 2},
+			},
+			wantErr: true,
+			Err:     model.ErrNoPropertyEntityID,
+		},
+
+		{
+			name:   "when the property key is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entityType:  model.PropertyEntityIssue,
+				entityID:    "KP-1",
+				propertyKey: "",
+				value:       map[string]interface{}{"version": 2},
+			},
+			wantErr: true,
+			Err:     model.ErrNoPropertyKey,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:         context.Background(),
+				entityType:  model.PropertyEntityIssue,
+				entityID:    "KP-1",
+				propertyKey: "app.state",
+				value:       map[string]interface{}{"version": 2},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issue/KP-1/properties/app.state",
+					"",
+					map[string]interface{}{"version": 2}).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewEntityPropertyService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResponse, err := newService.Set(testCase.args.ctx, testCase.args.entityType, testCase.args.entityID, testCase.args.propertyKey, testCase.args.value)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalEntityPropertyImpl_Upsert(t *testing.T) {
+
+	version := func(value int) *int { return &value }
+
+	testCases := []struct {
+		name        string
+		current     interface{}
+		getErr      error
+		value       interface{}
+		ifVersion   *int
+		wantWritten bool
+		wantErr     bool
+		Err         error
+	}{
+		{
+			name:        "when the property does not exist",
+			getErr:      model.ErrNotFound,
+			value:       map[string]interface{}{"version": 1, "state": "synced"},
+			ifVersion:   version(0),
+			wantWritten: true,
+		},
+
+		{
+			name:        "when the property value changed",
+			current:     map[string]interface{}{"version": float64(1), "state": "pending"},
+			value:       map[string]interface{}{"version": 2, "state": "synced"},
+			ifVersion:   version(1),
+			wantWritten: true,
+		},
+
+		{
+			name:    "when the property value did not change",
+			current: map[string]interface{}{"version": float64(1), "state": "synced"},
+			value: struct {
+				State   string `json:"state"`
+				Version int    `json:"version"`
+			}{State: "synced", Version: 1},
+		},
+
+		{
+			name:      "when the property version does not match",
+			current:   map[string]interface{}{"version": float64(3), "state": "synced"},
+			value:     map[string]interface{}{"version": 2, "state": "synced"},
+			ifVersion: version(1),
+			wantErr:   true,
+			Err:       model.ErrPropertyVersionConflict,
+		},
+
+		{
+			name:    "when the property cannot be fetched",
+			getErr:  model.ErrUnauthorized,
+			value:   map[string]interface{}{"version": 1},
+			wantErr: true,
+			Err:     model.ErrUnauthorized,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			client := mocks.NewConnector(t)
+
+			client.On("NewRequest",
+				context.Background(),
+				http.MethodGet,
+				"rest/api/3/issue/KP-1/properties/app.state",
+				"",
+				nil).
+				Return(&http.Request{}, nil)
+
+			client.On("Call",
+				&http.Request{},
+				&model.EntityPropertyScheme{}).
+				Run(func(args mock.Arguments) {
+					args.Get(1).(*model.EntityPropertyScheme).Value = testCase.current
+				}).
+				Return(&model.ResponseScheme{}, testCase.getErr).
+				Once()
+
+			if testCase.wantWritten {
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/3/issue/KP-1/properties/app.state",
+					"",
+					testCase.value).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+			}
+
+			newService, err := NewEntityPropertyService(client, "3")
+			assert.NoError(t, err)
+
+			gotWritten, gotResponse, err := newService.Upsert(context.Background(), model.PropertyEntityIssue, "KP-1", "app.state", testCase.value, testCase.ifVersion)
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err))
+				assert.False(t, gotWritten)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, gotResponse)
+			assert.Equal(t, testCase.wantWritten, gotWritten)
+		})
+	}
+
+	t.Run("when the entity type is not supported", func(t *testing.T) {
+
+		newService, err := NewEntityPropertyService(mocks.NewConnector(t), "3")
+		assert.NoError(t, err)
+
+		_, _, err = newService.Upsert(context.Background(), "board", "KP-1", "app.state", "synced", nil)
+		assert.True(t, errors.Is(err, model.ErrInvalidPropertyEntity))
+	})
+}
@@ -89,6 +89,10 @@ func (i *internalIssueFieldConfigItemServiceImpl) Update(ctx context.Context, id
 		return nil, model.ErrNoFieldConfigurationID
 	}
 
+	if payload == nil {
+		return nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/fieldconfiguration/%v/fields", i.version, id)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
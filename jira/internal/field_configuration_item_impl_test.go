@@ -312,6 +312,20 @@ func Test_internalIssueFieldConfigItemServiceImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to create the http created"),
 		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				id:  10001,
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
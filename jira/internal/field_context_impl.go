@@ -213,6 +213,10 @@ func (i *internalIssueFieldContextServiceImpl) Create(ctx context.Context, field
 		return nil, nil, model.ErrNoFieldID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context", i.version, fieldID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -319,6 +319,20 @@ func Test_internalIssueFieldContextServiceImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error"),
 		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:     context.Background(),
+				fieldID: "custom_field_10002",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -138,6 +138,10 @@ func (i *internalIssueFieldContextOptionServiceImpl) Create(ctx context.Context,
 		return nil, nil, model.ErrNoFieldContextID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context/%v/option", i.version, fieldID, contextID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -164,6 +168,10 @@ func (i *internalIssueFieldContextOptionServiceImpl) Update(ctx context.Context,
 		return nil, nil, model.ErrNoFieldContextID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/field/%v/context/%v/option", i.version, fieldID, contextID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -344,6 +344,21 @@ func Test_internalIssueFieldContextOptionServiceImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error"),
 		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				fieldID:   "custom_field_10002",
+				contextID: 10001,
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -529,6 +544,21 @@ func Test_internalIssueFieldContextOptionServiceImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error"),
 		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				fieldID:   "custom_field_10002",
+				contextID: 10001,
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -119,6 +119,10 @@ type internalFilterServiceImpl struct {
 
 func (i *internalFilterServiceImpl) Create(ctx context.Context, payload *model.FilterPayloadScheme) (*model.FilterScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/filter", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -269,6 +273,10 @@ func (i *internalFilterServiceImpl) Update(ctx context.Context, filterID int, pa
 		return nil, nil, model.ErrNoFilterID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/filter/%v", i.version, filterID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
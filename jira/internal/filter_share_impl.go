@@ -159,6 +159,10 @@ func (i *internalFilterShareImpl) Add(ctx context.Context, filterID int, payload
 		return nil, nil, model.ErrNoFilterID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/filter/%v/permission", i.version, filterID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
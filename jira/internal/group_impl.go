@@ -3,8 +3,10 @@ package internal
 import (
 	"context"
 	"fmt"
+	"html"
 	"net/http"
 	"net/url"
+	"regexp"
 	"strconv"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
@@ -86,6 +88,17 @@ func (g *GroupService) Create(ctx context.Context, groupName string) (*model.Gro
 	return g.internalClient.Create(ctx, groupName)
 }
 
+// Picker returns a list of groups whose names contain a query string.
+//
+// The groups names are returned as plain text, the HTML highlights of the matched query are removed.
+//
+// GET /rest/api/{2-3}/groups/picker
+//
+// https://docs.go-atlassian.io/jira-software-cloud/groups#find-groups
+func (g *GroupService) Picker(ctx context.Context, query string, maxResults int, excludeGroups []string) (*model.GroupUserPickerFoundGroupsScheme, *model.ResponseScheme, error) {
+	return g.internalClient.Picker(ctx, query, maxResults, excludeGroups)
+}
+
 type internalGroupServiceImpl struct {
 	c       service.Connector
 	version string
@@ -243,3 +256,42 @@ func (i *internalGroupServiceImpl) Remove(ctx context.Context, groupName, accoun
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalGroupServiceImpl) Picker(ctx context.Context, query string, maxResults int, excludeGroups []string) (*model.GroupUserPickerFoundGroupsScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	if query != "" {
+		params.Add("query", query)
+	}
+
+	for _, group := range excludeGroups {
+		params.Add("exclude", group)
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/groups/picker?%v", i.version, params.Encode())
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groups := new(model.GroupUserPickerFoundGroupsScheme)
+	response, err := i.c.Call(request, groups)
+	if err != nil {
+		return nil, response, err
+	}
+
+	for _, group := range groups.Groups {
+
+		if group.Name == "" {
+			group.Name = html.UnescapeString(htmlTagPattern.ReplaceAllString(group.HTML, ""))
+		}
+	}
+
+	return groups, response, nil
+}
+
+// htmlTagPattern matches the HTML tags used by Jira to highlight the query on the picker results.
+var htmlTagPattern = regexp.MustCompile(`<[^>]*>`)
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -995,3 +996,155 @@ func Test_NewGroupService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalGroupServiceImpl_Picker(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx           context.Context
+		query         string
+		maxResults    int
+		excludeGroups []string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:           context.Background(),
+				query:         "jira",
+				maxResults:    50,
+				excludeGroups: []string{"jira-administrators"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/groups/picker?exclude=jira-administrators&maxResults=50&query=jira",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.GroupUserPickerFoundGroupsScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.GroupUserPickerFoundGroupsScheme).Groups = []*model.GroupUserPickerFoundGroupScheme{
+							{HTML: "<b>jira</b>-users &amp; admins", GroupID: "276f955c-63d7-42c8-9520-92d01dca0625"},
+						}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:           context.Background(),
+				query:         "jira",
+				maxResults:    50,
+				excludeGroups: []string{"jira-administrators"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/groups/picker?exclude=jira-administrators&maxResults=50&query=jira",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.GroupUserPickerFoundGroupsScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.GroupUserPickerFoundGroupsScheme).Groups = []*model.GroupUserPickerFoundGroupScheme{
+							{HTML: "<b>jira</b>-users &amp; admins", GroupID: "276f955c-63d7-42c8-9520-92d01dca0625"},
+						}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:           context.Background(),
+				query:         "jira",
+				maxResults:    50,
+				excludeGroups: []string{"jira-administrators"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/groups/picker?exclude=jira-administrators&maxResults=50&query=jira",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			groupService, err := NewGroupService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := groupService.Picker(testCase.args.ctx, testCase.args.query, testCase.args.maxResults, testCase.args.excludeGroups)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Equal(t, "jira-users & admins", gotResult.Groups[0].Name)
+			}
+
+		})
+	}
+}
@@ -2,10 +2,16 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/fanout"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 )
@@ -134,19 +140,27 @@ func deleteIssue(ctx context.Context, client service.Connector, version, issueKe
 	return client.Call(request, nil)
 }
 
-func assignIssue(ctx context.Context, client service.Connector, version, issueKeyOrID, accountID string) (*model.ResponseScheme, error) {
+func assignIssue(ctx context.Context, client service.Connector, version, issueKeyOrID string, accountID *string) (*model.ResponseScheme, error) {
 
 	if issueKeyOrID == "" {
 		return nil, model.ErrNoIssueKeyOrID
 	}
 
-	if accountID == "" {
-		return nil, model.ErrNoAccountID
+	// A nil accountID is sent as null, so the issue is set to unassigned.
+	payload := map[string]interface{}{"accountId": nil}
+
+	if accountID != nil {
+
+		if *accountID == "" {
+			return nil, model.ErrNoAccountID
+		}
+
+		payload["accountId"] = *accountID
 	}
 
 	endpoint := fmt.Sprintf("/rest/api/%v/issue/%v/assignee", version, issueKeyOrID)
 
-	request, err := client.NewRequest(ctx, http.MethodPut, endpoint, "", map[string]interface{}{"accountId": accountID})
+	request, err := client.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +168,179 @@ func assignIssue(ctx context.Context, client service.Connector, version, issueKe
 	return client.Call(request, nil)
 }
 
+// createIssueWithOperations creates the issue with the update operations merged on the "update" block of the payload.
+// The operations are joined by UpdateOperations.Update, and the fields set on the "fields" block can't be updated.
+func createIssueWithOperations(ctx context.Context, client service.Connector, version string, payload map[string]interface{}, operations *model.UpdateOperations) (*model.IssueResponseScheme, *model.ResponseScheme, error) {
+
+	fields, _ := payload["fields"].(map[string]interface{})
+
+	var update map[string]interface{}
+
+	if operations != nil {
+
+		var err error
+		update, err = operations.Update()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for fieldID := range update {
+			if _, ok := fields[fieldID]; ok {
+				return nil, nil, fmt.Errorf("%w: %v", model.ErrIssueFieldUpdateConflict, fieldID)
+			}
+		}
+	}
+
+	if len(update) != 0 {
+		payload["update"] = update
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue", version)
+	request, err := client.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(model.IssueResponseScheme)
+	response, err := client.Call(request, issue)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return issue, response, nil
+}
+
+// assignRoundRobinWorkers is the number of issues assigned concurrently by AssignRoundRobin.
+const assignRoundRobinWorkers = 5
+
+func assignRoundRobin(ctx context.Context, client service.Connector, version string, issueKeys, accountIDs []string) (*model.IssueAssignBulkResultScheme, error) {
+
+	if len(issueKeys) == 0 {
+		return nil, model.ErrNoIssueKeyOrID
+	}
+
+	if len(accountIDs) == 0 {
+		return nil, model.ErrNoAccountID
+	}
+
+	result := &model.IssueAssignBulkResultScheme{
+		Total:    len(issueKeys),
+		Assigned: make(map[string]string),
+		Skipped:  make(map[string][]string),
+		Errors:   make(map[string]error),
+	}
+
+	var mu sync.Mutex
+
+	// pick returns the first account of the pool not skipped on the project, starting from the position.
+	pick := func(projectKey string, position int) (string, int, bool) {
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		for offset := 0; offset < len(accountIDs); offset++ {
+
+			index := (position + offset) % len(accountIDs)
+			if !slices.Contains(result.Skipped[projectKey], accountIDs[index]) {
+				return accountIDs[index], index, true
+			}
+		}
+
+		return "", 0, false
+	}
+
+	assign := func(position int) (string, error) {
+
+		issueKey := issueKeys[position]
+		projectKey := issueProjectKey(issueKey)
+
+		for {
+
+			accountID, index, ok := pick(projectKey, position)
+			if !ok {
+				return "", model.ErrNoAssignableAccount
+			}
+
+			_, err := assignIssue(ctx, client, version, issueKey, &accountID)
+			if err == nil || !errors.Is(err, model.ErrBadRequest) {
+				return accountID, err
+			}
+
+			// The assignment is rejected, the account is skipped on the project if it cannot be assigned to the issue.
+			assignable, checkErr := isAssignable(ctx, client, version, issueKey, accountID)
+			if checkErr != nil || assignable {
+				return accountID, err
+			}
+
+			mu.Lock()
+			if !slices.Contains(result.Skipped[projectKey], accountID) {
+				result.Skipped[projectKey] = append(result.Skipped[projectKey], accountID)
+			}
+			mu.Unlock()
+
+			position = index + 1
+		}
+	}
+
+	type assignment struct {
+		accountID string
+		err       error
+	}
+
+	positions := make([]int, len(issueKeys))
+	for position := range positions {
+		positions[position] = position
+	}
+
+	assignments := fanout.Map(positions, assignRoundRobinWorkers, func(position int) assignment {
+		accountID, err := assign(position)
+		return assignment{accountID: accountID, err: err}
+	})
+
+	for position, assignment := range assignments {
+
+		if assignment.err != nil {
+			result.Errors[issueKeys[position]] = assignment.err
+		} else {
+			result.Assigned[issueKeys[position]] = assignment.accountID
+		}
+	}
+
+	return result, nil
+}
+
+// issueProjectKey returns the project key of the issue key, e.g. DUMMY for DUMMY-1, or the value itself for an issue ID.
+func issueProjectKey(issueKey string) string {
+
+	if index := strings.LastIndex(issueKey, "-"); index > 0 {
+		return issueKey[:index]
+	}
+
+	return issueKey
+}
+
+// isAssignable reports if the user can be assigned to the issue.
+func isAssignable(ctx context.Context, client service.Connector, version, issueKey, accountID string) (bool, error) {
+
+	params := url.Values{}
+	params.Add("issueKey", issueKey)
+	params.Add("accountId", accountID)
+
+	endpoint := fmt.Sprintf("rest/api/%v/user/assignable/search?%v", version, params.Encode())
+
+	request, err := client.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return false, err
+	}
+
+	var users []*model.UserScheme
+	if _, err = client.Call(request, &users); err != nil {
+		return false, err
+	}
+
+	return len(users) != 0, nil
+}
+
 func sendNotification(ctx context.Context, client service.Connector, version, issueKeyOrID string, options *model.IssueNotifyOptionsScheme) (
 	*model.ResponseScheme, error) {
 
@@ -171,15 +358,37 @@ func sendNotification(ctx context.Context, client service.Connector, version, is
 	return client.Call(request, nil)
 }
 
-func getTransitions(ctx context.Context, client service.Connector, version, issueKeyOrID string) (*model.IssueTransitionsScheme, *model.ResponseScheme, error) {
+func getTransitions(ctx context.Context, client service.Connector, version, issueKeyOrID string, options *model.TransitionOptionsScheme) (*model.IssueTransitionsScheme, *model.ResponseScheme, error) {
 
 	if issueKeyOrID == "" {
 		return nil, nil, model.ErrNoIssueKeyOrID
 	}
 
-	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/transitions", version, issueKeyOrID)
+	var endpoint strings.Builder
+	endpoint.WriteString(fmt.Sprintf("rest/api/%v/issue/%v/transitions", version, issueKeyOrID))
 
-	request, err := client.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if options != nil {
+
+		params := url.Values{}
+
+		if len(options.Expand) != 0 {
+			params.Add("expand", strings.Join(options.Expand, ","))
+		}
+
+		if options.TransitionID != "" {
+			params.Add("transitionId", options.TransitionID)
+		}
+
+		if options.SkipRemoteOnlyCondition {
+			params.Add("skipRemoteOnlyCondition", "true")
+		}
+
+		if params.Encode() != "" {
+			endpoint.WriteString(fmt.Sprintf("?%v", params.Encode()))
+		}
+	}
+
+	request, err := client.NewRequest(ctx, http.MethodGet, endpoint.String(), "", nil)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -192,3 +401,420 @@ func getTransitions(ctx context.Context, client service.Connector, version, issu
 
 	return transitions, response, nil
 }
+
+func getEditMetadata(ctx context.Context, client service.Connector, version, issueKeyOrID string, overrideScreenSecurity, overrideEditableFlag bool) (*model.IssueEditMetadataScheme, *model.ResponseScheme, error) {
+
+	metadata := new(model.IssueEditMetadataScheme)
+	response, err := (&internalMetadataImpl{c: client, version: version}).get(ctx, issueKeyOrID, overrideScreenSecurity, overrideEditableFlag, metadata)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return metadata, response, nil
+}
+
+// changelogPageSize is the maximum number of changelog entries returned by Jira on a single page.
+const changelogPageSize = 100
+
+func getChangelogsSince(ctx context.Context, client service.Connector, version, issueKeyOrID, afterHistoryID string) ([]*model.IssueChangelogHistoryScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, model.ErrNoIssueKeyOrID
+	}
+
+	first, response, err := getChangelogPage(ctx, client, version, issueKeyOrID, 0, changelogPageSize)
+	if err != nil {
+		return nil, response, err
+	}
+
+	var histories []*model.IssueChangelogHistoryScheme
+
+	// The changelog is sorted from the oldest entry, so the pages are walked backwards from the last one.
+	for end := first.Total; end > 0; end -= changelogPageSize {
+
+		startAt := end - changelogPageSize
+		if startAt < 0 {
+			startAt = 0
+		}
+
+		values := first.Values
+		if startAt != 0 {
+
+			page, pageResponse, err := getChangelogPage(ctx, client, version, issueKeyOrID, startAt, end-startAt)
+			if err != nil {
+				return nil, pageResponse, err
+			}
+
+			values, response = page.Values, pageResponse
+
+		} else if len(values) > end {
+			values = values[:end]
+		}
+
+		for index := len(values) - 1; index >= 0; index-- {
+
+			if isChangelogReached(values[index], afterHistoryID) {
+				return reverseChangelogs(histories), response, nil
+			}
+
+			histories = append(histories, values[index])
+		}
+	}
+
+	return reverseChangelogs(histories), response, nil
+}
+
+func getChangelogPage(ctx context.Context, client service.Connector, version, issueKeyOrID string, startAt, maxResults int) (*model.IssueChangelogPageScheme, *model.ResponseScheme, error) {
+
+	params := url.Values{}
+	params.Add("startAt", strconv.Itoa(startAt))
+	params.Add("maxResults", strconv.Itoa(maxResults))
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/changelog?%v", version, issueKeyOrID, params.Encode())
+
+	request, err := client.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	page := new(model.IssueChangelogPageScheme)
+	response, err := client.Call(request, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// isChangelogReached reports whether the history is the given one or an older entry, as the history IDs are incremental.
+func isChangelogReached(history *model.IssueChangelogHistoryScheme, afterHistoryID string) bool {
+
+	if afterHistoryID == "" {
+		return false
+	}
+
+	if history.ID == afterHistoryID {
+		return true
+	}
+
+	historyID, err := strconv.Atoi(history.ID)
+	if err != nil {
+		return false
+	}
+
+	afterID, err := strconv.Atoi(afterHistoryID)
+	if err != nil {
+		return false
+	}
+
+	return historyID < afterID
+}
+
+func reverseChangelogs(histories []*model.IssueChangelogHistoryScheme) []*model.IssueChangelogHistoryScheme {
+
+	for left, right := 0, len(histories)-1; left < right; left, right = left+1, right-1 {
+		histories[left], histories[right] = histories[right], histories[left]
+	}
+
+	return histories
+}
+
+// bulkEditIssuesLimit is the maximum number of issues submitted on a single bulk edit.
+const bulkEditIssuesLimit = 1000
+
+func editBulkByJQL(ctx context.Context, client service.Connector, version, jql string, payload *model.BulkEditFieldsScheme) (*model.BulkEditTaskScheme, *model.ResponseScheme, error) {
+
+	if jql == "" {
+		return nil, nil, model.ErrNoJQL
+	}
+
+	if payload == nil || len(payload.SelectedActions) == 0 || payload.EditedFieldsInput == nil {
+		return nil, nil, model.ErrNoBulkEditFields
+	}
+
+	var issueIDs []string
+	search, err := walkJQLIssues(ctx, client, version, jql, func(issue *model.IssueScheme) error {
+
+		issueIDs = append(issueIDs, issue.ID)
+
+		if len(issueIDs) > bulkEditIssuesLimit {
+			return fmt.Errorf("%w: %v", model.ErrBulkEditLimitExceeded, bulkEditIssuesLimit)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, search, err
+	}
+
+	if len(issueIDs) == 0 {
+		return &model.BulkEditTaskScheme{}, nil, nil
+	}
+
+	edit := *payload
+	edit.SelectedIssueIdsOrKeys = issueIDs
+
+	request, err := client.NewRequest(ctx, http.MethodPost, fmt.Sprintf("rest/api/%v/bulk/issues/fields", version), "", &edit)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(model.BulkEditTaskScheme)
+	response, err := client.Call(request, task)
+	if err != nil {
+		return nil, response, err
+	}
+
+	task.Issues = len(issueIDs)
+
+	return task, response, nil
+}
+
+func getCreateMetaIssueTypes(ctx context.Context, client service.Connector, version, projectKeyOrID string, startAt, maxResults int) (*model.IssueCreateMetaIssueTypePageScheme, *model.ResponseScheme, error) {
+
+	metadata := &internalMetadataImpl{c: client, version: version}
+
+	page := new(model.IssueCreateMetaIssueTypePageScheme)
+	response, err := metadata.fetchIssueMappings(ctx, projectKeyOrID, startAt, maxResults, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// createMetaMaxResults is the page size of the create field metadata when the options don't set it, the Jira default.
+const createMetaMaxResults = 50
+
+func getCreateMeta(ctx context.Context, client service.Connector, version string, options *model.IssueCreateMetaOptions) (*model.IssueCreateMetaFieldPageScheme, *model.ResponseScheme, error) {
+
+	if options == nil {
+		return nil, nil, model.ErrNoProjectIDOrKey
+	}
+
+	maxResults := options.MaxResults
+	if maxResults == 0 {
+		maxResults = createMetaMaxResults
+	}
+
+	metadata := &internalMetadataImpl{c: client, version: version}
+
+	page := new(model.IssueCreateMetaFieldPageScheme)
+	response, err := metadata.fetchFieldMappings(ctx, options.ProjectKeyOrID, options.IssueTypeID, options.StartAt, maxResults, page)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return page, response, nil
+}
+
+// bulkMoveIssuesLimit is the maximum number of issues submitted on a single bulk move.
+const bulkMoveIssuesLimit = 1000
+
+func bulkMove(ctx context.Context, client service.Connector, version string, payload *model.IssueBulkMovePayloadScheme) (*model.IssueBulkMoveTaskScheme, *model.ResponseScheme, error) {
+
+	if payload == nil || len(payload.TargetToSourcesMapping) == 0 {
+		return nil, nil, model.ErrNoBulkMoveTargets
+	}
+
+	var issues int
+	for target, mapping := range payload.TargetToSourcesMapping {
+
+		if mapping == nil || len(mapping.IssueIdsOrKeys) == 0 {
+			return nil, nil, fmt.Errorf("%w: %v", model.ErrNoBulkMoveIssues, target)
+		}
+
+		issues += len(mapping.IssueIdsOrKeys)
+	}
+
+	if issues > bulkMoveIssuesLimit {
+		return nil, nil, model.ErrBulkMoveLimitExceeded
+	}
+
+	request, err := client.NewRequest(ctx, http.MethodPost, fmt.Sprintf("rest/api/%v/bulk/issues/move", version), "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	task := new(model.IssueBulkMoveTaskScheme)
+	response, err := client.Call(request, task)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return task, response, nil
+}
+
+func getBulkProgress(ctx context.Context, client service.Connector, version, taskID string) (*model.BulkOperationProgressScheme, *model.ResponseScheme, error) {
+
+	if taskID == "" {
+		return nil, nil, model.ErrNoTaskID
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/bulk/queue/%v", version, taskID)
+
+	request, err := client.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	progress := new(model.BulkOperationProgressScheme)
+	response, err := client.Call(request, progress)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return progress, response, nil
+}
+
+// bulkFetchIssuesLimit is the maximum number of issues fetched on a single bulk fetch.
+const bulkFetchIssuesLimit = 100
+
+func bulkFetchIssues(ctx context.Context, client service.Connector, version string, payload *model.IssueBulkFetchPayloadScheme, issues interface{}) (*model.ResponseScheme, error) {
+
+	if payload == nil || len(payload.IssueIdsOrKeys) == 0 {
+		return nil, model.ErrNoIssueKeyOrID
+	}
+
+	if len(payload.IssueIdsOrKeys) > bulkFetchIssuesLimit {
+		return nil, model.ErrBulkFetchLimitExceeded
+	}
+
+	request, err := client.NewRequest(ctx, http.MethodPost, fmt.Sprintf("rest/api/%v/issue/bulkfetch", version), "", payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Call(request, issues)
+}
+
+func getRendered(ctx context.Context, client service.Connector, version, issueKeyOrID string, fields []string) (*model.IssueRenderedScheme, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, model.ErrNoIssueKeyOrID
+	}
+
+	params := url.Values{}
+	params.Add("expand", "renderedFields")
+
+	if len(fields) != 0 {
+		params.Add("fields", strings.Join(fields, ","))
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/issue/%v?%v", version, issueKeyOrID, params.Encode())
+
+	request, err := client.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(model.IssueRenderedScheme)
+	response, err := client.Call(request, issue)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return issue, response, nil
+}
+
+// issueSubtasksScheme represents the subtasks of an issue, it's shared by the ADF and rich text issues.
+type issueSubtasksScheme struct {
+	Fields struct {
+		Subtasks []struct {
+			ID  string `json:"id"`
+			Key string `json:"key"`
+		} `json:"subtasks"`
+	} `json:"fields"`
+}
+
+// subtaskRankChunkSize is the maximum number of issues ranked on a single rank operation.
+const subtaskRankChunkSize = 50
+
+func reorderSubtasks(ctx context.Context, client service.Connector, version, parentKey string, orderedSubtaskKeys []string) (*model.ResponseScheme, error) {
+
+	if parentKey == "" {
+		return nil, model.ErrNoIssueKeyOrID
+	}
+
+	if len(orderedSubtaskKeys) == 0 {
+		return nil, model.ErrNoSubtaskKeys
+	}
+
+	params := url.Values{}
+	params.Add("fields", "subtasks")
+
+	request, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/%v/issue/%v?%v", version, parentKey, params.Encode()), "", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	parent := new(issueSubtasksScheme)
+
+	response, err := client.Call(request, parent)
+	if err != nil {
+		return response, err
+	}
+
+	subtasks := make(map[string]bool)
+	for _, subtask := range parent.Fields.Subtasks {
+		subtasks[subtask.Key] = true
+		subtasks[subtask.ID] = true
+	}
+
+	for _, key := range orderedSubtaskKeys {
+		if !subtasks[key] {
+			return response, fmt.Errorf("%w: %v", model.ErrNotSubtaskOfParent, key)
+		}
+	}
+
+	// Every chunk is ranked after the last subtask of the previous one, so the order is kept across the chunks.
+	for start := 1; start < len(orderedSubtaskKeys); start += subtaskRankChunkSize {
+
+		end := min(start+subtaskRankChunkSize, len(orderedSubtaskKeys))
+
+		payload := map[string]interface{}{
+			"issues":         orderedSubtaskKeys[start:end],
+			"rankAfterIssue": orderedSubtaskKeys[start-1],
+		}
+
+		request, err = client.NewRequest(ctx, http.MethodPut, "rest/agile/1.0/issue/rank", "", payload)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err = client.Call(request, nil)
+		if err != nil {
+			return response, err
+		}
+	}
+
+	return response, nil
+}
+
+// issueGetPropertiesLimit is the maximum number of entity properties returned along with an issue.
+const issueGetPropertiesLimit = 5
+
+// issueWithPropertiesEndpoint returns the endpoint fetching an issue with the fields and the entity properties selected.
+func issueWithPropertiesEndpoint(version, issueKeyOrID string, fields, properties []string) (string, error) {
+
+	if issueKeyOrID == "" {
+		return "", model.ErrNoIssueKeyOrID
+	}
+
+	if len(properties) == 0 {
+		return "", model.ErrNoPropertyKey
+	}
+
+	if len(properties) > issueGetPropertiesLimit {
+		return "", model.ErrTooManyIssueProperties
+	}
+
+	params := url.Values{}
+	params.Add("properties", strings.Join(properties, ","))
+
+	if len(fields) != 0 {
+		params.Add("fields", strings.Join(fields, ","))
+	}
+
+	return fmt.Sprintf("rest/api/%v/issue/%v?%v", version, issueKeyOrID, params.Encode()), nil
+}
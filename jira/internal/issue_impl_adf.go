@@ -10,6 +10,7 @@ import (
 	"dario.cat/mergo"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/task"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
 )
@@ -71,16 +72,29 @@ func (i *IssueADFService) Delete(ctx context.Context, issueKeyOrID string, delet
 //
 // If accountID is set to:
 //
-//  1. "-1", the issue is assigned to the default assignee for the project.
-//  2. null, the issue is set to unassigned.
+//  1. model.AutomaticAssignee(), the issue is assigned to the default assignee for the project.
+//  2. nil, the issue is set to unassigned.
 //
 // PUT /rest/api/{2-3}/issue/{issueKeyOrID}/assignee
 //
 // https://docs.go-atlassian.io/jira-software-cloud/issues#assign-issue
-func (i *IssueADFService) Assign(ctx context.Context, issueKeyOrID, accountID string) (*model.ResponseScheme, error) {
+func (i *IssueADFService) Assign(ctx context.Context, issueKeyOrID string, accountID *string) (*model.ResponseScheme, error) {
 	return i.internalClient.Assign(ctx, issueKeyOrID, accountID)
 }
 
+// AssignRoundRobin assigns the issues to a pool of users, distributing the issues evenly in turns.
+//
+// The issues are assigned concurrently, the accounts rejected because they cannot be assigned to an issue are
+// skipped on the remaining assignments of the same project. The errors are reported per issue, the assignment
+// continues on failure.
+//
+// PUT /rest/api/{2-3}/issue/{issueKeyOrID}/assignee
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues#assign-issue
+func (i *IssueADFService) AssignRoundRobin(ctx context.Context, issueKeys, accountIDs []string) (*model.IssueAssignBulkResultScheme, error) {
+	return i.internalClient.AssignRoundRobin(ctx, issueKeys, accountIDs)
+}
+
 // Notify creates an email notification for an issue and adds it to the mail queue.
 //
 // POST /rest/api/{2-3}/issue/{issueKeyOrID}/notify
@@ -96,11 +110,13 @@ func (i *IssueADFService) Notify(ctx context.Context, issueKeyOrID string, optio
 //
 // given its status, the response will return any empty transitions list.
 //
+// The transitions.fields expand returns the fields required to perform each transition.
+//
 // GET /rest/api/{2-3}/issue/{issueKeyOrID}/transitions
 //
 // https://docs.go-atlassian.io/jira-software-cloud/issues#get-transitions
-func (i *IssueADFService) Transitions(ctx context.Context, issueKeyOrID string) (*model.IssueTransitionsScheme, *model.ResponseScheme, error) {
-	return i.internalClient.Transitions(ctx, issueKeyOrID)
+func (i *IssueADFService) Transitions(ctx context.Context, issueKeyOrID string, options *model.TransitionOptionsScheme) (*model.IssueTransitionsScheme, *model.ResponseScheme, error) {
+	return i.internalClient.Transitions(ctx, issueKeyOrID, options)
 }
 
 // Create creates an issue or, where the option to create subtasks is enabled in Jira, a subtask.
@@ -112,6 +128,19 @@ func (i *IssueADFService) Create(ctx context.Context, payload *model.IssueScheme
 	return i.internalClient.Create(ctx, payload, customFields)
 }
 
+// CreateWithOperations creates an issue applying the update operations on creation, e.g. to add an initial comment or worklog.
+//
+// The fields and the operations are sent on a single request, so the issue is never visible without them.
+// A field can't be set on the payload and updated by the operations, model.ErrIssueFieldUpdateConflict is returned.
+// The operations of a field must be a []map[string]interface{}, model.ErrInvalidUpdateOperation is returned otherwise.
+//
+// POST /rest/api/{2-3}/issue
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues#create-issue
+func (i *IssueADFService) CreateWithOperations(ctx context.Context, payload *model.IssueScheme, customFields *model.CustomFields, operations *model.UpdateOperations) (*model.IssueResponseScheme, *model.ResponseScheme, error) {
+	return i.internalClient.CreateWithOperations(ctx, payload, customFields, operations)
+}
+
 // Creates issues and, where the option to create subtasks is enabled in Jira, subtasks.
 //
 // 1.Creates upto 50 issues and, where the option to create subtasks is enabled in Jira, subtasks.
@@ -155,6 +184,8 @@ func (i *IssueADFService) Update(ctx context.Context, issueKeyOrID string, notif
 
 // Move performs an issue transition and, if the transition has a screen, updates the fields from the transition screen.
 //
+// The options HistoryMetadata attributes the transition on the issue history, e.g. to the integration performing it.
+//
 // sortByCategory To update the fields on the transition screen, specify the fields in the fields or update parameters in the request body. Get details about the fields using Get transitions with the transitions.fields expand.
 //
 // POST /rest/api/{2-3}/issue/{issueKeyOrID}/transitions
@@ -164,6 +195,148 @@ func (i *IssueADFService) Move(ctx context.Context, issueKeyOrID, transitionID s
 	return i.internalClient.Move(ctx, issueKeyOrID, transitionID, options)
 }
 
+// EditMeta returns the edit screen fields for an issue that are visible to and editable by the user.
+//
+// Every field contains the update operations allowed, use the model.IssueUpdateBuilder to validate the operations against it.
+//
+// The overrides return the fields hidden from the screens or not editable, they require the administrator permissions.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}/editmeta
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues#get-edit-issue-metadata
+func (i *IssueADFService) EditMeta(ctx context.Context, issueKeyOrID string, overrideScreenSecurity, overrideEditableFlag bool) (*model.IssueEditMetadataScheme, *model.ResponseScheme, error) {
+	return i.internalClient.EditMeta(ctx, issueKeyOrID, overrideScreenSecurity, overrideEditableFlag)
+}
+
+// ChangelogsSince returns the changelog entries of an issue created after the given history ID.
+//
+// The changelog pages are walked from the newest entries and the paging stops once the history ID is reached,
+// the entries are returned in chronological order. If afterHistoryID is empty, the whole changelog is returned.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}/changelog
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues#get-changelogs
+func (i *IssueADFService) ChangelogsSince(ctx context.Context, issueKeyOrID, afterHistoryID string) ([]*model.IssueChangelogHistoryScheme, *model.ResponseScheme, error) {
+	return i.internalClient.ChangelogsSince(ctx, issueKeyOrID, afterHistoryID)
+}
+
+// EditBulkByJQL edits the fields of every issue matching a JQL query using the server-side bulk edit.
+//
+// The issues are searched using the token based pagination and submitted on a single bulk edit,
+// the operation is processed asynchronously, so the ID of the task tracking it is returned, see AwaitBulk.
+//
+// The bulk edit is limited to 1000 issues, the queries matching more issues are rejected.
+//
+// POST /rest/api/{2-3}/search/jql
+//
+// POST /rest/api/{2-3}/bulk/issues/fields
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues#bulk-edit-issues-by-jql
+func (i *IssueADFService) EditBulkByJQL(ctx context.Context, jql string, payload *model.BulkEditFieldsScheme) (*model.BulkEditTaskScheme, *model.ResponseScheme, error) {
+	return i.internalClient.EditBulkByJQL(ctx, jql, payload)
+}
+
+// CreateMetaIssueTypes returns a page of the issue types available to create issues in a project.
+//
+// GET /rest/api/{2-3}/issue/createmeta/{projectIdOrKey}/issuetypes
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues#get-create-metadata-issue-types
+func (i *IssueADFService) CreateMetaIssueTypes(ctx context.Context, projectKeyOrID string, startAt, maxResults int) (*model.IssueCreateMetaIssueTypePageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.CreateMetaIssueTypes(ctx, projectKeyOrID, startAt, maxResults)
+}
+
+// CreateMeta returns a page of the fields available to create issues of an issue type in a project.
+//
+// The fields include the required flags and the allowed values, use them to build dynamic create forms.
+//
+// GET /rest/api/{2-3}/issue/createmeta/{projectIdOrKey}/issuetypes/{issueTypeId}
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues#get-create-field-metadata
+func (i *IssueADFService) CreateMeta(ctx context.Context, options *model.IssueCreateMetaOptions) (*model.IssueCreateMetaFieldPageScheme, *model.ResponseScheme, error) {
+	return i.internalClient.CreateMeta(ctx, options)
+}
+
+// BulkMove moves issues between projects and issue types, mapping the statuses and the fields required by the targets.
+//
+// The operation is processed asynchronously, so the ID of the task tracking it is returned, see AwaitBulk.
+// The bulk move is limited to 1000 issues.
+//
+// POST /rest/api/{2-3}/bulk/issues/move
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues#bulk-move-issues
+func (i *IssueADFService) BulkMove(ctx context.Context, payload *model.IssueBulkMovePayloadScheme) (*model.IssueBulkMoveTaskScheme, *model.ResponseScheme, error) {
+	return i.internalClient.BulkMove(ctx, payload)
+}
+
+// BulkProgress returns the progress of a bulk operation, e.g. the tasks of EditBulkByJQL and BulkMove.
+//
+// GET /rest/api/{2-3}/bulk/queue/{taskId}
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues#get-bulk-issue-operation-progress
+func (i *IssueADFService) BulkProgress(ctx context.Context, taskID string) (*model.BulkOperationProgressScheme, *model.ResponseScheme, error) {
+	return i.internalClient.BulkProgress(ctx, taskID)
+}
+
+// AwaitBulk polls the progress of a bulk operation until it finishes, e.g. the tasks of EditBulkByJQL and BulkMove.
+//
+// The failed, canceled or dead operations return an error wrapping model.ErrTaskFailed, see task.Await for the polling.
+//
+// GET /rest/api/{2-3}/bulk/queue/{taskId}
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues#get-bulk-issue-operation-progress
+func (i *IssueADFService) AwaitBulk(ctx context.Context, taskID string, options ...task.Option) (*model.BulkOperationProgressScheme, error) {
+	return task.Await(ctx, task.JiraBulkTask(i, taskID), options...)
+}
+
+// BulkFetch returns the issues with the provided IDs or keys, with the fields, expansions and properties selected.
+//
+// The issues not found or not visible are returned on the issue errors, instead of failing the whole request.
+// The bulk fetch is limited to 100 issues.
+//
+// POST /rest/api/{2-3}/issue/bulkfetch
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues#bulk-fetch-issues
+func (i *IssueADFService) BulkFetch(ctx context.Context, payload *model.IssueBulkFetchPayloadScheme) (*model.IssueBulkFetchScheme, *model.ResponseScheme, error) {
+	return i.internalClient.BulkFetch(ctx, payload)
+}
+
+// GetRendered returns the issue with the HTML rendered values of the rich-text fields, along with the raw values.
+//
+// The fields limit the fields returned, all the fields are returned if they are not set.
+// The fields without a rendered form are only available as raw values.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}?expand=renderedFields
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues#get-issue-rendered
+func (i *IssueADFService) GetRendered(ctx context.Context, issueKeyOrID string, fields []string) (*model.IssueRenderedScheme, *model.ResponseScheme, error) {
+	return i.internalClient.GetRendered(ctx, issueKeyOrID, fields)
+}
+
+// ReorderSubtasks sets the order of the subtasks under the parent issue, ranking them in the order provided.
+//
+// Every key must belong to a subtask of the parent, the subtasks not provided keep their rank.
+// The subtasks are ranked using the Jira Software rank operation, in chunks of 50 issues.
+//
+// GET /rest/api/{2-3}/issue/{parentKey}
+//
+// PUT /rest/agile/1.0/issue/rank
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues#reorder-subtasks
+func (i *IssueADFService) ReorderSubtasks(ctx context.Context, parentKey string, orderedSubtaskKeys []string) (*model.ResponseScheme, error) {
+	return i.internalClient.ReorderSubtasks(ctx, parentKey, orderedSubtaskKeys)
+}
+
+// GetWithProperties returns the details for an issue along with the entity properties selected, up to 5.
+//
+// The properties are returned on the issue Properties field, sparing the requests to the issue properties endpoints.
+//
+// GET /rest/api/{2-3}/issue/{issueKeyOrID}?properties={properties}
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues#get-issue-with-properties
+func (i *IssueADFService) GetWithProperties(ctx context.Context, issueKeyOrID string, fields, properties []string) (*model.IssueScheme, *model.ResponseScheme, error) {
+	return i.internalClient.GetWithProperties(ctx, issueKeyOrID, fields, properties)
+}
+
 type internalIssueADFServiceImpl struct {
 	c       service.Connector
 	version string
@@ -173,19 +346,30 @@ func (i *internalIssueADFServiceImpl) Delete(ctx context.Context, issueKeyOrID s
 	return deleteIssue(ctx, i.c, i.version, issueKeyOrID, deleteSubTasks)
 }
 
-func (i *internalIssueADFServiceImpl) Assign(ctx context.Context, issueKeyOrID, accountID string) (*model.ResponseScheme, error) {
+func (i *internalIssueADFServiceImpl) Assign(ctx context.Context, issueKeyOrID string, accountID *string) (*model.ResponseScheme, error) {
 	return assignIssue(ctx, i.c, i.version, issueKeyOrID, accountID)
 }
 
+func (i *internalIssueADFServiceImpl) AssignRoundRobin(ctx context.Context, issueKeys, accountIDs []string) (*model.IssueAssignBulkResultScheme, error) {
+	return assignRoundRobin(ctx, i.c, i.version, issueKeys, accountIDs)
+}
+
 func (i *internalIssueADFServiceImpl) Notify(ctx context.Context, issueKeyOrID string, options *model.IssueNotifyOptionsScheme) (*model.ResponseScheme, error) {
 	return sendNotification(ctx, i.c, i.version, issueKeyOrID, options)
 }
 
-func (i *internalIssueADFServiceImpl) Transitions(ctx context.Context, issueKeyOrID string) (*model.IssueTransitionsScheme, *model.ResponseScheme, error) {
-	return getTransitions(ctx, i.c, i.version, issueKeyOrID)
+func (i *internalIssueADFServiceImpl) Transitions(ctx context.Context, issueKeyOrID string, options *model.TransitionOptionsScheme) (*model.IssueTransitionsScheme, *model.ResponseScheme, error) {
+	return getTransitions(ctx, i.c, i.version, issueKeyOrID, options)
 }
 
 func (i *internalIssueADFServiceImpl) Create(ctx context.Context, payload *model.IssueScheme, customFields *model.CustomFields) (*model.IssueResponseScheme, *model.ResponseScheme, error) {
+
+	if payload != nil {
+		if err := payload.Properties.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	var body interface{} = payload
 	var err error
 
@@ -214,6 +398,32 @@ func (i *internalIssueADFServiceImpl) Create(ctx context.Context, payload *model
 	return issue, response, nil
 }
 
+func (i *internalIssueADFServiceImpl) CreateWithOperations(ctx context.Context, payload *model.IssueScheme, customFields *model.CustomFields, operations *model.UpdateOperations) (*model.IssueResponseScheme, *model.ResponseScheme, error) {
+
+	if payload == nil {
+		return nil, nil, model.ErrNoIssueScheme
+	}
+
+	if err := payload.Properties.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	body, err := payload.ToMap()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if customFields != nil && len(customFields.Fields) != 0 {
+
+		body, err = payload.MergeCustomFields(customFields)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return createIssueWithOperations(ctx, i.c, i.version, body, operations)
+}
+
 func (i *internalIssueADFServiceImpl) Creates(ctx context.Context, payload []*model.IssueBulkSchemeV3) (*model.IssueBulkResponseScheme, *model.ResponseScheme, error) {
 
 	if len(payload) == 0 {
@@ -227,6 +437,10 @@ func (i *internalIssueADFServiceImpl) Creates(ctx context.Context, payload []*mo
 			continue
 		}
 
+		if err := newIssue.Payload.Properties.Validate(); err != nil {
+			return nil, nil, err
+		}
+
 		issuePayload, err := newIssue.Payload.MergeCustomFields(newIssue.CustomFields)
 		if err != nil {
 			return nil, nil, err
@@ -294,6 +508,12 @@ func (i *internalIssueADFServiceImpl) Update(ctx context.Context, issueKeyOrID s
 		return nil, model.ErrNoIssueKeyOrID
 	}
 
+	if payload != nil {
+		if err := payload.Properties.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	params := url.Values{}
 	params.Add("notifyUsers", fmt.Sprintf("%v", notify))
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v?%v", i.version, issueKeyOrID, params.Encode())
@@ -371,11 +591,20 @@ func (i *internalIssueADFServiceImpl) Move(ctx context.Context, issueKeyOrID, tr
 
 	payload := map[string]interface{}{"transition": map[string]interface{}{"id": transitionID}}
 
-	if options != nil {
-		if options.Fields == nil {
-			return nil, model.ErrNoIssueScheme
+	if options != nil && options.Fields == nil && options.HistoryMetadata == nil {
+		return nil, model.ErrNoIssueScheme
+	}
+
+	if options != nil && options.HistoryMetadata != nil {
+		if err := options.HistoryMetadata.Validate(); err != nil {
+			return nil, err
 		}
 
+		payload["historyMetadata"] = options.HistoryMetadata
+	}
+
+	if options != nil && options.Fields != nil {
+
 		// Merge the customfields and operations
 		payloadWithFields, err := options.Fields.MergeCustomFields(options.CustomFields)
 		if err != nil {
@@ -402,3 +631,71 @@ func (i *internalIssueADFServiceImpl) Move(ctx context.Context, issueKeyOrID, tr
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalIssueADFServiceImpl) EditMeta(ctx context.Context, issueKeyOrID string, overrideScreenSecurity, overrideEditableFlag bool) (*model.IssueEditMetadataScheme, *model.ResponseScheme, error) {
+	return getEditMetadata(ctx, i.c, i.version, issueKeyOrID, overrideScreenSecurity, overrideEditableFlag)
+}
+
+func (i *internalIssueADFServiceImpl) ChangelogsSince(ctx context.Context, issueKeyOrID, afterHistoryID string) ([]*model.IssueChangelogHistoryScheme, *model.ResponseScheme, error) {
+	return getChangelogsSince(ctx, i.c, i.version, issueKeyOrID, afterHistoryID)
+}
+
+func (i *internalIssueADFServiceImpl) EditBulkByJQL(ctx context.Context, jql string, payload *model.BulkEditFieldsScheme) (*model.BulkEditTaskScheme, *model.ResponseScheme, error) {
+	return editBulkByJQL(ctx, i.c, i.version, jql, payload)
+}
+
+func (i *internalIssueADFServiceImpl) CreateMetaIssueTypes(ctx context.Context, projectKeyOrID string, startAt, maxResults int) (*model.IssueCreateMetaIssueTypePageScheme, *model.ResponseScheme, error) {
+	return getCreateMetaIssueTypes(ctx, i.c, i.version, projectKeyOrID, startAt, maxResults)
+}
+
+func (i *internalIssueADFServiceImpl) CreateMeta(ctx context.Context, options *model.IssueCreateMetaOptions) (*model.IssueCreateMetaFieldPageScheme, *model.ResponseScheme, error) {
+	return getCreateMeta(ctx, i.c, i.version, options)
+}
+
+func (i *internalIssueADFServiceImpl) BulkMove(ctx context.Context, payload *model.IssueBulkMovePayloadScheme) (*model.IssueBulkMoveTaskScheme, *model.ResponseScheme, error) {
+	return bulkMove(ctx, i.c, i.version, payload)
+}
+
+func (i *internalIssueADFServiceImpl) BulkProgress(ctx context.Context, taskID string) (*model.BulkOperationProgressScheme, *model.ResponseScheme, error) {
+	return getBulkProgress(ctx, i.c, i.version, taskID)
+}
+
+func (i *internalIssueADFServiceImpl) BulkFetch(ctx context.Context, payload *model.IssueBulkFetchPayloadScheme) (*model.IssueBulkFetchScheme, *model.ResponseScheme, error) {
+
+	issues := new(model.IssueBulkFetchScheme)
+	response, err := bulkFetchIssues(ctx, i.c, i.version, payload, issues)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return issues, response, nil
+}
+
+func (i *internalIssueADFServiceImpl) GetRendered(ctx context.Context, issueKeyOrID string, fields []string) (*model.IssueRenderedScheme, *model.ResponseScheme, error) {
+	return getRendered(ctx, i.c, i.version, issueKeyOrID, fields)
+}
+
+func (i *internalIssueADFServiceImpl) ReorderSubtasks(ctx context.Context, parentKey string, orderedSubtaskKeys []string) (*model.ResponseScheme, error) {
+	return reorderSubtasks(ctx, i.c, i.version, parentKey, orderedSubtaskKeys)
+}
+
+func (i *internalIssueADFServiceImpl) GetWithProperties(ctx context.Context, issueKeyOrID string, fields, properties []string) (*model.IssueScheme, *model.ResponseScheme, error) {
+
+	endpoint, err := issueWithPropertiesEndpoint(i.version, issueKeyOrID, fields, properties)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	issue := new(model.IssueScheme)
+	response, err := i.c.Call(request, issue)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return issue, response, nil
+}
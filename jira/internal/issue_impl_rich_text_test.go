@@ -2,7 +2,9 @@ package internal
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/stretchr/testify/mock"
 	"net/http"
 	"testing"
@@ -138,14 +140,17 @@ func Test_internalRichTextServiceImpl_Delete(t *testing.T) {
 
 func Test_internalRichTextServiceImpl_Assign(t *testing.T) {
 
+	accountID, emptyAccountID := "account-id-sample", ""
+
 	type fields struct {
 		c       service.Connector
 		version string
 	}
 
 	type args struct {
-		ctx                     context.Context
-		issueKeyOrID, accountID string
+		ctx          context.Context
+		issueKeyOrID string
+		accountID    *string
 	}
 
 	testCases := []struct {
@@ -162,7 +167,7 @@ func Test_internalRichTextServiceImpl_Assign(t *testing.T) {
 			args: args{
 				ctx:          context.Background(),
 				issueKeyOrID: "DUMMY-1",
-				accountID:    "account-id-sample",
+				accountID:    &accountID,
 			},
 			on: func(fields *fields) {
 
@@ -185,13 +190,71 @@ func Test_internalRichTextServiceImpl_Assign(t *testing.T) {
 			},
 		},
 
+		{
+			name:   "when the issue is set to unassigned",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				accountID:    nil,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"/rest/api/2/issue/DUMMY-1/assignee",
+					"",
+					map[string]interface{}{"accountId": nil}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issue is assigned to the default assignee",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				accountID:    model.AutomaticAssignee(),
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"/rest/api/2/issue/DUMMY-1/assignee",
+					"",
+					map[string]interface{}{"accountId": "-1"}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
 		{
 			name:   "when the issue issue key or id is not provided",
 			fields: fields{version: "2"},
 			args: args{
 				ctx:          context.Background(),
 				issueKeyOrID: "",
-				accountID:    "account-id-sample",
+				accountID:    &accountID,
 			},
 			on: func(fields *fields) {
 				fields.c = mocks.NewConnector(t)
@@ -206,7 +269,7 @@ func Test_internalRichTextServiceImpl_Assign(t *testing.T) {
 			args: args{
 				ctx:          context.Background(),
 				issueKeyOrID: "DUMMY-1",
-				accountID:    "",
+				accountID:    &emptyAccountID,
 			},
 			on: func(fields *fields) {
 				fields.c = mocks.NewConnector(t)
@@ -221,7 +284,7 @@ func Test_internalRichTextServiceImpl_Assign(t *testing.T) {
 			args: args{
 				ctx:          context.Background(),
 				issueKeyOrID: "DUMMY-1",
-				accountID:    "account-id-sample",
+				accountID:    &accountID,
 			},
 			on: func(fields *fields) {
 
@@ -272,16 +335,7 @@ func Test_internalRichTextServiceImpl_Assign(t *testing.T) {
 	}
 }
 
-func Test_internalRichTextServiceImpl_Notify(t *testing.T) {
-
-	optionsMocked := &model.IssueNotifyOptionsScheme{
-		HTMLBody: "The <strong>latest</strong> test results for this ticket are now available.",
-		Subject:  "SUBJECT EMAIL EXAMPLE",
-		To: &model.IssueNotifyToScheme{
-			Reporter: true,
-			Assignee: true,
-		},
-	}
+func Test_internalRichTextServiceImpl_AssignRoundRobin(t *testing.T) {
 
 	type fields struct {
 		c       service.Connector
@@ -289,9 +343,9 @@ func Test_internalRichTextServiceImpl_Notify(t *testing.T) {
 	}
 
 	type args struct {
-		ctx          context.Context
-		issueKeyOrID string
-		options      *model.IssueNotifyOptionsScheme
+		ctx        context.Context
+		issueKeys  []string
+		accountIDs []string
 	}
 
 	testCases := []struct {
@@ -299,27 +353,38 @@ func Test_internalRichTextServiceImpl_Notify(t *testing.T) {
 		fields  fields
 		args    args
 		on      func(*fields)
+		want    *model.IssueAssignBulkResultScheme
 		wantErr bool
 		Err     error
 	}{
 		{
-			name:   "when the api version is v2",
+			name:   "when the accounts are assigned in turns",
 			fields: fields{version: "2"},
 			args: args{
-				ctx:          context.Background(),
-				issueKeyOrID: "DUMMY-1",
-				options:      optionsMocked,
+				ctx:        context.Background(),
+				issueKeys:  []string{"DUMMY-1", "DUMMY-2", "DUMMY-3"},
+				accountIDs: []string{"account-a", "account-b"},
 			},
 			on: func(fields *fields) {
 
 				client := mocks.NewConnector(t)
 
+				for _, issueKey := range []string{"DUMMY-1", "DUMMY-3"} {
+					client.On("NewRequest",
+						context.Background(),
+						http.MethodPut,
+						fmt.Sprintf("/rest/api/2/issue/%v/assignee", issueKey),
+						"",
+						map[string]interface{}{"accountId": "account-a"}).
+						Return(&http.Request{}, nil)
+				}
+
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodPost,
-					"rest/api/2/issue/DUMMY-1/notify",
+					http.MethodPut,
+					"/rest/api/2/issue/DUMMY-2/assignee",
 					"",
-					optionsMocked).
+					map[string]interface{}{"accountId": "account-b"}).
 					Return(&http.Request{}, nil)
 
 				client.On("Call",
@@ -329,29 +394,82 @@ func Test_internalRichTextServiceImpl_Notify(t *testing.T) {
 
 				fields.c = client
 			},
+			want: &model.IssueAssignBulkResultScheme{
+				Total:    3,
+				Assigned: map[string]string{"DUMMY-1": "account-a", "DUMMY-2": "account-b", "DUMMY-3": "account-a"},
+				Skipped:  map[string][]string{},
+				Errors:   map[string]error{},
+			},
 		},
 
 		{
-			name:   "when the issue issue key or id is not provided",
+			name:   "when an account cannot be assigned to the issues",
 			fields: fields{version: "2"},
 			args: args{
-				ctx:          context.Background(),
-				issueKeyOrID: "",
+				ctx:        context.Background(),
+				issueKeys:  []string{"DUMMY-1"},
+				accountIDs: []string{"account-b", "account-a"},
 			},
 			on: func(fields *fields) {
-				fields.c = mocks.NewConnector(t)
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"/rest/api/2/issue/DUMMY-1/assignee",
+					"",
+					map[string]interface{}{"accountId": "account-b"}).
+					Return(&http.Request{RequestURI: "account-b"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "account-b"},
+					nil).
+					Return(&model.ResponseScheme{}, model.ErrBadRequest)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/user/assignable/search?accountId=account-b&issueKey=DUMMY-1",
+					"",
+					nil).
+					Return(&http.Request{RequestURI: "search"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "search"},
+					mock.Anything).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"/rest/api/2/issue/DUMMY-1/assignee",
+					"",
+					map[string]interface{}{"accountId": "account-a"}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.IssueAssignBulkResultScheme{
+				Total:    1,
+				Assigned: map[string]string{"DUMMY-1": "account-a"},
+				Skipped:  map[string][]string{"DUMMY": {"account-b"}},
+				Errors:   map[string]error{},
 			},
-			wantErr: true,
-			Err:     model.ErrNoIssueKeyOrID,
 		},
 
 		{
-			name:   "when the request method cannot be created",
+			name:   "when an account cannot be assigned to the issues of a project only",
 			fields: fields{version: "2"},
 			args: args{
-				ctx:          context.Background(),
-				issueKeyOrID: "DUMMY-1",
-				options:      optionsMocked,
+				ctx:        context.Background(),
+				issueKeys:  []string{"DUMMY-1", "DUMMY-2", "OTHER-1"},
+				accountIDs: []string{"account-b", "account-a"},
 			},
 			on: func(fields *fields) {
 
@@ -359,16 +477,123 @@ func Test_internalRichTextServiceImpl_Notify(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodPost,
-					"rest/api/2/issue/DUMMY-1/notify",
+					http.MethodPut,
+					"/rest/api/2/issue/DUMMY-1/assignee",
 					"",
-					optionsMocked).
-					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+					map[string]interface{}{"accountId": "account-b"}).
+					Return(&http.Request{RequestURI: "account-b"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "account-b"},
+					nil).
+					Return(&model.ResponseScheme{}, model.ErrBadRequest)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/user/assignable/search?accountId=account-b&issueKey=DUMMY-1",
+					"",
+					nil).
+					Return(&http.Request{RequestURI: "search"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "search"},
+					mock.Anything).
+					Return(&model.ResponseScheme{}, nil)
+
+				for issueKey, accountID := range map[string]string{"DUMMY-1": "account-a", "DUMMY-2": "account-a", "OTHER-1": "account-b"} {
+					client.On("NewRequest",
+						context.Background(),
+						http.MethodPut,
+						fmt.Sprintf("/rest/api/2/issue/%v/assignee", issueKey),
+						"",
+						map[string]interface{}{"accountId": accountID}).
+						Return(&http.Request{}, nil)
+				}
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.IssueAssignBulkResultScheme{
+				Total:    3,
+				Assigned: map[string]string{"DUMMY-1": "account-a", "DUMMY-2": "account-a", "OTHER-1": "account-b"},
+				Skipped:  map[string][]string{"DUMMY": {"account-b"}},
+				Errors:   map[string]error{},
+			},
+		},
+
+		{
+			name:   "when no account can be assigned to the issues",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				issueKeys:  []string{"DUMMY-1"},
+				accountIDs: []string{"account-b"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"/rest/api/2/issue/DUMMY-1/assignee",
+					"",
+					map[string]interface{}{"accountId": "account-b"}).
+					Return(&http.Request{RequestURI: "account-b"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "account-b"},
+					nil).
+					Return(&model.ResponseScheme{}, model.ErrBadRequest)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/user/assignable/search?accountId=account-b&issueKey=DUMMY-1",
+					"",
+					nil).
+					Return(&http.Request{RequestURI: "search"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "search"},
+					mock.Anything).
+					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
 			},
+			want: &model.IssueAssignBulkResultScheme{
+				Total:    1,
+				Assigned: map[string]string{},
+				Skipped:  map[string][]string{"DUMMY": {"account-b"}},
+				Errors:   map[string]error{"DUMMY-1": model.ErrNoAssignableAccount},
+			},
+		},
+
+		{
+			name:   "when the issue keys are not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				accountIDs: []string{"account-a"},
+			},
 			wantErr: true,
-			Err:     errors.New("error, unable to create the http request"),
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the account ids are not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:       context.Background(),
+				issueKeys: []string{"DUMMY-1"},
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
 		},
 	}
 
@@ -382,7 +607,7 @@ func Test_internalRichTextServiceImpl_Notify(t *testing.T) {
 			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
-			gotResponse, err := issueService.Notify(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.options)
+			gotResult, err := issueService.AssignRoundRobin(testCase.args.ctx, testCase.args.issueKeys, testCase.args.accountIDs)
 
 			if testCase.wantErr {
 
@@ -395,14 +620,23 @@ func Test_internalRichTextServiceImpl_Notify(t *testing.T) {
 			} else {
 
 				assert.NoError(t, err)
-				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult)
 			}
 
 		})
 	}
 }
 
-func Test_internalRichTextServiceImpl_Transitions(t *testing.T) {
+func Test_internalRichTextServiceImpl_Notify(t *testing.T) {
+
+	optionsMocked := &model.IssueNotifyOptionsScheme{
+		HTMLBody: "The <strong>latest</strong> test results for this ticket are now available.",
+		Subject:  "SUBJECT EMAIL EXAMPLE",
+		To: &model.IssueNotifyToScheme{
+			Reporter: true,
+			Assignee: true,
+		},
+	}
 
 	type fields struct {
 		c       service.Connector
@@ -412,6 +646,7 @@ func Test_internalRichTextServiceImpl_Transitions(t *testing.T) {
 	type args struct {
 		ctx          context.Context
 		issueKeyOrID string
+		options      *model.IssueNotifyOptionsScheme
 	}
 
 	testCases := []struct {
@@ -428,6 +663,7 @@ func Test_internalRichTextServiceImpl_Transitions(t *testing.T) {
 			args: args{
 				ctx:          context.Background(),
 				issueKeyOrID: "DUMMY-1",
+				options:      optionsMocked,
 			},
 			on: func(fields *fields) {
 
@@ -435,15 +671,15 @@ func Test_internalRichTextServiceImpl_Transitions(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodGet,
-					"rest/api/2/issue/DUMMY-1/transitions",
+					http.MethodPost,
+					"rest/api/2/issue/DUMMY-1/notify",
 					"",
-					nil).
+					optionsMocked).
 					Return(&http.Request{}, nil)
 
 				client.On("Call",
 					&http.Request{},
-					&model.IssueTransitionsScheme{}).
+					nil).
 					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
@@ -470,6 +706,7 @@ func Test_internalRichTextServiceImpl_Transitions(t *testing.T) {
 			args: args{
 				ctx:          context.Background(),
 				issueKeyOrID: "DUMMY-1",
+				options:      optionsMocked,
 			},
 			on: func(fields *fields) {
 
@@ -477,10 +714,10 @@ func Test_internalRichTextServiceImpl_Transitions(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodGet,
-					"rest/api/2/issue/DUMMY-1/transitions",
+					http.MethodPost,
+					"rest/api/2/issue/DUMMY-1/notify",
 					"",
-					nil).
+					optionsMocked).
 					Return(&http.Request{}, errors.New("error, unable to create the http request"))
 
 				fields.c = client
@@ -500,7 +737,7 @@ func Test_internalRichTextServiceImpl_Transitions(t *testing.T) {
 			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
-			gotResult, gotResponse, err := issueService.Transitions(testCase.args.ctx, testCase.args.issueKeyOrID)
+			gotResponse, err := issueService.Notify(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.options)
 
 			if testCase.wantErr {
 
@@ -514,54 +751,23 @@ func Test_internalRichTextServiceImpl_Transitions(t *testing.T) {
 
 				assert.NoError(t, err)
 				assert.NotEqual(t, gotResponse, nil)
-				assert.NotEqual(t, gotResult, nil)
 			}
 
 		})
 	}
 }
 
-func Test_internalRichTextServiceImpl_Create(t *testing.T) {
+func Test_internalRichTextServiceImpl_Transitions(t *testing.T) {
 
-	payloadMocked := &model.IssueSchemeV2{
-		Fields: &model.IssueFieldsSchemeV2{
-			Summary:   "New summary test",
-			Project:   &model.ProjectScheme{ID: "10000"},
-			IssueType: &model.IssueTypeScheme{Name: "Story"},
-		},
+	type fields struct {
+		c       service.Connector
+		version string
 	}
 
-	customFieldsMocked := &model.CustomFields{}
-
-	// Add a new custom field
-	err := customFieldsMocked.Groups("customfield_10052", []string{"jira-administrators", "jira-administrators-system"})
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	err = customFieldsMocked.Number("customfield_10042", 1000.2222)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	expectedPayloadWithCustomFields := map[string]interface{}{
-		"fields": map[string]interface{}{
-			"customfield_10042": 1000.2222,
-			"customfield_10052": []map[string]interface{}{map[string]interface{}{"name": "jira-administrators"}, map[string]interface{}{"name": "jira-administrators-system"}},
-			"issuetype":         map[string]interface{}{"name": "Story"},
-			"project":           map[string]interface{}{"id": "10000"},
-			"summary":           "New summary test"},
-	}
-
-	type fields struct {
-		c       service.Connector
-		version string
-	}
-
-	type args struct {
-		ctx          context.Context
-		payload      *model.IssueSchemeV2
-		customFields *model.CustomFields
+	type args struct {
+		ctx          context.Context
+		issueKeyOrID string
+		options      *model.TransitionOptionsScheme
 	}
 
 	testCases := []struct {
@@ -577,8 +783,12 @@ func Test_internalRichTextServiceImpl_Create(t *testing.T) {
 			fields: fields{version: "2"},
 			args: args{
 				ctx:          context.Background(),
-				payload:      payloadMocked,
-				customFields: customFieldsMocked,
+				issueKeyOrID: "DUMMY-1",
+				options: &model.TransitionOptionsScheme{
+					Expand:                  []string{"transitions.fields"},
+					TransitionID:            "31",
+					SkipRemoteOnlyCondition: true,
+				},
 			},
 			on: func(fields *fields) {
 
@@ -586,15 +796,15 @@ func Test_internalRichTextServiceImpl_Create(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodPost,
-					"rest/api/2/issue",
+					http.MethodGet,
+					"rest/api/2/issue/DUMMY-1/transitions?expand=transitions.fields&skipRemoteOnlyCondition=true&transitionId=31",
 					"",
-					expectedPayloadWithCustomFields).
+					nil).
 					Return(&http.Request{}, nil)
 
 				client.On("Call",
 					&http.Request{},
-					&model.IssueResponseScheme{}).
+					&model.IssueTransitionsScheme{}).
 					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
@@ -602,41 +812,25 @@ func Test_internalRichTextServiceImpl_Create(t *testing.T) {
 		},
 
 		{
-			name:   "when the customfield are not provided",
+			name:   "when the issue issue key or id is not provided",
 			fields: fields{version: "2"},
 			args: args{
 				ctx:          context.Background(),
-				payload:      payloadMocked,
-				customFields: nil,
+				issueKeyOrID: "",
 			},
 			on: func(fields *fields) {
-
-				client := mocks.NewConnector(t)
-
-				client.On("NewRequest",
-					context.Background(),
-					http.MethodPost,
-					"rest/api/2/issue",
-					"",
-					payloadMocked).
-					Return(&http.Request{}, nil)
-
-				client.On("Call",
-					&http.Request{},
-					&model.IssueResponseScheme{}).
-					Return(&model.ResponseScheme{}, nil)
-
-				fields.c = client
+				fields.c = mocks.NewConnector(t)
 			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
 		},
 
 		{
-			name:   "when the http request cannot be created",
+			name:   "when the request method cannot be created",
 			fields: fields{version: "2"},
 			args: args{
 				ctx:          context.Background(),
-				payload:      payloadMocked,
-				customFields: customFieldsMocked,
+				issueKeyOrID: "DUMMY-1",
 			},
 			on: func(fields *fields) {
 
@@ -644,10 +838,10 @@ func Test_internalRichTextServiceImpl_Create(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodPost,
-					"rest/api/2/issue",
+					http.MethodGet,
+					"rest/api/2/issue/DUMMY-1/transitions",
 					"",
-					expectedPayloadWithCustomFields).
+					nil).
 					Return(&http.Request{}, errors.New("error, unable to create the http request"))
 
 				fields.c = client
@@ -667,7 +861,7 @@ func Test_internalRichTextServiceImpl_Create(t *testing.T) {
 			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
-			gotResult, gotResponse, err := issueService.Create(testCase.args.ctx, testCase.args.payload, testCase.args.customFields)
+			gotResult, gotResponse, err := issueService.Transitions(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.options)
 
 			if testCase.wantErr {
 
@@ -688,10 +882,30 @@ func Test_internalRichTextServiceImpl_Create(t *testing.T) {
 	}
 }
 
-func Test_internalRichTextServiceImpl_Creates(t *testing.T) {
+func Test_internalRichTextServiceImpl_Create(t *testing.T) {
+
+	payloadMocked := &model.IssueSchemeV2{
+		Fields: &model.IssueFieldsSchemeV2{
+			Summary:   "New summary test",
+			Project:   &model.ProjectScheme{ID: "10000"},
+			IssueType: &model.IssueTypeScheme{Name: "Story"},
+		},
+	}
+
+	payloadWithPropertiesMocked := &model.IssueSchemeV2{
+		Fields: &model.IssueFieldsSchemeV2{
+			Summary:   "New summary test",
+			Project:   &model.ProjectScheme{ID: "10000"},
+			IssueType: &model.IssueTypeScheme{Name: "Story"},
+		},
+		Properties: model.IssuePropertiesScheme{
+			{Key: "com.example.sync", Value: map[string]interface{}{"source": "crm", "id": 42}},
+		},
+	}
 
 	customFieldsMocked := &model.CustomFields{}
 
+	// Add a new custom field
 	err := customFieldsMocked.Groups("customfield_10052", []string{"jira-administrators", "jira-administrators-system"})
 	if err != nil {
 		t.Fatal(err)
@@ -702,61 +916,24 @@ func Test_internalRichTextServiceImpl_Creates(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	payloadMocked := []*model.IssueBulkSchemeV2{
-		{
-			Payload: &model.IssueSchemeV2{
-				Fields: &model.IssueFieldsSchemeV2{
-					Summary:   "New summary test",
-					Project:   &model.ProjectScheme{ID: "10000"},
-					IssueType: &model.IssueTypeScheme{Name: "Story"},
-				},
-			},
-			CustomFields: customFieldsMocked,
-		},
-
-		{
-			Payload:      nil,
-			CustomFields: nil,
-		},
-
-		{
-			Payload: &model.IssueSchemeV2{
-				Fields: &model.IssueFieldsSchemeV2{
-					Summary:   "New summary test #2",
-					Project:   &model.ProjectScheme{ID: "10000"},
-					IssueType: &model.IssueTypeScheme{Name: "Story"},
-				},
-			},
-			CustomFields: customFieldsMocked,
-		},
+	expectedPayloadWithCustomFields := map[string]interface{}{
+		"fields": map[string]interface{}{
+			"customfield_10042": 1000.2222,
+			"customfield_10052": []map[string]interface{}{map[string]interface{}{"name": "jira-administrators"}, map[string]interface{}{"name": "jira-administrators-system"}},
+			"issuetype":         map[string]interface{}{"name": "Story"},
+			"project":           map[string]interface{}{"id": "10000"},
+			"summary":           "New summary test"},
 	}
 
-	expectedBulkWithCustomFieldsPayload := map[string]interface{}{
-
-		"issueUpdates": []map[string]interface{}{map[string]interface{}{
-
-			"fields": map[string]interface{}{
-				"customfield_10042": 1000.2222,
-				"customfield_10052": []map[string]interface{}{map[string]interface{}{"name": "jira-administrators"}, map[string]interface{}{"name": "jira-administrators-system"}},
-				"issuetype":         map[string]interface{}{"name": "Story"},
-				"project":           map[string]interface{}{"id": "10000"},
-				"summary":           "New summary test"}}, map[string]interface{}{
-
-			"fields": map[string]interface{}{
-				"customfield_10042": 1000.2222,
-				"customfield_10052": []map[string]interface{}{map[string]interface{}{"name": "jira-administrators"}, map[string]interface{}{"name": "jira-administrators-system"}},
-				"issuetype":         map[string]interface{}{"name": "Story"},
-				"project":           map[string]interface{}{"id": "10000"},
-				"summary":           "New summary test #2"}}}}
-
 	type fields struct {
 		c       service.Connector
 		version string
 	}
 
 	type args struct {
-		ctx     context.Context
-		payload []*model.IssueBulkSchemeV2
+		ctx          context.Context
+		payload      *model.IssueSchemeV2
+		customFields *model.CustomFields
 	}
 
 	testCases := []struct {
@@ -771,8 +948,9 @@ func Test_internalRichTextServiceImpl_Creates(t *testing.T) {
 			name:   "when the api version is v2",
 			fields: fields{version: "2"},
 			args: args{
-				ctx:     context.Background(),
-				payload: payloadMocked,
+				ctx:          context.Background(),
+				payload:      payloadMocked,
+				customFields: customFieldsMocked,
 			},
 			on: func(fields *fields) {
 
@@ -781,14 +959,14 @@ func Test_internalRichTextServiceImpl_Creates(t *testing.T) {
 				client.On("NewRequest",
 					context.Background(),
 					http.MethodPost,
-					"rest/api/2/issue/bulk",
+					"rest/api/2/issue",
 					"",
-					expectedBulkWithCustomFieldsPayload).
+					expectedPayloadWithCustomFields).
 					Return(&http.Request{}, nil)
 
 				client.On("Call",
 					&http.Request{},
-					&model.IssueBulkResponseScheme{}).
+					&model.IssueResponseScheme{}).
 					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
@@ -796,25 +974,85 @@ func Test_internalRichTextServiceImpl_Creates(t *testing.T) {
 		},
 
 		{
-			name:   "when the payload is not provided",
+			name:   "when the customfield are not provided",
 			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				payload:      payloadMocked,
+				customFields: nil,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue",
+					"",
+					payloadMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueResponseScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the entity properties are provided",
+			fields: fields{version: "3"},
 			args: args{
 				ctx:     context.Background(),
-				payload: nil,
+				payload: payloadWithPropertiesMocked,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue",
+					"",
+					payloadWithPropertiesMocked).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueResponseScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the entity property key is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+				payload: &model.IssueSchemeV2{
+					Properties: model.IssuePropertiesScheme{{Value: "value"}},
+				},
 			},
 			on: func(fields *fields) {
 				fields.c = mocks.NewConnector(t)
 			},
 			wantErr: true,
-			Err:     model.ErrNoCreateIssues,
+			Err:     model.ErrNoPropertyKey,
 		},
 
 		{
 			name:   "when the http request cannot be created",
 			fields: fields{version: "2"},
 			args: args{
-				ctx:     context.Background(),
-				payload: payloadMocked,
+				ctx:          context.Background(),
+				payload:      payloadMocked,
+				customFields: customFieldsMocked,
 			},
 			on: func(fields *fields) {
 
@@ -823,9 +1061,9 @@ func Test_internalRichTextServiceImpl_Creates(t *testing.T) {
 				client.On("NewRequest",
 					context.Background(),
 					http.MethodPost,
-					"rest/api/2/issue/bulk",
+					"rest/api/2/issue",
 					"",
-					expectedBulkWithCustomFieldsPayload).
+					expectedPayloadWithCustomFields).
 					Return(&http.Request{}, errors.New("error, unable to create the http request"))
 
 				fields.c = client
@@ -845,7 +1083,7 @@ func Test_internalRichTextServiceImpl_Creates(t *testing.T) {
 			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
-			gotResult, gotResponse, err := issueService.Creates(testCase.args.ctx, testCase.args.payload)
+			gotResult, gotResponse, err := issueService.Create(testCase.args.ctx, testCase.args.payload, testCase.args.customFields)
 
 			if testCase.wantErr {
 
@@ -866,18 +1104,30 @@ func Test_internalRichTextServiceImpl_Creates(t *testing.T) {
 	}
 }
 
-func Test_internalRichTextServiceImpl_Get(t *testing.T) {
+func Test_internalRichTextServiceImpl_CreateWithOperations(t *testing.T) {
 
-	customFields := &model.CustomFields{}
+	payloadMocked := &model.IssueSchemeV2{
+		Fields: &model.IssueFieldsSchemeV2{
+			Summary: "New summary test",
+			Project: &model.ProjectScheme{ID: "10000"},
+		},
+	}
 
-	// Add a new custom field
-	err := customFields.Groups("customfield_10052", []string{"jira-administrators", "jira-administrators-system"})
-	if err != nil {
+	operations := &model.UpdateOperations{}
+	if err := operations.AddMultiRawOperation("comment", []map[string]interface{}{{"add": map[string]interface{}{"body": "Imported"}}}); err != nil {
 		t.Fatal(err)
 	}
 
-	err = customFields.Number("customfield_10042", 1000.2222)
-	if err != nil {
+	if err := operations.AddMultiRawOperation("worklog", []map[string]interface{}{{"add": map[string]interface{}{"timeSpent": "1h"}}}); err != nil {
+		t.Fatal(err)
+	}
+
+	invalidOperations := &model.UpdateOperations{
+		Fields: []map[string]interface{}{{"update": map[string]interface{}{"labels": map[string]interface{}{"add": "triaged"}}}},
+	}
+
+	conflictingOperations := &model.UpdateOperations{}
+	if err := conflictingOperations.AddStringOperation("summary", "set", "Updated summary"); err != nil {
 		t.Fatal(err)
 	}
 
@@ -887,9 +1137,10 @@ func Test_internalRichTextServiceImpl_Get(t *testing.T) {
 	}
 
 	type args struct {
-		ctx            context.Context
-		issueKeyOrID   string
-		fields, expand []string
+		ctx          context.Context
+		payload      *model.IssueSchemeV2
+		customFields *model.CustomFields
+		operations   *model.UpdateOperations
 	}
 
 	testCases := []struct {
@@ -904,10 +1155,9 @@ func Test_internalRichTextServiceImpl_Get(t *testing.T) {
 			name:   "when the api version is v2",
 			fields: fields{version: "2"},
 			args: args{
-				ctx:          context.Background(),
-				issueKeyOrID: "DUMMY-1",
-				fields:       []string{"summary", "status"},
-				expand:       []string{"operations", "changelogts"},
+				ctx:        context.Background(),
+				payload:    payloadMocked,
+				operations: operations,
 			},
 			on: func(fields *fields) {
 
@@ -915,15 +1165,24 @@ func Test_internalRichTextServiceImpl_Get(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodGet,
-					"rest/api/2/issue/DUMMY-1?expand=operations%2Cchangelogts&fields=summary%2Cstatus",
+					http.MethodPost,
+					"rest/api/2/issue",
 					"",
-					nil).
-					Return(&http.Request{}, nil)
+					map[string]interface{}{
+						"fields": map[string]interface{}{
+							"summary": "New summary test",
+							"project": map[string]interface{}{"id": "10000"},
+						},
+						"update": map[string]interface{}{
+							"comment": []map[string]interface{}{{"add": map[string]interface{}{"body": "Imported"}}},
+							"worklog": []map[string]interface{}{{"add": map[string]interface{}{"timeSpent": "1h"}}},
+						},
+					}).
+					Return(&http.Request{}, nil)
 
 				client.On("Call",
 					&http.Request{},
-					&model.IssueSchemeV2{}).
+					&model.IssueResponseScheme{}).
 					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
@@ -931,29 +1190,46 @@ func Test_internalRichTextServiceImpl_Get(t *testing.T) {
 		},
 
 		{
-			name:   "when the issue key or id is not provided",
+			name:   "when the operations update a field set on the payload",
 			fields: fields{version: "2"},
 			args: args{
-				ctx:          context.Background(),
-				issueKeyOrID: "",
-				fields:       []string{"summary", "status"},
-				expand:       []string{"operations", "changelogts"},
+				ctx:        context.Background(),
+				payload:    payloadMocked,
+				operations: conflictingOperations,
 			},
-			on: func(fields *fields) {
-				fields.c = mocks.NewConnector(t)
+			wantErr: true,
+			Err:     fmt.Errorf("%w: summary", model.ErrIssueFieldUpdateConflict),
+		},
+
+		{
+			name:   "when the operations of a field are not a list",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				payload:    payloadMocked,
+				operations: invalidOperations,
 			},
 			wantErr: true,
-			Err:     model.ErrNoIssueKeyOrID,
+			Err:     fmt.Errorf("%w: labels", model.ErrInvalidUpdateOperation),
+		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:        context.Background(),
+				operations: operations,
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueScheme,
 		},
 
 		{
 			name:   "when the http request cannot be created",
 			fields: fields{version: "2"},
 			args: args{
-				ctx:          context.Background(),
-				issueKeyOrID: "DUMMY-1",
-				fields:       []string{"summary", "status"},
-				expand:       []string{"operations", "changelogts"},
+				ctx:     context.Background(),
+				payload: payloadMocked,
 			},
 			on: func(fields *fields) {
 
@@ -961,10 +1237,10 @@ func Test_internalRichTextServiceImpl_Get(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodGet,
-					"rest/api/2/issue/DUMMY-1?expand=operations%2Cchangelogts&fields=summary%2Cstatus",
+					http.MethodPost,
+					"rest/api/2/issue",
 					"",
-					nil).
+					mock.Anything).
 					Return(&http.Request{}, errors.New("error, unable to create the http request"))
 
 				fields.c = client
@@ -984,8 +1260,8 @@ func Test_internalRichTextServiceImpl_Get(t *testing.T) {
 			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
-			gotResult, gotResponse, err := issueService.Get(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.fields,
-				testCase.args.expand)
+			gotResult, gotResponse, err := issueService.CreateWithOperations(testCase.args.ctx, testCase.args.payload, testCase.args.customFields,
+				testCase.args.operations)
 
 			if testCase.wantErr {
 
@@ -1006,86 +1282,66 @@ func Test_internalRichTextServiceImpl_Get(t *testing.T) {
 	}
 }
 
-func Test_internalRichTextServiceImpl_Move(t *testing.T) {
+func Test_internalRichTextServiceImpl_Creates(t *testing.T) {
 
-	/*
-		"customfield_10042": 1000.2222,
-		"customfield_10052": [
-			{
-				"name": "jira-administrators"
-			},
-			{
-				"name": "jira-administrators-system"
-			}
-		]
-	*/
 	customFieldsMocked := &model.CustomFields{}
-	if err := customFieldsMocked.Groups("customfield_10052", []string{"jira-administrators", "jira-administrators-system"}); err != nil {
-		t.Fatal(err)
-	}
-	if err := customFieldsMocked.Number("customfield_10042", 1000.2222); err != nil {
+
+	err := customFieldsMocked.Groups("customfield_10052", []string{"jira-administrators", "jira-administrators-system"})
+	if err != nil {
 		t.Fatal(err)
 	}
 
-	/*
-		 "update": {
-			"labels": [
-				{
-					"remove": "triaged"
-				}
-			]
-		}
-	*/
-	operationsMocked := &model.UpdateOperations{}
-	if err := operationsMocked.AddArrayOperation("labels", map[string]string{"triaged": "remove"}); err != nil {
+	err = customFieldsMocked.Number("customfield_10042", 1000.2222)
+	if err != nil {
 		t.Fatal(err)
 	}
 
-	expectedPayloadWithCustomFieldsAndOperations := map[string]interface{}{
-		"fields": map[string]interface{}{
-			"customfield_10042": 1000.2222,
-			"customfield_10052": []map[string]interface{}{map[string]interface{}{
-				"name": "jira-administrators"}, map[string]interface{}{
-				"name": "jira-administrators-system"}},
-
-			"issuetype":  map[string]interface{}{"name": "Story"},
-			"project":    map[string]interface{}{"id": "10000"},
-			"resolution": map[string]interface{}{"name": "Done"},
-			"summary":    "New summary test"},
+	payloadMocked := []*model.IssueBulkSchemeV2{
+		{
+			Payload: &model.IssueSchemeV2{
+				Fields: &model.IssueFieldsSchemeV2{
+					Summary:   "New summary test",
+					Project:   &model.ProjectScheme{ID: "10000"},
+					IssueType: &model.IssueTypeScheme{Name: "Story"},
+				},
+			},
+			CustomFields: customFieldsMocked,
+		},
 
-		"update": map[string]interface{}{
-			"labels": []map[string]interface{}{map[string]interface{}{
-				"remove": "triaged"}}},
+		{
+			Payload:      nil,
+			CustomFields: nil,
+		},
 
-		"transition": map[string]interface{}{"id": "10001"},
+		{
+			Payload: &model.IssueSchemeV2{
+				Fields: &model.IssueFieldsSchemeV2{
+					Summary:   "New summary test #2",
+					Project:   &model.ProjectScheme{ID: "10000"},
+					IssueType: &model.IssueTypeScheme{Name: "Story"},
+				},
+			},
+			CustomFields: customFieldsMocked,
+		},
 	}
 
-	expectedPayloadWithCustomfields := map[string]interface{}{
-		"fields": map[string]interface{}{
-			"customfield_10042": 1000.2222,
-			"customfield_10052": []map[string]interface{}{map[string]interface{}{
-				"name": "jira-administrators"}, map[string]interface{}{
-				"name": "jira-administrators-system"}},
-
-			"issuetype": map[string]interface{}{"name": "Story"},
-			"project":   map[string]interface{}{"id": "10000"},
-			"summary":   "New summary test"},
-		"transition": map[string]interface{}{"id": "10001"},
-	}
+	expectedBulkWithCustomFieldsPayload := map[string]interface{}{
 
-	expectedPayloadWithOperations := map[string]interface{}{
-		"fields": map[string]interface{}{
-			"issuetype": map[string]interface{}{"name": "Story"},
-			"project":   map[string]interface{}{"id": "10000"},
-			"summary":   "New summary test"},
+		"issueUpdates": []map[string]interface{}{map[string]interface{}{
 
-		"update": map[string]interface{}{
-			"labels": []map[string]interface{}{map[string]interface{}{
-				"remove": "triaged"}}},
-		"transition": map[string]interface{}{"id": "10001"},
-	}
+			"fields": map[string]interface{}{
+				"customfield_10042": 1000.2222,
+				"customfield_10052": []map[string]interface{}{map[string]interface{}{"name": "jira-administrators"}, map[string]interface{}{"name": "jira-administrators-system"}},
+				"issuetype":         map[string]interface{}{"name": "Story"},
+				"project":           map[string]interface{}{"id": "10000"},
+				"summary":           "New summary test"}}, map[string]interface{}{
 
-	expectedPayloadWithNoOptions := map[string]interface{}{"transition": map[string]interface{}{"id": "10001"}}
+			"fields": map[string]interface{}{
+				"customfield_10042": 1000.2222,
+				"customfield_10052": []map[string]interface{}{map[string]interface{}{"name": "jira-administrators"}, map[string]interface{}{"name": "jira-administrators-system"}},
+				"issuetype":         map[string]interface{}{"name": "Story"},
+				"project":           map[string]interface{}{"id": "10000"},
+				"summary":           "New summary test #2"}}}}
 
 	type fields struct {
 		c       service.Connector
@@ -1093,9 +1349,8 @@ func Test_internalRichTextServiceImpl_Move(t *testing.T) {
 	}
 
 	type args struct {
-		ctx                        context.Context
-		issueKeyOrID, transitionID string
-		options                    *model.IssueMoveOptionsV2
+		ctx     context.Context
+		payload []*model.IssueBulkSchemeV2
 	}
 
 	testCases := []struct {
@@ -1107,26 +1362,11 @@ func Test_internalRichTextServiceImpl_Move(t *testing.T) {
 		Err     error
 	}{
 		{
-			name:   "when the api version is v3",
+			name:   "when the api version is v2",
 			fields: fields{version: "2"},
 			args: args{
-				ctx:          context.Background(),
-				issueKeyOrID: "DUMMY-1",
-				transitionID: "10001",
-				options: &model.IssueMoveOptionsV2{
-					Fields: &model.IssueSchemeV2{
-						Fields: &model.IssueFieldsSchemeV2{
-							Summary:   "New summary test",
-							Project:   &model.ProjectScheme{ID: "10000"},
-							IssueType: &model.IssueTypeScheme{Name: "Story"},
-							Resolution: &model.ResolutionScheme{
-								Name: "Done",
-							},
-						},
-					},
-					CustomFields: customFieldsMocked,
-					Operations:   operationsMocked,
-				},
+				ctx:     context.Background(),
+				payload: payloadMocked,
 			},
 			on: func(fields *fields) {
 
@@ -1135,14 +1375,14 @@ func Test_internalRichTextServiceImpl_Move(t *testing.T) {
 				client.On("NewRequest",
 					context.Background(),
 					http.MethodPost,
-					"rest/api/2/issue/DUMMY-1/transitions",
+					"rest/api/2/issue/bulk",
 					"",
-					expectedPayloadWithCustomFieldsAndOperations).
+					expectedBulkWithCustomFieldsPayload).
 					Return(&http.Request{}, nil)
 
 				client.On("Call",
 					&http.Request{},
-					nil).
+					&model.IssueBulkResponseScheme{}).
 					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
@@ -1150,39 +1390,25 @@ func Test_internalRichTextServiceImpl_Move(t *testing.T) {
 		},
 
 		{
-			name:   "when the options are provided and the fields are not provided",
+			name:   "when the payload is not provided",
 			fields: fields{version: "2"},
 			args: args{
-				ctx:          context.Background(),
-				issueKeyOrID: "DUMMY-1",
-				transitionID: "10001",
-				options: &model.IssueMoveOptionsV2{
-					CustomFields: customFieldsMocked,
-					Operations:   operationsMocked,
-				},
+				ctx:     context.Background(),
+				payload: nil,
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
 			},
 			wantErr: true,
-			Err:     model.ErrNoIssueScheme,
+			Err:     model.ErrNoCreateIssues,
 		},
 
 		{
-			name:   "when the operations are not provided",
+			name:   "when the http request cannot be created",
 			fields: fields{version: "2"},
 			args: args{
-				ctx:          context.Background(),
-				issueKeyOrID: "DUMMY-1",
-				transitionID: "10001",
-				options: &model.IssueMoveOptionsV2{
-					Fields: &model.IssueSchemeV2{
-						Fields: &model.IssueFieldsSchemeV2{
-							Summary:   "New summary test",
-							Project:   &model.ProjectScheme{ID: "10000"},
-							IssueType: &model.IssueTypeScheme{Name: "Story"},
-						},
-					},
-					CustomFields: customFieldsMocked,
-					Operations:   nil,
-				},
+				ctx:     context.Background(),
+				payload: payloadMocked,
 			},
 			on: func(fields *fields) {
 
@@ -1191,54 +1417,2231 @@ func Test_internalRichTextServiceImpl_Move(t *testing.T) {
 				client.On("NewRequest",
 					context.Background(),
 					http.MethodPost,
-					"rest/api/2/issue/DUMMY-1/transitions",
+					"rest/api/2/issue/bulk",
 					"",
-					expectedPayloadWithCustomfields).
-					Return(&http.Request{}, nil)
-
-				client.On("Call",
-					&http.Request{},
-					nil).
-					Return(&model.ResponseScheme{}, nil)
+					expectedBulkWithCustomFieldsPayload).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
 
 				fields.c = client
 			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
 		},
+	}
 
-		{
-			name:   "when the custom fields are not provided",
-			fields: fields{version: "2"},
-			args: args{
-				ctx:          context.Background(),
-				issueKeyOrID: "DUMMY-1",
-				transitionID: "10001",
-				options: &model.IssueMoveOptionsV2{
-					Fields: &model.IssueSchemeV2{
-						Fields: &model.IssueFieldsSchemeV2{
-							Summary:   "New summary test",
-							Project:   &model.ProjectScheme{ID: "10000"},
-							IssueType: &model.IssueTypeScheme{Name: "Story"},
-						},
-					},
-					CustomFields: nil,
-					Operations:   operationsMocked,
-				},
-			},
-			on: func(fields *fields) {
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
 
-				client := mocks.NewConnector(t)
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := issueService.Creates(testCase.args.ctx, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalRichTextServiceImpl_Get(t *testing.T) {
+
+	customFields := &model.CustomFields{}
+
+	// Add a new custom field
+	err := customFields.Groups("customfield_10052", []string{"jira-administrators", "jira-administrators-system"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = customFields.Number("customfield_10042", 1000.2222)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx            context.Context
+		issueKeyOrID   string
+		fields, expand []string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				fields:       []string{"summary", "status"},
+				expand:       []string{"operations", "changelogts"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/DUMMY-1?expand=operations%2Cchangelogts&fields=summary%2Cstatus",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSchemeV2{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issue key or id is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "",
+				fields:       []string{"summary", "status"},
+				expand:       []string{"operations", "changelogts"},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				fields:       []string{"summary", "status"},
+				expand:       []string{"operations", "changelogts"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/DUMMY-1?expand=operations%2Cchangelogts&fields=summary%2Cstatus",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := issueService.Get(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.fields,
+				testCase.args.expand)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalRichTextServiceImpl_Move(t *testing.T) {
+
+	/*
+		"customfield_10042": 1000.2222,
+		"customfield_10052": [
+			{
+				"name": "jira-administrators"
+			},
+			{
+				"name": "jira-administrators-system"
+			}
+		]
+	*/
+	customFieldsMocked := &model.CustomFields{}
+	if err := customFieldsMocked.Groups("customfield_10052", []string{"jira-administrators", "jira-administrators-system"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := customFieldsMocked.Number("customfield_10042", 1000.2222); err != nil {
+		t.Fatal(err)
+	}
+
+	/*
+		 "update": {
+			"labels": [
+				{
+					"remove": "triaged"
+				}
+			]
+		}
+	*/
+	operationsMocked := &model.UpdateOperations{}
+	if err := operationsMocked.AddArrayOperation("labels", map[string]string{"triaged": "remove"}); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedPayloadWithCustomFieldsAndOperations := map[string]interface{}{
+		"fields": map[string]interface{}{
+			"customfield_10042": 1000.2222,
+			"customfield_10052": []map[string]interface{}{map[string]interface{}{
+				"name": "jira-administrators"}, map[string]interface{}{
+				"name": "jira-administrators-system"}},
+
+			"issuetype":  map[string]interface{}{"name": "Story"},
+			"project":    map[string]interface{}{"id": "10000"},
+			"resolution": map[string]interface{}{"name": "Done"},
+			"summary":    "New summary test"},
+
+		"update": map[string]interface{}{
+			"labels": []map[string]interface{}{map[string]interface{}{
+				"remove": "triaged"}}},
+
+		"transition": map[string]interface{}{"id": "10001"},
+	}
+
+	expectedPayloadWithCustomfields := map[string]interface{}{
+		"fields": map[string]interface{}{
+			"customfield_10042": 1000.2222,
+			"customfield_10052": []map[string]interface{}{map[string]interface{}{
+				"name": "jira-administrators"}, map[string]interface{}{
+				"name": "jira-administrators-system"}},
+
+			"issuetype": map[string]interface{}{"name": "Story"},
+			"project":   map[string]interface{}{"id": "10000"},
+			"summary":   "New summary test"},
+		"transition": map[string]interface{}{"id": "10001"},
+	}
+
+	expectedPayloadWithOperations := map[string]interface{}{
+		"fields": map[string]interface{}{
+			"issuetype": map[string]interface{}{"name": "Story"},
+			"project":   map[string]interface{}{"id": "10000"},
+			"summary":   "New summary test"},
+
+		"update": map[string]interface{}{
+			"labels": []map[string]interface{}{map[string]interface{}{
+				"remove": "triaged"}}},
+		"transition": map[string]interface{}{"id": "10001"},
+	}
+
+	expectedPayloadWithNoOptions := map[string]interface{}{"transition": map[string]interface{}{"id": "10001"}}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx                        context.Context
+		issueKeyOrID, transitionID string
+		options                    *model.IssueMoveOptionsV2
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				transitionID: "10001",
+				options: &model.IssueMoveOptionsV2{
+					Fields: &model.IssueSchemeV2{
+						Fields: &model.IssueFieldsSchemeV2{
+							Summary:   "New summary test",
+							Project:   &model.ProjectScheme{ID: "10000"},
+							IssueType: &model.IssueTypeScheme{Name: "Story"},
+							Resolution: &model.ResolutionScheme{
+								Name: "Done",
+							},
+						},
+					},
+					CustomFields: customFieldsMocked,
+					Operations:   operationsMocked,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/DUMMY-1/transitions",
+					"",
+					expectedPayloadWithCustomFieldsAndOperations).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the history metadata is provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				transitionID: "10001",
+				options: &model.IssueMoveOptionsV2{
+					HistoryMetadata: &model.HistoryMetadataScheme{
+						ActivityDescription: "Closed by the release bot",
+						Actor:               &model.HistoryMetadataParticipantScheme{ID: "release-bot", Type: "application"},
+					},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/DUMMY-1/transitions",
+					"",
+					map[string]interface{}{
+						"transition": map[string]interface{}{"id": "10001"},
+						"historyMetadata": &model.HistoryMetadataScheme{
+							ActivityDescription: "Closed by the release bot",
+							Actor:               &model.HistoryMetadataParticipantScheme{ID: "release-bot", Type: "application"},
+						},
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the history metadata participant is not identified",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				transitionID: "10001",
+				options: &model.IssueMoveOptionsV2{
+					HistoryMetadata: &model.HistoryMetadataScheme{
+						ActivityDescription: "Closed by the release bot",
+						Generator:           &model.HistoryMetadataParticipantScheme{Type: "application"},
+					},
+				},
+			},
+			wantErr: true,
+			Err:     fmt.Errorf("%w: generator", model.ErrNoHistoryParticipantID),
+		},
+
+		{
+			name:   "when the history metadata activity is not described",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				transitionID: "10001",
+				options: &model.IssueMoveOptionsV2{
+					HistoryMetadata: &model.HistoryMetadataScheme{},
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrNoHistoryActivityDescription,
+		},
+
+		{
+			name:   "when the options are provided and the fields are not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				transitionID: "10001",
+				options: &model.IssueMoveOptionsV2{
+					CustomFields: customFieldsMocked,
+					Operations:   operationsMocked,
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueScheme,
+		},
+
+		{
+			name:   "when the operations are not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				transitionID: "10001",
+				options: &model.IssueMoveOptionsV2{
+					Fields: &model.IssueSchemeV2{
+						Fields: &model.IssueFieldsSchemeV2{
+							Summary:   "New summary test",
+							Project:   &model.ProjectScheme{ID: "10000"},
+							IssueType: &model.IssueTypeScheme{Name: "Story"},
+						},
+					},
+					CustomFields: customFieldsMocked,
+					Operations:   nil,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/DUMMY-1/transitions",
+					"",
+					expectedPayloadWithCustomfields).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the custom fields are not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				transitionID: "10001",
+				options: &model.IssueMoveOptionsV2{
+					Fields: &model.IssueSchemeV2{
+						Fields: &model.IssueFieldsSchemeV2{
+							Summary:   "New summary test",
+							Project:   &model.ProjectScheme{ID: "10000"},
+							IssueType: &model.IssueTypeScheme{Name: "Story"},
+						},
+					},
+					CustomFields: nil,
+					Operations:   operationsMocked,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/DUMMY-1/transitions",
+					"",
+					expectedPayloadWithOperations).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the the issue comment options are not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				transitionID: "10001",
+				options:      nil,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/DUMMY-1/transitions",
+					"",
+					expectedPayloadWithNoOptions).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issue key is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "",
+				transitionID: "10001",
+				options: &model.IssueMoveOptionsV2{
+					Fields: &model.IssueSchemeV2{
+						Fields: &model.IssueFieldsSchemeV2{
+							Summary:   "New summary test",
+							Project:   &model.ProjectScheme{ID: "10000"},
+							IssueType: &model.IssueTypeScheme{Name: "Story"},
+						},
+					},
+					CustomFields: customFieldsMocked,
+					Operations:   operationsMocked,
+				},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the transition id is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				transitionID: "",
+				options: &model.IssueMoveOptionsV2{
+					Fields: &model.IssueSchemeV2{
+						Fields: &model.IssueFieldsSchemeV2{
+							Summary:   "New summary test",
+							Project:   &model.ProjectScheme{ID: "10000"},
+							IssueType: &model.IssueTypeScheme{Name: "Story"},
+						},
+					},
+					CustomFields: customFieldsMocked,
+					Operations:   operationsMocked,
+				},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoTransitionID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				transitionID: "10001",
+				options: &model.IssueMoveOptionsV2{
+					Fields: &model.IssueSchemeV2{
+						Fields: &model.IssueFieldsSchemeV2{
+							Summary:   "New summary test",
+							Project:   &model.ProjectScheme{ID: "10000"},
+							IssueType: &model.IssueTypeScheme{Name: "Story"},
+						},
+					},
+					CustomFields: customFieldsMocked,
+					Operations:   operationsMocked,
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/DUMMY-1/transitions",
+					"",
+					mock.Anything).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResponse, err := issueService.Move(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.transitionID,
+				testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalRichTextServiceImpl_Update(t *testing.T) {
+
+	customFieldsMocked := &model.CustomFields{}
+
+	err := customFieldsMocked.Groups("customfield_10052", []string{"jira-administrators", "jira-administrators-system"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = customFieldsMocked.Number("customfield_10042", 1000.2222)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	operations := &model.UpdateOperations{}
+	err = operations.AddArrayOperation("labels", map[string]string{
+		"triaged": "remove",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedPayloadWithCustomFieldsAndOperations := map[string]interface{}{
+		"fields": map[string]interface{}{
+			"customfield_10042": 1000.2222,
+			"customfield_10052": []map[string]interface{}{map[string]interface{}{
+				"name": "jira-administrators"}, map[string]interface{}{
+				"name": "jira-administrators-system"}}, "summary": "New summary test"},
+		"update": map[string]interface{}{
+			"labels": []map[string]interface{}{map[string]interface{}{
+				"remove": "triaged"}}}}
+
+	expectedPayloadWithCustomfields := map[string]interface{}{
+		"fields": map[string]interface{}{
+			"customfield_10042": 1000.2222,
+			"customfield_10052": []map[string]interface{}{map[string]interface{}{
+				"name": "jira-administrators"}, map[string]interface{}{
+				"name": "jira-administrators-system"}},
+			"summary": "New summary test"}}
+
+	expectedPayloadWithOperations := map[string]interface{}{
+		"fields": map[string]interface{}{
+			"summary": "New summary test"},
+		"update": map[string]interface{}{
+			"labels": []map[string]interface{}{map[string]interface{}{"remove": "triaged"}}}}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueKeyOrID string
+		notify       bool
+		payload      *model.IssueSchemeV2
+		customFields *model.CustomFields
+		operations   *model.UpdateOperations
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				notify:       true,
+				payload: &model.IssueSchemeV2{
+					Fields: &model.IssueFieldsSchemeV2{
+						Summary: "New summary test",
+					},
+				},
+				customFields: customFieldsMocked,
+				operations:   operations,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/issue/DUMMY-1?notifyUsers=true",
+					"",
+					expectedPayloadWithCustomFieldsAndOperations).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issue key or id is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "",
+				notify:       true,
+				payload: &model.IssueSchemeV2{
+					Fields: &model.IssueFieldsSchemeV2{
+						Summary: "New summary test",
+					},
+				},
+				customFields: customFieldsMocked,
+				operations:   operations,
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				notify:       true,
+				payload: &model.IssueSchemeV2{
+					Fields: &model.IssueFieldsSchemeV2{
+						Summary: "New summary test",
+					},
+				},
+				customFields: customFieldsMocked,
+				operations:   operations,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/issue/DUMMY-1?notifyUsers=true",
+					"",
+					expectedPayloadWithCustomFieldsAndOperations).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+
+		{
+			name:   "when the operations are not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				notify:       true,
+				payload: &model.IssueSchemeV2{
+					Fields: &model.IssueFieldsSchemeV2{
+						Summary: "New summary test",
+					},
+				},
+				customFields: customFieldsMocked,
+				operations:   nil,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/issue/DUMMY-1?notifyUsers=true",
+					"",
+					expectedPayloadWithCustomfields).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the custom fields are not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				notify:       true,
+				payload: &model.IssueSchemeV2{
+					Fields: &model.IssueFieldsSchemeV2{
+						Summary: "New summary test",
+					},
+				},
+				customFields: nil,
+				operations:   operations,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/issue/DUMMY-1?notifyUsers=true",
+					"",
+					expectedPayloadWithOperations).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the operations are customfields are not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				notify:       true,
+				payload: &model.IssueSchemeV2{
+					Fields: &model.IssueFieldsSchemeV2{
+						Summary: "New summary test",
+					},
+				},
+				customFields: nil,
+				operations:   nil,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/api/2/issue/DUMMY-1?notifyUsers=true",
+					"",
+					&model.IssueSchemeV2{
+						Fields: &model.IssueFieldsSchemeV2{
+							Summary: "New summary test",
+						},
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResponse, err := issueService.Update(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.notify,
+				testCase.args.payload, testCase.args.customFields, testCase.args.operations)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalRichTextServiceImpl_EditMeta(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx                                          context.Context
+		issueKeyOrID                                 string
+		overrideScreenSecurity, overrideEditableFlag bool
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:                    context.Background(),
+				issueKeyOrID:           "DUMMY-1",
+				overrideScreenSecurity: true,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/DUMMY-1/editmeta?overrideEditableFlag=false&overrideScreenSecurity=true",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueEditMetadataScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issue key or id is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/DUMMY-1/editmeta?overrideEditableFlag=false&overrideScreenSecurity=false",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := issueService.EditMeta(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.overrideScreenSecurity,
+				testCase.args.overrideEditableFlag)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalRichTextServiceImpl_ChangelogsSince(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx            context.Context
+		issueKeyOrID   string
+		afterHistoryID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:            context.Background(),
+				issueKeyOrID:   "DUMMY-1",
+				afterHistoryID: "10000",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/DUMMY-1/changelog?maxResults=100&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueChangelogPageScheme{}).
+					Run(func(args mock.Arguments) {
+						page := args.Get(1).(*model.IssueChangelogPageScheme)
+						page.Total = 3
+						page.Values = []*model.IssueChangelogHistoryScheme{{ID: "10000"}, {ID: "10001"}, {ID: "10002"}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issue key or id is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:            context.Background(),
+				issueKeyOrID:   "",
+				afterHistoryID: "10000",
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:            context.Background(),
+				issueKeyOrID:   "DUMMY-1",
+				afterHistoryID: "10000",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/DUMMY-1/changelog?maxResults=100&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := issueService.ChangelogsSince(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.afterHistoryID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Len(t, gotResult, 2)
+			}
+
+		})
+	}
+}
+
+func Test_internalRichTextServiceImpl_EditBulkByJQL(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		jql     string
+		payload *model.BulkEditFieldsScheme
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				jql: "project = DUMMY",
+				payload: &model.BulkEditFieldsScheme{
+					SelectedActions: []string{"labels"},
+					EditedFieldsInput: &model.BulkEditFieldsInputScheme{
+						LabelsFields: []*model.BulkEditLabelsFieldScheme{
+							{FieldID: "labels", BulkEditMultiSelectFieldOption: "ADD", Labels: []*model.BulkEditNameScheme{{Name: "triage"}}},
+						},
+					},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/search/jql",
+					"",
+					map[string]interface{}{"jql": "project = DUMMY", "fields": []string{"id"}, "maxResults": 100}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSearchJQLScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.IssueSearchJQLScheme).Issues = []*model.IssueScheme{{ID: "10001"}, {ID: "10002"}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/bulk/issues/fields",
+					"",
+					&model.BulkEditFieldsScheme{
+						SelectedActions:        []string{"labels"},
+						SelectedIssueIdsOrKeys: []string{"10001", "10002"},
+						EditedFieldsInput: &model.BulkEditFieldsInputScheme{
+							LabelsFields: []*model.BulkEditLabelsFieldScheme{
+								{FieldID: "labels", BulkEditMultiSelectFieldOption: "ADD", Labels: []*model.BulkEditNameScheme{{Name: "triage"}}},
+							},
+						},
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BulkEditTaskScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.BulkEditTaskScheme).TaskID = "10641"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the next page token is repeated",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				jql: "project = DUMMY",
+				payload: &model.BulkEditFieldsScheme{
+					SelectedActions: []string{"labels"},
+					EditedFieldsInput: &model.BulkEditFieldsInputScheme{
+						LabelsFields: []*model.BulkEditLabelsFieldScheme{
+							{FieldID: "labels", BulkEditMultiSelectFieldOption: "ADD", Labels: []*model.BulkEditNameScheme{{Name: "triage"}}},
+						},
+					},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/search/jql",
+					"",
+					map[string]interface{}{"jql": "project = DUMMY", "fields": []string{"id"}, "maxResults": 100}).
+					Return(&http.Request{RequestURI: "page-1"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "page-1"},
+					&model.IssueSearchJQLScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.IssueSearchJQLScheme).Issues = []*model.IssueScheme{{ID: "10001"}}
+						args.Get(1).(*model.IssueSearchJQLScheme).NextPageToken = "token-1"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/search/jql",
+					"",
+					map[string]interface{}{"jql": "project = DUMMY", "fields": []string{"id"}, "maxResults": 100, "nextPageToken": "token-1"}).
+					Return(&http.Request{RequestURI: "page-2"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "page-2"},
+					&model.IssueSearchJQLScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.IssueSearchJQLScheme).Issues = []*model.IssueScheme{{ID: "10002"}}
+						args.Get(1).(*model.IssueSearchJQLScheme).NextPageToken = "token-1"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/bulk/issues/fields",
+					"",
+					&model.BulkEditFieldsScheme{
+						SelectedActions:        []string{"labels"},
+						SelectedIssueIdsOrKeys: []string{"10001", "10002"},
+						EditedFieldsInput: &model.BulkEditFieldsInputScheme{
+							LabelsFields: []*model.BulkEditLabelsFieldScheme{
+								{FieldID: "labels", BulkEditMultiSelectFieldOption: "ADD", Labels: []*model.BulkEditNameScheme{{Name: "triage"}}},
+							},
+						},
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BulkEditTaskScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.BulkEditTaskScheme).TaskID = "10641"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the context is canceled",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: canceled,
+				jql: "project = DUMMY",
+				payload: &model.BulkEditFieldsScheme{
+					SelectedActions:   []string{"labels"},
+					EditedFieldsInput: &model.BulkEditFieldsInputScheme{},
+				},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     context.Canceled,
+		},
+
+		{
+			name:   "when the jql is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				jql: "",
+				payload: &model.BulkEditFieldsScheme{
+					SelectedActions: []string{"labels"},
+					EditedFieldsInput: &model.BulkEditFieldsInputScheme{
+						LabelsFields: []*model.BulkEditLabelsFieldScheme{
+							{FieldID: "labels", BulkEditMultiSelectFieldOption: "ADD", Labels: []*model.BulkEditNameScheme{{Name: "triage"}}},
+						},
+					},
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrNoJQL,
+		},
+
+		{
+			name:   "when the edited fields are not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:     context.Background(),
+				jql:     "project = DUMMY",
+				payload: &model.BulkEditFieldsScheme{},
+			},
+			wantErr: true,
+			Err:     model.ErrNoBulkEditFields,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				jql: "project = DUMMY",
+				payload: &model.BulkEditFieldsScheme{
+					SelectedActions: []string{"labels"},
+					EditedFieldsInput: &model.BulkEditFieldsInputScheme{
+						LabelsFields: []*model.BulkEditLabelsFieldScheme{
+							{FieldID: "labels", BulkEditMultiSelectFieldOption: "ADD", Labels: []*model.BulkEditNameScheme{{Name: "triage"}}},
+						},
+					},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/search/jql",
+					"",
+					map[string]interface{}{"jql": "project = DUMMY", "fields": []string{"id"}, "maxResults": 100}).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := issueService.EditBulkByJQL(testCase.args.ctx, testCase.args.jql, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Equal(t, "10641", gotResult.TaskID)
+				assert.Equal(t, 2, gotResult.Issues)
+			}
+
+		})
+	}
+}
+
+func Test_internalRichTextServiceImpl_CreateMetaIssueTypes(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx            context.Context
+		projectKeyOrID string
+		startAt        int
+		maxResults     int
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				startAt:        0,
+				maxResults:     50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/createmeta/DUMMY/issuetypes?maxResults=50&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueCreateMetaIssueTypePageScheme{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the project key or id is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "",
+				startAt:        0,
+				maxResults:     50,
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectIDOrKey,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:            context.Background(),
+				projectKeyOrID: "DUMMY",
+				startAt:        0,
+				maxResults:     50,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/createmeta/DUMMY/issuetypes?maxResults=50&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := issueService.CreateMetaIssueTypes(testCase.args.ctx, testCase.args.projectKeyOrID, testCase.args.startAt, testCase.args.maxResults)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalRichTextServiceImpl_CreateMeta(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		options *model.IssueCreateMetaOptions
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:     context.Background(),
+				options: &model.IssueCreateMetaOptions{ProjectKeyOrID: "DUMMY", IssueTypeID: "10001", MaxResults: 50},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/createmeta/DUMMY/issuetypes/10001?maxResults=50&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueCreateMetaFieldPageScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.IssueCreateMetaFieldPageScheme).Fields = []*model.IssueFieldMetadataScheme{{FieldID: "summary", Required: true}, {FieldID: "labels"}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the options are not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:     context.Background(),
+				options: nil,
+			},
+			wantErr: true,
+			Err:     model.ErrNoProjectIDOrKey,
+		},
+
+		{
+			name:   "when the issue type id is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:     context.Background(),
+				options: &model.IssueCreateMetaOptions{ProjectKeyOrID: "DUMMY"},
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueTypeID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:     context.Background(),
+				options: &model.IssueCreateMetaOptions{ProjectKeyOrID: "DUMMY", IssueTypeID: "10001", MaxResults: 50},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/createmeta/DUMMY/issuetypes/10001?maxResults=50&startAt=0",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := issueService.CreateMeta(testCase.args.ctx, testCase.args.options)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Len(t, gotResult.Required(), 1)
+			}
+
+		})
+	}
+}
+
+func Test_internalRichTextServiceImpl_BulkMove(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		payload *model.IssueBulkMovePayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				payload: &model.IssueBulkMovePayloadScheme{
+					TargetToSourcesMapping: map[string]*model.IssueBulkMoveTargetScheme{
+						model.IssueBulkMoveTarget("KP", "10001", ""): {
+							IssueIdsOrKeys:     []string{"DUMMY-1", "DUMMY-2"},
+							InferFieldDefaults: true,
+							TargetStatus: []*model.IssueBulkMoveStatusMappingScheme{
+								{Statuses: map[string][]string{"10002": {"3", "4"}}},
+							},
+						},
+					},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/bulk/issues/move",
+					"",
+					&model.IssueBulkMovePayloadScheme{
+						TargetToSourcesMapping: map[string]*model.IssueBulkMoveTargetScheme{
+							model.IssueBulkMoveTarget("KP", "10001", ""): {
+								IssueIdsOrKeys:     []string{"DUMMY-1", "DUMMY-2"},
+								InferFieldDefaults: true,
+								TargetStatus: []*model.IssueBulkMoveStatusMappingScheme{
+									{Statuses: map[string][]string{"10002": {"3", "4"}}},
+								},
+							},
+						},
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueBulkMoveTaskScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.IssueBulkMoveTaskScheme).TaskID = "10641"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the targets are not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.IssueBulkMovePayloadScheme{},
+			},
+			wantErr: true,
+			Err:     model.ErrNoBulkMoveTargets,
+		},
+
+		{
+			name:   "when the issues exceed the bulk move limit",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				payload: &model.IssueBulkMovePayloadScheme{
+					TargetToSourcesMapping: map[string]*model.IssueBulkMoveTargetScheme{
+						"KP,10001": {IssueIdsOrKeys: make([]string, 1001)},
+					},
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrBulkMoveLimitExceeded,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				payload: &model.IssueBulkMovePayloadScheme{
+					TargetToSourcesMapping: map[string]*model.IssueBulkMoveTargetScheme{
+						model.IssueBulkMoveTarget("KP", "10001", ""): {
+							IssueIdsOrKeys:     []string{"DUMMY-1", "DUMMY-2"},
+							InferFieldDefaults: true,
+							TargetStatus: []*model.IssueBulkMoveStatusMappingScheme{
+								{Statuses: map[string][]string{"10002": {"3", "4"}}},
+							},
+						},
+					},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/bulk/issues/move",
+					"",
+					&model.IssueBulkMovePayloadScheme{
+						TargetToSourcesMapping: map[string]*model.IssueBulkMoveTargetScheme{
+							model.IssueBulkMoveTarget("KP", "10001", ""): {
+								IssueIdsOrKeys:     []string{"DUMMY-1", "DUMMY-2"},
+								InferFieldDefaults: true,
+								TargetStatus: []*model.IssueBulkMoveStatusMappingScheme{
+									{Statuses: map[string][]string{"10002": {"3", "4"}}},
+								},
+							},
+						},
+					}).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := issueService.BulkMove(testCase.args.ctx, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Equal(t, "10641", gotResult.TaskID)
+			}
+
+		})
+	}
+}
+
+func Test_internalRichTextServiceImpl_BulkProgress(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx    context.Context
+		taskID string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:    context.Background(),
+				taskID: "10641",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/bulk/queue/10641",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BulkOperationProgressScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.BulkOperationProgressScheme).Status = "COMPLETE"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the task id is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoTaskID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:    context.Background(),
+				taskID: "10641",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/bulk/queue/10641",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := issueService.BulkProgress(testCase.args.ctx, testCase.args.taskID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Equal(t, "COMPLETE", gotResult.Status)
+			}
+
+		})
+	}
+}
+
+func Test_internalRichTextServiceImpl_BulkFetch(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx     context.Context
+		payload *model.IssueBulkFetchPayloadScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				payload: &model.IssueBulkFetchPayloadScheme{
+					IssueIdsOrKeys: []string{"KP-1", "10002"},
+					Fields:         []string{"summary", "status"},
+					Expand:         []string{"renderedFields"},
+					Properties:     []string{"support.tier"},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/bulkfetch",
+					"",
+					&model.IssueBulkFetchPayloadScheme{
+						IssueIdsOrKeys: []string{"KP-1", "10002"},
+						Fields:         []string{"summary", "status"},
+						Expand:         []string{"renderedFields"},
+						Properties:     []string{"support.tier"},
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueBulkFetchSchemeV2{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issues are not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.IssueBulkFetchPayloadScheme{},
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the issues exceed the bulk fetch limit",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:     context.Background(),
+				payload: &model.IssueBulkFetchPayloadScheme{IssueIdsOrKeys: make([]string, 101)},
+			},
+			wantErr: true,
+			Err:     model.ErrBulkFetchLimitExceeded,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx: context.Background(),
+				payload: &model.IssueBulkFetchPayloadScheme{
+					IssueIdsOrKeys: []string{"KP-1", "10002"},
+					Fields:         []string{"summary", "status"},
+					Expand:         []string{"renderedFields"},
+					Properties:     []string{"support.tier"},
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
 
 				client.On("NewRequest",
 					context.Background(),
 					http.MethodPost,
-					"rest/api/2/issue/DUMMY-1/transitions",
+					"rest/api/2/issue/bulkfetch",
 					"",
-					expectedPayloadWithOperations).
+					&model.IssueBulkFetchPayloadScheme{
+						IssueIdsOrKeys: []string{"KP-1", "10002"},
+						Fields:         []string{"summary", "status"},
+						Expand:         []string{"renderedFields"},
+						Properties:     []string{"support.tier"},
+					}).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := issueService.BulkFetch(testCase.args.ctx, testCase.args.payload)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
+
+func Test_internalRichTextServiceImpl_GetRendered(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueKeyOrID string
+		fields       []string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				fields:       []string{"description", "labels"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/KP-1?expand=renderedFields&fields=description%2Clabels",
+					"",
+					nil).
 					Return(&http.Request{}, nil)
 
 				client.On("Call",
 					&http.Request{},
-					nil).
+					&model.IssueRenderedScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.IssueRenderedScheme).RenderedFields = map[string]interface{}{"description": "<p>Summary</p>"}
+					}).
 					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
@@ -1246,13 +3649,12 @@ func Test_internalRichTextServiceImpl_Move(t *testing.T) {
 		},
 
 		{
-			name:   "when the the issue comment options are not provided",
+			name:   "when the fields are not provided",
 			fields: fields{version: "2"},
 			args: args{
 				ctx:          context.Background(),
-				issueKeyOrID: "DUMMY-1",
-				transitionID: "10001",
-				options:      nil,
+				issueKeyOrID: "KP-1",
+				fields:       nil,
 			},
 			on: func(fields *fields) {
 
@@ -1260,15 +3662,18 @@ func Test_internalRichTextServiceImpl_Move(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodPost,
-					"rest/api/2/issue/DUMMY-1/transitions",
+					http.MethodGet,
+					"rest/api/2/issue/KP-1?expand=renderedFields",
 					"",
-					expectedPayloadWithNoOptions).
+					nil).
 					Return(&http.Request{}, nil)
 
 				client.On("Call",
 					&http.Request{},
-					nil).
+					&model.IssueRenderedScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.IssueRenderedScheme).RenderedFields = map[string]interface{}{"description": "<p>Summary</p>"}
+					}).
 					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
@@ -1281,70 +3686,203 @@ func Test_internalRichTextServiceImpl_Move(t *testing.T) {
 			args: args{
 				ctx:          context.Background(),
 				issueKeyOrID: "",
-				transitionID: "10001",
-				options: &model.IssueMoveOptionsV2{
-					Fields: &model.IssueSchemeV2{
-						Fields: &model.IssueFieldsSchemeV2{
-							Summary:   "New summary test",
-							Project:   &model.ProjectScheme{ID: "10000"},
-							IssueType: &model.IssueTypeScheme{Name: "Story"},
-						},
-					},
-					CustomFields: customFieldsMocked,
-					Operations:   operationsMocked,
-				},
+				fields:       []string{"description", "labels"},
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-1",
+				fields:       []string{"description", "labels"},
 			},
 			on: func(fields *fields) {
-				fields.c = mocks.NewConnector(t)
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/KP-1?expand=renderedFields&fields=description%2Clabels",
+					"",
+					nil).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := issueService.GetRendered(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.fields)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+				assert.Equal(t, "<p>Summary</p>", gotResult.RenderedFields["description"])
+			}
+
+		})
+	}
+}
+
+func Test_internalRichTextServiceImpl_ReorderSubtasks(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx                context.Context
+		parentKey          string
+		orderedSubtaskKeys []string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v2",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:                context.Background(),
+				parentKey:          "KP-1",
+				orderedSubtaskKeys: []string{"KP-4", "KP-2", "KP-3"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/KP-1?fields=subtasks",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&issueSubtasksScheme{}).
+					Run(func(args mock.Arguments) {
+						_ = json.Unmarshal([]byte(`{"fields":{"subtasks":[{"id":"10001","key":"KP-2"},{"id":"10002","key":"KP-3"},{"id":"10003","key":"KP-4"}]}}`), args.Get(1))
+					}).
+					Return(&model.ResponseScheme{}, nil).
+					Once()
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPut,
+					"rest/agile/1.0/issue/rank",
+					"",
+					map[string]interface{}{"issues": []string{"KP-2", "KP-3"}, "rankAfterIssue": "KP-4"}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issue is not a subtask of the parent",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:                context.Background(),
+				parentKey:          "KP-1",
+				orderedSubtaskKeys: []string{"KP-2", "KP-9"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/2/issue/KP-1?fields=subtasks",
+					"",
+					nil).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&issueSubtasksScheme{}).
+					Run(func(args mock.Arguments) {
+						_ = json.Unmarshal([]byte(`{"fields":{"subtasks":[{"id":"10001","key":"KP-2"},{"id":"10002","key":"KP-3"},{"id":"10003","key":"KP-4"}]}}`), args.Get(1))
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
 			},
 			wantErr: true,
-			Err:     model.ErrNoIssueKeyOrID,
+			Err:     fmt.Errorf("%w: KP-9", model.ErrNotSubtaskOfParent),
 		},
 
 		{
-			name:   "when the transition id is not provided",
+			name:   "when the subtask keys are not provided",
 			fields: fields{version: "2"},
 			args: args{
-				ctx:          context.Background(),
-				issueKeyOrID: "DUMMY-1",
-				transitionID: "",
-				options: &model.IssueMoveOptionsV2{
-					Fields: &model.IssueSchemeV2{
-						Fields: &model.IssueFieldsSchemeV2{
-							Summary:   "New summary test",
-							Project:   &model.ProjectScheme{ID: "10000"},
-							IssueType: &model.IssueTypeScheme{Name: "Story"},
-						},
-					},
-					CustomFields: customFieldsMocked,
-					Operations:   operationsMocked,
-				},
+				ctx:                context.Background(),
+				parentKey:          "KP-1",
+				orderedSubtaskKeys: nil,
 			},
-			on: func(fields *fields) {
-				fields.c = mocks.NewConnector(t)
+			wantErr: true,
+			Err:     model.ErrNoSubtaskKeys,
+		},
+
+		{
+			name:   "when the parent key is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:                context.Background(),
+				parentKey:          "",
+				orderedSubtaskKeys: []string{"KP-4", "KP-2", "KP-3"},
 			},
 			wantErr: true,
-			Err:     model.ErrNoTransitionID,
+			Err:     model.ErrNoIssueKeyOrID,
 		},
 
 		{
 			name:   "when the http request cannot be created",
 			fields: fields{version: "2"},
 			args: args{
-				ctx:          context.Background(),
-				issueKeyOrID: "DUMMY-1",
-				transitionID: "10001",
-				options: &model.IssueMoveOptionsV2{
-					Fields: &model.IssueSchemeV2{
-						Fields: &model.IssueFieldsSchemeV2{
-							Summary:   "New summary test",
-							Project:   &model.ProjectScheme{ID: "10000"},
-							IssueType: &model.IssueTypeScheme{Name: "Story"},
-						},
-					},
-					CustomFields: customFieldsMocked,
-					Operations:   operationsMocked,
-				},
+				ctx:                context.Background(),
+				parentKey:          "KP-1",
+				orderedSubtaskKeys: []string{"KP-4", "KP-2", "KP-3"},
 			},
 			on: func(fields *fields) {
 
@@ -1352,10 +3890,10 @@ func Test_internalRichTextServiceImpl_Move(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodPost,
-					"rest/api/2/issue/DUMMY-1/transitions",
+					http.MethodGet,
+					"rest/api/2/issue/KP-1?fields=subtasks",
 					"",
-					mock.Anything).
+					nil).
 					Return(&http.Request{}, errors.New("error, unable to create the http request"))
 
 				fields.c = client
@@ -1375,8 +3913,7 @@ func Test_internalRichTextServiceImpl_Move(t *testing.T) {
 			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
-			gotResponse, err := issueService.Move(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.transitionID,
-				testCase.args.options)
+			gotResponse, err := issueService.ReorderSubtasks(testCase.args.ctx, testCase.args.parentKey, testCase.args.orderedSubtaskKeys)
 
 			if testCase.wantErr {
 
@@ -1396,51 +3933,7 @@ func Test_internalRichTextServiceImpl_Move(t *testing.T) {
 	}
 }
 
-func Test_internalRichTextServiceImpl_Update(t *testing.T) {
-
-	customFieldsMocked := &model.CustomFields{}
-
-	err := customFieldsMocked.Groups("customfield_10052", []string{"jira-administrators", "jira-administrators-system"})
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	err = customFieldsMocked.Number("customfield_10042", 1000.2222)
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	operations := &model.UpdateOperations{}
-	err = operations.AddArrayOperation("labels", map[string]string{
-		"triaged": "remove",
-	})
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	expectedPayloadWithCustomFieldsAndOperations := map[string]interface{}{
-		"fields": map[string]interface{}{
-			"customfield_10042": 1000.2222,
-			"customfield_10052": []map[string]interface{}{map[string]interface{}{
-				"name": "jira-administrators"}, map[string]interface{}{
-				"name": "jira-administrators-system"}}, "summary": "New summary test"},
-		"update": map[string]interface{}{
-			"labels": []map[string]interface{}{map[string]interface{}{
-				"remove": "triaged"}}}}
-
-	expectedPayloadWithCustomfields := map[string]interface{}{
-		"fields": map[string]interface{}{
-			"customfield_10042": 1000.2222,
-			"customfield_10052": []map[string]interface{}{map[string]interface{}{
-				"name": "jira-administrators"}, map[string]interface{}{
-				"name": "jira-administrators-system"}},
-			"summary": "New summary test"}}
-
-	expectedPayloadWithOperations := map[string]interface{}{
-		"fields": map[string]interface{}{
-			"summary": "New summary test"},
-		"update": map[string]interface{}{
-			"labels": []map[string]interface{}{map[string]interface{}{"remove": "triaged"}}}}
+func Test_internalRichTextServiceImpl_GetWithProperties(t *testing.T) {
 
 	type fields struct {
 		c       service.Connector
@@ -1448,12 +3941,9 @@ func Test_internalRichTextServiceImpl_Update(t *testing.T) {
 	}
 
 	type args struct {
-		ctx          context.Context
-		issueKeyOrID string
-		notify       bool
-		payload      *model.IssueSchemeV2
-		customFields *model.CustomFields
-		operations   *model.UpdateOperations
+		ctx                context.Context
+		issueKeyOrID       string
+		fields, properties []string
 	}
 
 	testCases := []struct {
@@ -1465,19 +3955,13 @@ func Test_internalRichTextServiceImpl_Update(t *testing.T) {
 		Err     error
 	}{
 		{
-			name:   "when the api version is v3",
-			fields: fields{version: "2"},
+			name:   "when the parameters are correct",
+			fields: fields{version: "3"},
 			args: args{
 				ctx:          context.Background(),
 				issueKeyOrID: "DUMMY-1",
-				notify:       true,
-				payload: &model.IssueSchemeV2{
-					Fields: &model.IssueFieldsSchemeV2{
-						Summary: "New summary test",
-					},
-				},
-				customFields: customFieldsMocked,
-				operations:   operations,
+				fields:       []string{"summary", "status"},
+				properties:   []string{"app.config", "app.sync"},
 			},
 			on: func(fields *fields) {
 
@@ -1485,15 +3969,15 @@ func Test_internalRichTextServiceImpl_Update(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodPut,
-					"rest/api/2/issue/DUMMY-1?notifyUsers=true",
+					http.MethodGet,
+					"rest/api/3/issue/DUMMY-1?fields=summary%2Cstatus&properties=app.config%2Capp.sync",
 					"",
-					expectedPayloadWithCustomFieldsAndOperations).
+					nil).
 					Return(&http.Request{}, nil)
 
 				client.On("Call",
 					&http.Request{},
-					nil).
+					&model.IssueSchemeV2{}).
 					Return(&model.ResponseScheme{}, nil)
 
 				fields.c = client
@@ -1502,145 +3986,51 @@ func Test_internalRichTextServiceImpl_Update(t *testing.T) {
 
 		{
 			name:   "when the issue key or id is not provided",
-			fields: fields{version: "2"},
+			fields: fields{version: "3"},
 			args: args{
 				ctx:          context.Background(),
 				issueKeyOrID: "",
-				notify:       true,
-				payload: &model.IssueSchemeV2{
-					Fields: &model.IssueFieldsSchemeV2{
-						Summary: "New summary test",
-					},
-				},
-				customFields: customFieldsMocked,
-				operations:   operations,
-			},
-			on: func(fields *fields) {
-				fields.c = mocks.NewConnector(t)
+				fields:       []string{"summary", "status"},
+				properties:   []string{"app.config", "app.sync"},
 			},
 			wantErr: true,
 			Err:     model.ErrNoIssueKeyOrID,
 		},
 
 		{
-			name:   "when the http request cannot be created",
-			fields: fields{version: "2"},
+			name:   "when the properties are not provided",
+			fields: fields{version: "3"},
 			args: args{
 				ctx:          context.Background(),
 				issueKeyOrID: "DUMMY-1",
-				notify:       true,
-				payload: &model.IssueSchemeV2{
-					Fields: &model.IssueFieldsSchemeV2{
-						Summary: "New summary test",
-					},
-				},
-				customFields: customFieldsMocked,
-				operations:   operations,
-			},
-			on: func(fields *fields) {
-
-				client := mocks.NewConnector(t)
-
-				client.On("NewRequest",
-					context.Background(),
-					http.MethodPut,
-					"rest/api/2/issue/DUMMY-1?notifyUsers=true",
-					"",
-					expectedPayloadWithCustomFieldsAndOperations).
-					Return(&http.Request{}, errors.New("error, unable to create the http request"))
-
-				fields.c = client
+				fields:       []string{"summary", "status"},
+				properties:   nil,
 			},
 			wantErr: true,
-			Err:     errors.New("error, unable to create the http request"),
-		},
-
-		{
-			name:   "when the operations are not provided",
-			fields: fields{version: "2"},
-			args: args{
-				ctx:          context.Background(),
-				issueKeyOrID: "DUMMY-1",
-				notify:       true,
-				payload: &model.IssueSchemeV2{
-					Fields: &model.IssueFieldsSchemeV2{
-						Summary: "New summary test",
-					},
-				},
-				customFields: customFieldsMocked,
-				operations:   nil,
-			},
-			on: func(fields *fields) {
-
-				client := mocks.NewConnector(t)
-
-				client.On("NewRequest",
-					context.Background(),
-					http.MethodPut,
-					"rest/api/2/issue/DUMMY-1?notifyUsers=true",
-					"",
-					expectedPayloadWithCustomfields).
-					Return(&http.Request{}, nil)
-
-				client.On("Call",
-					&http.Request{},
-					nil).
-					Return(&model.ResponseScheme{}, nil)
-
-				fields.c = client
-			},
+			Err:     model.ErrNoPropertyKey,
 		},
 
 		{
-			name:   "when the custom fields are not provided",
-			fields: fields{version: "2"},
+			name:   "when more than 5 properties are requested",
+			fields: fields{version: "3"},
 			args: args{
 				ctx:          context.Background(),
 				issueKeyOrID: "DUMMY-1",
-				notify:       true,
-				payload: &model.IssueSchemeV2{
-					Fields: &model.IssueFieldsSchemeV2{
-						Summary: "New summary test",
-					},
-				},
-				customFields: nil,
-				operations:   operations,
-			},
-			on: func(fields *fields) {
-
-				client := mocks.NewConnector(t)
-
-				client.On("NewRequest",
-					context.Background(),
-					http.MethodPut,
-					"rest/api/2/issue/DUMMY-1?notifyUsers=true",
-					"",
-					expectedPayloadWithOperations).
-					Return(&http.Request{}, nil)
-
-				client.On("Call",
-					&http.Request{},
-					nil).
-					Return(&model.ResponseScheme{}, nil)
-
-				fields.c = client
+				fields:       []string{"summary", "status"},
+				properties:   []string{"p1", "p2", "p3", "p4", "p5", "p6"},
 			},
+			wantErr: true,
+			Err:     model.ErrTooManyIssueProperties,
 		},
 
 		{
-			name:   "when the operations are customfields are not provided",
-			fields: fields{version: "2"},
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
 			args: args{
 				ctx:          context.Background(),
 				issueKeyOrID: "DUMMY-1",
-				notify:       true,
-				payload: &model.IssueSchemeV2{
-					Fields: &model.IssueFieldsSchemeV2{
-						Summary: "New summary test",
-					},
-				},
-				customFields: nil,
-				operations:   nil,
+				fields:       []string{"summary", "status"},
+				properties:   []string{"app.config", "app.sync"},
 			},
 			on: func(fields *fields) {
 
@@ -1648,23 +4038,16 @@ func Test_internalRichTextServiceImpl_Update(t *testing.T) {
 
 				client.On("NewRequest",
 					context.Background(),
-					http.MethodPut,
-					"rest/api/2/issue/DUMMY-1?notifyUsers=true",
+					http.MethodGet,
+					"rest/api/3/issue/DUMMY-1?fields=summary%2Cstatus&properties=app.config%2Capp.sync",
 					"",
-					&model.IssueSchemeV2{
-						Fields: &model.IssueFieldsSchemeV2{
-							Summary: "New summary test",
-						},
-					}).
-					Return(&http.Request{}, nil)
-
-				client.On("Call",
-					&http.Request{},
 					nil).
-					Return(&model.ResponseScheme{}, nil)
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
 
 				fields.c = client
 			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
 		},
 	}
 
@@ -1678,8 +4061,7 @@ func Test_internalRichTextServiceImpl_Update(t *testing.T) {
 			issueService, _, err := NewIssueService(testCase.fields.c, testCase.fields.version, nil)
 			assert.NoError(t, err)
 
-			gotResponse, err := issueService.Update(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.notify,
-				testCase.args.payload, testCase.args.customFields, testCase.args.operations)
+			gotResult, gotResponse, err := issueService.GetWithProperties(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.fields, testCase.args.properties)
 
 			if testCase.wantErr {
 
@@ -1693,6 +4075,7 @@ func Test_internalRichTextServiceImpl_Update(t *testing.T) {
 
 				assert.NoError(t, err)
 				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
 			}
 
 		})
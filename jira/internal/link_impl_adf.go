@@ -119,6 +119,10 @@ func (i *internalLinkADFServiceImpl) Delete(ctx context.Context, linkID string)
 
 func (i *internalLinkADFServiceImpl) Create(ctx context.Context, payload *model.LinkPayloadSchemeV3) (*model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/issueLink", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -123,6 +123,10 @@ func (i *internalLinkRichTextServiceImpl) Delete(ctx context.Context, linkID str
 
 func (i *internalLinkRichTextServiceImpl) Create(ctx context.Context, payload *model.LinkPayloadSchemeV2) (*model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/issueLink", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -124,6 +124,10 @@ func (i *internalLinkTypeImpl) Get(ctx context.Context, issueLinkTypeID string)
 
 func (i *internalLinkTypeImpl) Create(ctx context.Context, payload *model.LinkTypeScheme) (*model.LinkTypeScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/issueLinkType", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -146,6 +150,10 @@ func (i *internalLinkTypeImpl) Update(ctx context.Context, issueLinkTypeID strin
 		return nil, nil, model.ErrNoLinkTypeID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/issueLinkType/%v", i.version, issueLinkTypeID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -114,8 +114,30 @@ type internalMetadataImpl struct {
 
 func (i *internalMetadataImpl) FetchIssueMappings(ctx context.Context, projectKeyOrID string, startAt, maxResults int) (gjson.Result, *model.ResponseScheme, error) {
 
+	response, err := i.fetchIssueMappings(ctx, projectKeyOrID, startAt, maxResults, nil)
+	if err != nil {
+		return gjson.Result{}, response, err
+	}
+
+	return gjson.ParseBytes(response.Bytes.Bytes()), response, nil
+}
+
+func (i *internalMetadataImpl) FetchFieldMappings(ctx context.Context, projectKeyOrID, issueTypeID string, startAt, maxResults int) (gjson.Result, *model.ResponseScheme, error) {
+
+	response, err := i.fetchFieldMappings(ctx, projectKeyOrID, issueTypeID, startAt, maxResults, nil)
+	if err != nil {
+		return gjson.Result{}, response, err
+	}
+
+	return gjson.ParseBytes(response.Bytes.Bytes()), response, nil
+}
+
+// fetchIssueMappings requests a page of the issue type metadata of a project, decoded into page when it's not nil.
+// It's shared with the typed Issue.CreateMetaIssueTypes.
+func (i *internalMetadataImpl) fetchIssueMappings(ctx context.Context, projectKeyOrID string, startAt, maxResults int, page interface{}) (*model.ResponseScheme, error) {
+
 	if projectKeyOrID == "" {
-		return gjson.Result{}, nil, model.ErrNoProjectIDOrKey
+		return nil, model.ErrNoProjectIDOrKey
 	}
 
 	params := url.Values{}
@@ -126,25 +148,22 @@ func (i *internalMetadataImpl) FetchIssueMappings(ctx context.Context, projectKe
 
 	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
 	if err != nil {
-		return gjson.Result{}, nil, err
+		return nil, err
 	}
 
-	response, err := i.c.Call(request, nil)
-	if err != nil {
-		return gjson.Result{}, response, err
-	}
-
-	return gjson.ParseBytes(response.Bytes.Bytes()), response, nil
+	return i.c.Call(request, page)
 }
 
-func (i *internalMetadataImpl) FetchFieldMappings(ctx context.Context, projectKeyOrID, issueTypeID string, startAt, maxResults int) (gjson.Result, *model.ResponseScheme, error) {
+// fetchFieldMappings requests a page of the field metadata of an issue type, decoded into page when it's not nil.
+// It's shared with the typed Issue.CreateMeta.
+func (i *internalMetadataImpl) fetchFieldMappings(ctx context.Context, projectKeyOrID, issueTypeID string, startAt, maxResults int, page interface{}) (*model.ResponseScheme, error) {
 
 	if projectKeyOrID == "" {
-		return gjson.Result{}, nil, model.ErrNoProjectIDOrKey
+		return nil, model.ErrNoProjectIDOrKey
 	}
 
 	if issueTypeID == "" {
-		return gjson.Result{}, nil, model.ErrNoIssueTypeID
+		return nil, model.ErrNoIssueTypeID
 	}
 
 	params := url.Values{}
@@ -154,10 +173,15 @@ func (i *internalMetadataImpl) FetchFieldMappings(ctx context.Context, projectKe
 
 	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
 	if err != nil {
-		return gjson.Result{}, nil, err
+		return nil, err
 	}
 
-	response, err := i.c.Call(request, nil)
+	return i.c.Call(request, page)
+}
+
+func (i *internalMetadataImpl) Get(ctx context.Context, issueKeyOrID string, overrideScreenSecurity, overrideEditableFlag bool) (gjson.Result, *model.ResponseScheme, error) {
+
+	response, err := i.get(ctx, issueKeyOrID, overrideScreenSecurity, overrideEditableFlag, nil)
 	if err != nil {
 		return gjson.Result{}, response, err
 	}
@@ -165,10 +189,12 @@ func (i *internalMetadataImpl) FetchFieldMappings(ctx context.Context, projectKe
 	return gjson.ParseBytes(response.Bytes.Bytes()), response, nil
 }
 
-func (i *internalMetadataImpl) Get(ctx context.Context, issueKeyOrID string, overrideScreenSecurity, overrideEditableFlag bool) (gjson.Result, *model.ResponseScheme, error) {
+// get requests the edit metadata of an issue, decoded into metadata when it's not nil.
+// It's shared with the typed Issue.EditMeta.
+func (i *internalMetadataImpl) get(ctx context.Context, issueKeyOrID string, overrideScreenSecurity, overrideEditableFlag bool, metadata interface{}) (*model.ResponseScheme, error) {
 
 	if issueKeyOrID == "" {
-		return gjson.Result{}, nil, model.ErrNoIssueKeyOrID
+		return nil, model.ErrNoIssueKeyOrID
 	}
 
 	params := url.Values{}
@@ -179,15 +205,10 @@ func (i *internalMetadataImpl) Get(ctx context.Context, issueKeyOrID string, ove
 
 	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
 	if err != nil {
-		return gjson.Result{}, nil, err
+		return nil, err
 	}
 
-	response, err := i.c.Call(request, nil)
-	if err != nil {
-		return gjson.Result{}, response, err
-	}
-
-	return gjson.ParseBytes(response.Bytes.Bytes()), response, nil
+	return i.c.Call(request, metadata)
 }
 
 func (i *internalMetadataImpl) Create(ctx context.Context, opts *model.IssueMetadataCreateOptions) (gjson.Result, *model.ResponseScheme, error) {
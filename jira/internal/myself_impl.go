@@ -66,6 +66,20 @@ func (m *MySelfService) Delete(ctx context.Context, key string) (*model.Response
 	return m.internalClient.Delete(ctx, key)
 }
 
+// Validate verifies the credentials calling the current user endpoint, use it before running long jobs.
+//
+// It returns the authenticated account, the deployment type and the scopes granted to the OAuth 2.0 tokens.
+// The deployment type is the one reported by the server information, cached by the client, and the scopes
+// are the ones listed by the X-OAuth-Scopes header, never the ones accepted by the endpoint.
+// The authentication challenge is appended to the error when the credentials are rejected.
+//
+// GET /rest/api/{2-3}/myself
+//
+// https://docs.go-atlassian.io/jira-software-cloud/myself#validate-credentials
+func (m *MySelfService) Validate(ctx context.Context) (*model.ValidationResultScheme, *model.ResponseScheme, error) {
+	return m.internalClient.Validate(ctx)
+}
+
 type internalMySelfImpl struct {
 	c       service.Connector
 	version string
@@ -181,3 +195,45 @@ func (i *internalMySelfImpl) Delete(ctx context.Context, key string) (*model.Res
 
 	return response, nil
 }
+
+func (i *internalMySelfImpl) Validate(ctx context.Context) (*model.ValidationResultScheme, *model.ResponseScheme, error) {
+
+	account, response, err := i.Details(ctx, nil)
+	if err != nil {
+
+		if response != nil && response.Response != nil {
+			if challenge := response.Response.Header.Get("WWW-Authenticate"); challenge != "" {
+				return nil, response, fmt.Errorf("%w: %v", err, challenge)
+			}
+		}
+
+		return nil, response, err
+	}
+
+	result := &model.ValidationResultScheme{Account: account}
+
+	if connector, ok := i.c.(service.DeploymentTypeConnector); ok {
+		result.DeploymentType, err = connector.DeploymentType(ctx)
+	} else {
+		server := &internalServerServiceImpl{c: i.c, version: i.version}
+		result.DeploymentType, _, err = server.DeploymentType(ctx)
+	}
+
+	if err != nil {
+		return nil, response, err
+	}
+
+	// Only the scopes granted to the token are reported, X-Accepted-OAuth-Scopes lists the ones accepted by the endpoint.
+	if response != nil && response.Response != nil {
+
+		scopes := strings.FieldsFunc(response.Response.Header.Get("X-OAuth-Scopes"), func(r rune) bool {
+			return r == ',' || r == ' '
+		})
+
+		if len(scopes) != 0 {
+			result.Scopes = scopes
+		}
+	}
+
+	return result, response, nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func Test_internalMySelfImpl_Details(t *testing.T) {
@@ -765,3 +766,153 @@ func Test_internalMySelfImpl_Delete(t *testing.T) {
 		})
 	}
 }
+
+// deploymentTypeConnector is a connector caching the deployment type, as the Jira clients do.
+type deploymentTypeConnector struct {
+	*mocks.Connector
+	deploymentType string
+}
+
+func (d *deploymentTypeConnector) DeploymentType(ctx context.Context) (string, error) {
+	return d.deploymentType, nil
+}
+
+func Test_internalMySelfImpl_Validate(t *testing.T) {
+
+	testCases := []struct {
+		name       string
+		account    *model.UserScheme
+		header     http.Header
+		code       int
+		err        error
+		deployment string
+		cached     bool
+		want       *model.ValidationResultScheme
+		wantErr    bool
+		Err        error
+		contains   string
+	}{
+		{
+			name:       "when the credentials belong to a cloud account",
+			account:    &model.UserScheme{AccountID: "5b10ac8d82e05b22cc7d4ef5"},
+			header:     http.Header{"X-Oauth-Scopes": []string{"read:jira-work, read:jira-user"}},
+			code:       http.StatusOK,
+			deployment: "Cloud",
+			want: &model.ValidationResultScheme{
+				Account:        &model.UserScheme{AccountID: "5b10ac8d82e05b22cc7d4ef5"},
+				DeploymentType: model.DeploymentTypeCloud,
+				Scopes:         []string{"read:jira-work", "read:jira-user"},
+			},
+		},
+
+		{
+			name:       "when the endpoint only reports the accepted scopes",
+			account:    &model.UserScheme{AccountID: "5b10ac8d82e05b22cc7d4ef5"},
+			header:     http.Header{"X-Accepted-Oauth-Scopes": []string{"read:jira-user"}},
+			code:       http.StatusOK,
+			deployment: "Cloud",
+			want: &model.ValidationResultScheme{
+				Account:        &model.UserScheme{AccountID: "5b10ac8d82e05b22cc7d4ef5"},
+				DeploymentType: model.DeploymentTypeCloud,
+			},
+		},
+
+		{
+			name:       "when the credentials belong to a server account",
+			account:    &model.UserScheme{Key: "JIRAUSER10000", Name: "carlos"},
+			header:     http.Header{},
+			code:       http.StatusOK,
+			deployment: "Server",
+			want: &model.ValidationResultScheme{
+				Account:        &model.UserScheme{Key: "JIRAUSER10000", Name: "carlos"},
+				DeploymentType: model.DeploymentTypeServer,
+			},
+		},
+
+		{
+			name:       "when the client caches the deployment type",
+			account:    &model.UserScheme{AccountID: "5b10ac8d82e05b22cc7d4ef5"},
+			header:     http.Header{},
+			code:       http.StatusOK,
+			deployment: model.DeploymentTypeCloud,
+			cached:     true,
+			want: &model.ValidationResultScheme{
+				Account:        &model.UserScheme{AccountID: "5b10ac8d82e05b22cc7d4ef5"},
+				DeploymentType: model.DeploymentTypeCloud,
+			},
+		},
+
+		{
+			name:     "when the credentials are rejected",
+			header:   http.Header{"Www-Authenticate": []string{`Bearer error="invalid_token"`}},
+			code:     http.StatusUnauthorized,
+			err:      model.ErrUnauthorized,
+			wantErr:  true,
+			Err:      model.ErrUnauthorized,
+			contains: `invalid_token`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			client := mocks.NewConnector(t)
+
+			client.On("NewRequest",
+				context.Background(),
+				http.MethodGet,
+				"rest/api/3/myself",
+				"",
+				nil).
+				Return(&http.Request{RequestURI: "myself"}, nil)
+
+			client.On("Call",
+				&http.Request{RequestURI: "myself"},
+				&model.UserScheme{}).
+				Run(func(args mock.Arguments) {
+					if testCase.account != nil {
+						*args.Get(1).(*model.UserScheme) = *testCase.account
+					}
+				}).
+				Return(&model.ResponseScheme{Code: testCase.code, Response: &http.Response{StatusCode: testCase.code, Header: testCase.header}}, testCase.err)
+
+			var connector service.Connector = client
+
+			if testCase.cached {
+				connector = &deploymentTypeConnector{Connector: client, deploymentType: testCase.deployment}
+			} else if testCase.deployment != "" {
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodGet,
+					"rest/api/3/serverInfo",
+					"",
+					nil).
+					Return(&http.Request{RequestURI: "serverInfo"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "serverInfo"},
+					&model.ServerInformationScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.ServerInformationScheme).DeploymentType = testCase.deployment
+					}).
+					Return(&model.ResponseScheme{}, nil)
+			}
+
+			newService, err := NewMySelfService(connector, "3")
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.Validate(context.Background())
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err))
+				assert.ErrorContains(t, err, testCase.contains)
+				assert.NotNil(t, gotResponse)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, gotResult)
+		})
+	}
+}
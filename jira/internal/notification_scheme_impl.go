@@ -163,6 +163,10 @@ func (i *internalNotificationSchemeImpl) Search(ctx context.Context, options *mo
 
 func (i *internalNotificationSchemeImpl) Create(ctx context.Context, payload *model.NotificationSchemePayloadScheme) (*model.NotificationSchemeCreatedPayload, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/notificationscheme", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -246,6 +250,10 @@ func (i *internalNotificationSchemeImpl) Update(ctx context.Context, schemeID st
 		return nil, model.ErrNoNotificationSchemeID
 	}
 
+	if payload == nil {
+		return nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/notificationscheme/%v", i.version, schemeID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -77,6 +77,10 @@ func (i *internalPermissionSchemeGrantImpl) Create(ctx context.Context, permissi
 		return nil, nil, model.ErrNoPermissionSchemeID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/permissionscheme/%v/permission", i.version, permissionSchemeID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -120,6 +120,10 @@ func (i *internalProjectCategoryImpl) Get(ctx context.Context, categoryID int) (
 
 func (i *internalProjectCategoryImpl) Create(ctx context.Context, payload *model.ProjectCategoryPayloadScheme) (*model.ProjectCategoryScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/projectCategory", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -142,6 +146,10 @@ func (i *internalProjectCategoryImpl) Update(ctx context.Context, categoryID int
 		return nil, nil, model.ErrNoProjectCategoryID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/projectCategory/%v", i.version, categoryID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -91,6 +91,10 @@ type internalProjectComponentImpl struct {
 
 func (i *internalProjectComponentImpl) Create(ctx context.Context, payload *model.ComponentPayloadScheme) (*model.ComponentScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/component", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -173,6 +177,10 @@ func (i *internalProjectComponentImpl) Update(ctx context.Context, componentID s
 		return nil, nil, model.ErrNoComponentID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/component/%v", i.version, componentID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -37,7 +37,8 @@ func (p *ProjectFeatureService) Gets(ctx context.Context, projectKeyOrID string)
 	return p.internalClient.Gets(ctx, projectKeyOrID)
 }
 
-// Set sets the state of a project feature.
+// Set sets the state of a project feature, e.g. model.ProjectFeatureEnabled or model.ProjectFeatureDisabled,
+// and returns the updated features of the project.
 //
 // PUT /rest/api/{2-3}/project/{projectKeyOrID}/features/{featureKey}
 //
@@ -15,7 +15,7 @@ import (
 
 func Test_internalProjectFeatureImpl_Set(t *testing.T) {
 
-	payloadMocked := map[string]interface{}{"state": "ENABLED"}
+	payloadMocked := map[string]interface{}{"state": model.ProjectFeatureEnabled}
 
 	type fields struct {
 		c       service.Connector
@@ -42,7 +42,7 @@ func Test_internalProjectFeatureImpl_Set(t *testing.T) {
 				ctx:            context.Background(),
 				projectKeyOrID: "DUMMY",
 				featureKey:     "jsw.classic.roadmap",
-				state:          "ENABLED",
+				state:          model.ProjectFeatureEnabled,
 			},
 			on: func(fields *fields) {
 
@@ -73,7 +73,7 @@ func Test_internalProjectFeatureImpl_Set(t *testing.T) {
 				ctx:            context.Background(),
 				projectKeyOrID: "DUMMY",
 				featureKey:     "jsw.classic.roadmap",
-				state:          "ENABLED",
+				state:          model.ProjectFeatureEnabled,
 			},
 			on: func(fields *fields) {
 
@@ -137,7 +137,7 @@ func Test_internalProjectFeatureImpl_Set(t *testing.T) {
 				ctx:            context.Background(),
 				projectKeyOrID: "DUMMY",
 				featureKey:     "jsw.classic.roadmap",
-				state:          "ENABLED",
+				state:          model.ProjectFeatureEnabled,
 			},
 			on: func(fields *fields) {
 
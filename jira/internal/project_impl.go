@@ -135,6 +135,8 @@ func (p *ProjectService) Delete(ctx context.Context, projectKeyOrID string, enab
 //
 // 2. asynchronous. Follow the location link in the response to determine the status of the task and use Get task to obtain subsequent updates.
 //
+// The returned task can be awaited with the Task service, e.g. instance.Task.Await(ctx, deletion.ID).
+//
 // POST /rest/api/{2-3}/project/{projectKeyOrID}/delete
 //
 // https://docs.go-atlassian.io/jira-software-cloud/projects#delete-project-asynchronously
@@ -191,6 +193,10 @@ type internalProjectImpl struct {
 
 func (i *internalProjectImpl) Create(ctx context.Context, payload *model.ProjectPayloadScheme) (*model.NewProjectCreatedScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/project", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -313,6 +319,10 @@ func (i *internalProjectImpl) Update(ctx context.Context, projectKeyOrID string,
 		return nil, nil, model.ErrNoProjectIDOrKey
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/project/%v", i.version, projectKeyOrID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
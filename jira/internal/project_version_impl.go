@@ -189,6 +189,10 @@ func (i *internalProjectVersionImpl) Search(ctx context.Context, projectKeyOrID
 
 func (i *internalProjectVersionImpl) Create(ctx context.Context, payload *model.VersionPayloadScheme) (*model.VersionScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/version", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -242,6 +246,10 @@ func (i *internalProjectVersionImpl) Update(ctx context.Context, versionID strin
 		return nil, nil, model.ErrNoVersionID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/version/%v", i.version, versionID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -100,6 +100,18 @@ func (r *RemoteLinkService) DeleteByGlobalID(ctx context.Context, issueKeyOrID,
 	return r.internalClient.DeleteByGlobalID(ctx, issueKeyOrID, globalID)
 }
 
+// LinkConfluencePage links a Confluence page to an issue, the page is listed on the Confluence content panel of the issue.
+//
+// The global ID of the remote link is built as appId=<application link ID>&pageId=<page ID>, the format Jira expects
+// to resolve the page through the application link, so linking the same page again updates the existing link.
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/remotelink
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues/link/remote#link-confluence-page
+func (r *RemoteLinkService) LinkConfluencePage(ctx context.Context, issueKeyOrID string, page *model.RemoteLinkConfluencePageScheme) (*model.RemoteLinkIdentify, *model.ResponseScheme, error) {
+	return r.internalClient.LinkConfluencePage(ctx, issueKeyOrID, page)
+}
+
 type internalRemoteLinkImpl struct {
 	c       service.Connector
 	version string
@@ -167,6 +179,10 @@ func (i *internalRemoteLinkImpl) Create(ctx context.Context, issueKeyOrID string
 		return nil, nil, model.ErrNoIssueKeyOrID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/remotelink", i.version, issueKeyOrID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -193,6 +209,10 @@ func (i *internalRemoteLinkImpl) Update(ctx context.Context, issueKeyOrID, linkI
 		return nil, model.ErrNoRemoteLinkID
 	}
 
+	if payload == nil {
+		return nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/issue/%v/remotelink/%v", i.version, issueKeyOrID, linkID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -245,3 +265,47 @@ func (i *internalRemoteLinkImpl) DeleteByGlobalID(ctx context.Context, issueKeyO
 
 	return i.c.Call(request, nil)
 }
+
+func (i *internalRemoteLinkImpl) LinkConfluencePage(ctx context.Context, issueKeyOrID string, page *model.RemoteLinkConfluencePageScheme) (*model.RemoteLinkIdentify, *model.ResponseScheme, error) {
+
+	if issueKeyOrID == "" {
+		return nil, nil, model.ErrNoIssueKeyOrID
+	}
+
+	if page == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
+	if page.AppLinkID == "" {
+		return nil, nil, model.ErrNoRemoteLinkAppLinkID
+	}
+
+	if page.PageID == "" {
+		return nil, nil, model.ErrNoRemoteLinkPageID
+	}
+
+	if page.URL == "" {
+		return nil, nil, model.ErrNoRemoteLinkURL
+	}
+
+	title := page.Title
+	if title == "" {
+		title = page.URL
+	}
+
+	globalID := url.Values{}
+	globalID.Add("appId", page.AppLinkID)
+	globalID.Add("pageId", page.PageID)
+
+	payload := &model.RemoteLinkScheme{
+		GlobalID: globalID.Encode(),
+		Application: &model.RemoteLinkApplicationScheme{
+			Type: model.RemoteLinkConfluenceApplicationType,
+			Name: model.RemoteLinkConfluenceApplicationName,
+		},
+		Relationship: model.RemoteLinkConfluenceRelationship,
+		Object:       &model.RemoteLinkObjectScheme{URL: page.URL, Title: title},
+	}
+
+	return i.Create(ctx, issueKeyOrID, payload)
+}
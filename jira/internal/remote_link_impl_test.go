@@ -569,6 +569,21 @@ func Test_internalRemoteLinkImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to execute the http call"),
 		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-23",
+				linkID:       "10001",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -774,6 +789,20 @@ func Test_internalRemoteLinkImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to execute the http call"),
 		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "KP-23",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1182,3 +1211,199 @@ func Test_internalRemoteLinkImpl_DeleteByGlobalID(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalRemoteLinkImpl_LinkConfluencePage(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx          context.Context
+		issueKeyOrID string
+		page         *model.RemoteLinkConfluencePageScheme
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				page: &model.RemoteLinkConfluencePageScheme{
+					AppLinkID: "4b6b5a2e-2a3c-3b36-9e0b-1e0e4a1a7f7c",
+					PageID:    "65611",
+					URL:       "https://ctreminiom.atlassian.net/wiki/spaces/DUMMY/pages/65611",
+					Title:     "Release notes",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/DUMMY-1/remotelink",
+					"",
+					&model.RemoteLinkScheme{
+						GlobalID:     "appId=4b6b5a2e-2a3c-3b36-9e0b-1e0e4a1a7f7c&pageId=65611",
+						Application:  &model.RemoteLinkApplicationScheme{Type: "com.atlassian.confluence", Name: "Confluence"},
+						Relationship: "Wiki Page",
+						Object:       &model.RemoteLinkObjectScheme{URL: "https://ctreminiom.atlassian.net/wiki/spaces/DUMMY/pages/65611", Title: "Release notes"},
+					}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.RemoteLinkIdentify{}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the issue key or id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "",
+				page: &model.RemoteLinkConfluencePageScheme{
+					AppLinkID: "4b6b5a2e-2a3c-3b36-9e0b-1e0e4a1a7f7c",
+					PageID:    "65611",
+					URL:       "https://ctreminiom.atlassian.net/wiki/spaces/DUMMY/pages/65611",
+					Title:     "Release notes",
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the page url is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				page: &model.RemoteLinkConfluencePageScheme{
+					AppLinkID: "4b6b5a2e-2a3c-3b36-9e0b-1e0e4a1a7f7c",
+					PageID:    "65611",
+					Title:     "Release notes",
+				},
+			},
+			wantErr: true,
+			Err:     model.ErrNoRemoteLinkURL,
+		},
+
+		{
+			name:   "when the page is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
+
+		{
+			name:   "when the application link id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				page:         &model.RemoteLinkConfluencePageScheme{PageID: "65611", URL: "https://ctreminiom.atlassian.net/wiki/spaces/DUMMY/pages/65611"},
+			},
+			wantErr: true,
+			Err:     model.ErrNoRemoteLinkAppLinkID,
+		},
+
+		{
+			name:   "when the page id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				page:         &model.RemoteLinkConfluencePageScheme{AppLinkID: "4b6b5a2e-2a3c-3b36-9e0b-1e0e4a1a7f7c", URL: "https://ctreminiom.atlassian.net/wiki/spaces/DUMMY/pages/65611"},
+			},
+			wantErr: true,
+			Err:     model.ErrNoRemoteLinkPageID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-1",
+				page: &model.RemoteLinkConfluencePageScheme{
+					AppLinkID: "4b6b5a2e-2a3c-3b36-9e0b-1e0e4a1a7f7c",
+					PageID:    "65611",
+					URL:       "https://ctreminiom.atlassian.net/wiki/spaces/DUMMY/pages/65611",
+					Title:     "Release notes",
+				},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/DUMMY-1/remotelink",
+					"",
+					&model.RemoteLinkScheme{
+						GlobalID:     "appId=4b6b5a2e-2a3c-3b36-9e0b-1e0e4a1a7f7c&pageId=65611",
+						Application:  &model.RemoteLinkApplicationScheme{Type: "com.atlassian.confluence", Name: "Confluence"},
+						Relationship: "Wiki Page",
+						Object:       &model.RemoteLinkObjectScheme{URL: "https://ctreminiom.atlassian.net/wiki/spaces/DUMMY/pages/65611", Title: "Release notes"},
+					}).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			remoteLinkService, err := NewRemoteLinkService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := remoteLinkService.LinkConfluencePage(testCase.args.ctx, testCase.args.issueKeyOrID, testCase.args.page)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+			}
+
+		})
+	}
+}
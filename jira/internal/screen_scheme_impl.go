@@ -119,6 +119,10 @@ func (i *internalScreenSchemeImpl) Gets(ctx context.Context, options *model.Scre
 
 func (i *internalScreenSchemeImpl) Create(ctx context.Context, payload *model.ScreenSchemePayloadScheme) (*model.ScreenSchemeScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/screenscheme", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -141,6 +145,10 @@ func (i *internalScreenSchemeImpl) Update(ctx context.Context, screenSchemeID st
 		return nil, model.ErrNoScreenSchemeID
 	}
 
+	if payload == nil {
+		return nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/screenscheme/%v", i.version, screenSchemeID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -1,10 +1,19 @@
 package internal
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/collect"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 )
 
+// searchWalkPageSize is the number of issues fetched per page by Walk.
+const searchWalkPageSize = 100
+
 // NewSearchService creates a new instance of SearchADFService and SearchRichTextService.
 func NewSearchService(client service.Connector, version string) (*SearchADFService, *SearchRichTextService, error) {
 
@@ -28,3 +37,98 @@ func NewSearchService(client service.Connector, version string) (*SearchADFServi
 
 	return adfService, rtService, nil
 }
+
+// walkJQLStream walks the issues matching the JQL query, streaming the issues of every page to visit as they're
+// decoded, so a single issue is kept in memory at a time. The response of the last page is returned.
+func walkJQLStream[T any](ctx context.Context, client service.Connector, streamer service.StreamConnector, version, jql string, fields, expands []string, visit func(issue *T) error) (*model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/search/jql", version)
+
+	for cursor := ""; ; {
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		payload := map[string]interface{}{"jql": jql, "maxResults": searchWalkPageSize}
+
+		if len(fields) != 0 {
+			payload["fields"] = fields
+		}
+
+		if len(expands) != 0 {
+			payload["expand"] = expands
+		}
+
+		if cursor != "" {
+			payload["nextPageToken"] = cursor
+		}
+
+		request, err := client.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := streamer.CallStream(request, "issues", func(element json.RawMessage) error {
+
+			issue := new(T)
+			if err := json.Unmarshal(element, issue); err != nil {
+				return err
+			}
+
+			return visit(issue)
+		})
+		if err != nil {
+			return response, err
+		}
+
+		page := new(struct {
+			NextPageToken string `json:"nextPageToken"`
+		})
+
+		if err = json.Unmarshal(response.Bytes.Bytes(), page); err != nil {
+			return response, err
+		}
+
+		if page.NextPageToken == "" || page.NextPageToken == cursor {
+			return response, nil
+		}
+
+		cursor = page.NextPageToken
+	}
+}
+
+// walkJQLIssues walks the IDs and the keys of the issues matching the JQL query with the shared cursor walker, streaming
+// the pages when the client supports it. The response of the last page is returned.
+func walkJQLIssues(ctx context.Context, client service.Connector, version, jql string, visit func(issue *model.IssueScheme) error) (*model.ResponseScheme, error) {
+
+	fields := []string{"id"}
+
+	if streamer, ok := client.(service.StreamConnector); ok {
+		return walkJQLStream(ctx, client, streamer, version, jql, fields, nil, visit)
+	}
+
+	var response *model.ResponseScheme
+	err := collect.WalkCursor(ctx, func(ctx context.Context, cursor string) ([]*model.IssueScheme, string, error) {
+
+		payload := map[string]interface{}{"jql": jql, "fields": fields, "maxResults": searchWalkPageSize}
+		if cursor != "" {
+			payload["nextPageToken"] = cursor
+		}
+
+		request, err := client.NewRequest(ctx, http.MethodPost, fmt.Sprintf("rest/api/%v/search/jql", version), "", payload)
+		if err != nil {
+			return nil, "", err
+		}
+
+		page := new(model.IssueSearchJQLScheme)
+		response, err = client.Call(request, page)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return page.Issues, page.NextPageToken, nil
+	}, visit)
+
+	return response, err
+}
@@ -8,6 +8,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/collect"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
@@ -66,11 +67,26 @@ func (s *SearchADFService) ApproximateCount(ctx context.Context, jql string) (*m
 
 // BulkFetch fetches multiple issues by their IDs or keys
 //
+// The bulk fetch is limited to 100 issues, use Issue.BulkFetch to select the expansions and the properties too.
+//
 // POST /rest/api/3/issue/bulkfetch
 func (s *SearchADFService) BulkFetch(ctx context.Context, issueIDsOrKeys []string, fields []string) (*model.IssueBulkFetchScheme, *model.ResponseScheme, error) {
 	return s.internalClient.BulkFetch(ctx, issueIDsOrKeys, fields)
 }
 
+// Walk searches the issues matching the JQL query and calls visit for every issue, one page after another.
+//
+// The issues are streamed instead of being gathered, so a single page is kept in memory, or a single issue
+// when the client implements service.StreamConnector, e.g. the Jira clients. The first error returned by visit
+// stops the walk and it's returned.
+//
+// POST /rest/api/3/search/jql
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues/search#walk-issues
+func (s *SearchADFService) Walk(ctx context.Context, jql string, fields, expands []string, visit func(issue *model.IssueScheme) error) error {
+	return s.internalClient.Walk(ctx, jql, fields, expands, visit)
+}
+
 type internalSearchADFImpl struct {
 	c       service.Connector
 	version string
@@ -231,29 +247,39 @@ func (i *internalSearchADFImpl) ApproximateCount(ctx context.Context, jql string
 
 // BulkFetch fetches multiple issues by their IDs or keys
 //
+// The bulk fetch is limited to 100 issues, use Issue.BulkFetch to select the expansions and the properties too.
+//
 // POST /rest/api/3/issue/bulkfetch
 func (i *internalSearchADFImpl) BulkFetch(ctx context.Context, issueIDsOrKeys []string, fields []string) (*model.IssueBulkFetchScheme, *model.ResponseScheme, error) {
 
-	payload := struct {
-		IssueIDsOrKeys []string `json:"issueIdsOrKeys,omitempty"`
-		Fields         []string `json:"fields,omitempty"`
-	}{
-		IssueIDsOrKeys: issueIDsOrKeys,
+	payload := &model.IssueBulkFetchPayloadScheme{
+		IssueIdsOrKeys: issueIDsOrKeys,
 		Fields:         fields,
 	}
 
-	endpoint := fmt.Sprintf("rest/api/%v/issue/bulkfetch", i.version)
-
-	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
-	if err != nil {
-		return nil, nil, err
-	}
-
 	issues := new(model.IssueBulkFetchScheme)
-	response, err := i.c.Call(request, issues)
+	response, err := bulkFetchIssues(ctx, i.c, i.version, payload, issues)
 	if err != nil {
 		return nil, response, err
 	}
 
 	return issues, response, nil
 }
+
+func (i *internalSearchADFImpl) Walk(ctx context.Context, jql string, fields, expands []string, visit func(issue *model.IssueScheme) error) error {
+
+	if streamer, ok := i.c.(service.StreamConnector); ok {
+		_, err := walkJQLStream(ctx, i.c, streamer, i.version, jql, fields, expands, visit)
+		return err
+	}
+
+	return collect.WalkCursor(ctx, func(ctx context.Context, cursor string) ([]*model.IssueScheme, string, error) {
+
+		page, _, err := i.SearchJQL(ctx, jql, fields, expands, searchWalkPageSize, cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return page.Issues, page.NextPageToken, nil
+	}, visit)
+}
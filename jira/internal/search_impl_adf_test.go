@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/testutil"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 )
@@ -780,11 +781,8 @@ func Test_internalSearchADFImpl_BulkFetch(t *testing.T) {
 
 				client := mocks.NewConnector(t)
 
-				payload := struct {
-					IssueIDsOrKeys []string `json:"issueIdsOrKeys,omitempty"`
-					Fields         []string `json:"fields,omitempty"`
-				}{
-					IssueIDsOrKeys: []string{"FOO-1", "10067", "BAR-1"},
+				payload := &model.IssueBulkFetchPayloadScheme{
+					IssueIdsOrKeys: []string{"FOO-1", "10067", "BAR-1"},
 					Fields:         []string{"summary", "status", "priority"},
 				}
 
@@ -818,11 +816,8 @@ func Test_internalSearchADFImpl_BulkFetch(t *testing.T) {
 
 				client := mocks.NewConnector(t)
 
-				payload := struct {
-					IssueIDsOrKeys []string `json:"issueIdsOrKeys,omitempty"`
-					Fields         []string `json:"fields,omitempty"`
-				}{
-					IssueIDsOrKeys: []string{"FOO-1", "10067", "BAR-1"},
+				payload := &model.IssueBulkFetchPayloadScheme{
+					IssueIdsOrKeys: []string{"FOO-1", "10067", "BAR-1"},
 					Fields:         []string{"summary", "status", "priority"},
 				}
 
@@ -838,6 +833,27 @@ func Test_internalSearchADFImpl_BulkFetch(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error"),
 		},
+
+		{
+			name:   "when the issues are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the issues exceed the bulk fetch limit",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:            context.Background(),
+				issueIDsOrKeys: make([]string, 101),
+			},
+			wantErr: true,
+			Err:     model.ErrBulkFetchLimitExceeded,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -876,3 +892,42 @@ func Test_internalSearchADFImpl_BulkFetch(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalSearchADFImpl_Walk(t *testing.T) {
+
+	recorder := testutil.NewRecorder()
+	recorder.Respond(`{"issues":[{"key":"KP-1"},{"key":"KP-2"}],"nextPageToken":"CAEaAggD"}`)
+	recorder.Respond(`{"issues":[{"key":"KP-3"}]}`)
+
+	searchService, _, err := NewSearchService(recorder, "3")
+	assert.NoError(t, err)
+
+	var keys []string
+	err = searchService.Walk(context.Background(), "project = KP", []string{"summary"}, nil, func(issue *model.IssueScheme) error {
+		keys = append(keys, issue.Key)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"KP-1", "KP-2", "KP-3"}, keys)
+
+	testutil.ExpectRequest(t, recorder, testutil.Request{
+		Method: http.MethodPost,
+		Path:   "rest/api/3/search/jql",
+		Body:   `{"jql":"project = KP","maxResults":100,"fields":["summary"]}`,
+	})
+
+	testutil.ExpectRequest(t, recorder, testutil.Request{
+		Method: http.MethodPost,
+		Path:   "rest/api/3/search/jql",
+		Body:   `{"jql":"project = KP","maxResults":100,"fields":["summary"],"nextPageToken":"CAEaAggD"}`,
+	})
+
+	recorder.Respond(`{"issues":[{"key":"KP-1"}],"nextPageToken":"CAEaAggD"}`)
+
+	err = searchService.Walk(context.Background(), "project = KP", nil, nil, func(issue *model.IssueScheme) error {
+		return errors.New("error, unable to export the issue")
+	})
+
+	assert.EqualError(t, err, "error, unable to export the issue")
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
 	"net/http"
+	"strings"
 )
 
 // NewServerService creates a new instance of ServerService.
@@ -36,6 +37,16 @@ func (s *ServerService) Info(ctx context.Context) (*model.ServerInformationSchem
 	return s.internalClient.Info(ctx)
 }
 
+// DeploymentType returns the deployment type of the Jira instance reported by the server information,
+// model.DeploymentTypeCloud or model.DeploymentTypeServer for the Server and Data Center instances.
+//
+// GET /rest/api/{2-3}/serverInfo
+//
+// https://docs.go-atlassian.io/jira-software-cloud/server#get-jira-instance-info
+func (s *ServerService) DeploymentType(ctx context.Context) (string, *model.ResponseScheme, error) {
+	return s.internalClient.DeploymentType(ctx)
+}
+
 type internalServerServiceImpl struct {
 	c       service.Connector
 	version string
@@ -58,3 +69,17 @@ func (i *internalServerServiceImpl) Info(ctx context.Context) (*model.ServerInfo
 
 	return server, response, nil
 }
+
+func (i *internalServerServiceImpl) DeploymentType(ctx context.Context) (string, *model.ResponseScheme, error) {
+
+	info, response, err := i.Info(ctx)
+	if err != nil {
+		return "", response, err
+	}
+
+	if strings.EqualFold(info.DeploymentType, model.DeploymentTypeCloud) {
+		return model.DeploymentTypeCloud, response, nil
+	}
+
+	return model.DeploymentTypeServer, response, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"net/http"
 	"testing"
 )
@@ -141,6 +142,72 @@ func Test_internalServerServiceImpl_Info(t *testing.T) {
 	}
 }
 
+func Test_internalServerServiceImpl_DeploymentType(t *testing.T) {
+
+	testCases := []struct {
+		name       string
+		deployment string
+		err        error
+		want       string
+		wantErr    bool
+	}{
+		{
+			name:       "when the instance is a cloud instance",
+			deployment: "Cloud",
+			want:       model.DeploymentTypeCloud,
+		},
+
+		{
+			name:       "when the instance is a data center instance",
+			deployment: "Server",
+			want:       model.DeploymentTypeServer,
+		},
+
+		{
+			name:    "when the server information cannot be fetched",
+			err:     errors.New("error, unable to execute the http call"),
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			client := mocks.NewConnector(t)
+
+			client.On("NewRequest",
+				context.Background(),
+				http.MethodGet,
+				"rest/api/3/serverInfo",
+				"",
+				nil).
+				Return(&http.Request{}, nil)
+
+			client.On("Call",
+				&http.Request{},
+				&model.ServerInformationScheme{}).
+				Run(func(args mock.Arguments) {
+					args.Get(1).(*model.ServerInformationScheme).DeploymentType = testCase.deployment
+				}).
+				Return(&model.ResponseScheme{}, testCase.err)
+
+			newService, err := NewServerService(client, "3")
+			assert.NoError(t, err)
+
+			got, gotResponse, err := newService.DeploymentType(context.Background())
+
+			if testCase.wantErr {
+				assert.EqualError(t, err, testCase.err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, gotResponse)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+}
+
 func Test_NewServerService(t *testing.T) {
 
 	type args struct {
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/task"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
 )
@@ -52,6 +53,17 @@ func (t *TaskService) Cancel(ctx context.Context, taskID string) (*model.Respons
 	return t.internalClient.Cancel(ctx, taskID)
 }
 
+// Await polls a task until it finishes, e.g. the tasks of the project asynchronous deletion or the issue archive export.
+//
+// The failed, canceled or dead tasks return an error wrapping model.ErrTaskFailed, see task.Await for the polling.
+//
+// GET /rest/api/{2-3}/task/{taskID}
+//
+// https://docs.go-atlassian.io/jira-software-cloud/tasks#get-task
+func (t *TaskService) Await(ctx context.Context, taskID string, options ...task.Option) (*model.TaskScheme, error) {
+	return task.Await(ctx, task.JiraTask(t, taskID), options...)
+}
+
 type internalTaskServiceImpl struct {
 	c       service.Connector
 	version string
@@ -72,6 +72,10 @@ func (i *internalTeamServiceImpl) Gets(ctx context.Context, maxResults int) (*mo
 
 func (i *internalTeamServiceImpl) Create(ctx context.Context, payload *model.JiraTeamCreatePayloadScheme) (*model.JiraTeamCreateResponseScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := "rest/teams/1.0/teams/create"
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -250,6 +250,18 @@ func Test_internalTeamServiceImpl_Create(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to execute the http transition"),
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
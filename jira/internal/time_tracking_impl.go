@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+// NewTimeTrackingService creates a new instance of TimeTrackingService.
+func NewTimeTrackingService(client service.Connector, version string) (*TimeTrackingService, error) {
+
+	if version == "" {
+		return nil, model.ErrNoVersionProvided
+	}
+
+	return &TimeTrackingService{
+		internalClient: &internalTimeTrackingImpl{c: client, version: version},
+	}, nil
+}
+
+// TimeTrackingService provides methods to manage the time tracking provider and settings in Jira.
+type TimeTrackingService struct {
+	// internalClient is the connector interface for time tracking operations.
+	internalClient jira.TimeTrackingConnector
+}
+
+// Get returns the time tracking provider that is currently selected.
+//
+// Note that if time tracking is disabled, then a successful but empty response is returned.
+//
+// GET /rest/api/{2-3}/configuration/timetracking
+//
+// https://docs.go-atlassian.io/jira-software-cloud/time-tracking#get-selected-time-tracking-provider
+func (t *TimeTrackingService) Get(ctx context.Context) (*model.TimeTrackingProviderScheme, *model.ResponseScheme, error) {
+	return t.internalClient.Get(ctx)
+}
+
+// GetConfiguration returns the time tracking settings.
+//
+// This includes settings such as the working hours per day, the working days per week and the default unit.
+//
+// GET /rest/api/{2-3}/configuration/timetracking/options
+//
+// https://docs.go-atlassian.io/jira-software-cloud/time-tracking#get-time-tracking-settings
+func (t *TimeTrackingService) GetConfiguration(ctx context.Context) (*model.TimeTrackingConfigurationScheme, *model.ResponseScheme, error) {
+	return t.internalClient.GetConfiguration(ctx)
+}
+
+// SetConfiguration sets the time tracking settings.
+//
+// PUT /rest/api/{2-3}/configuration/timetracking/options
+//
+// https://docs.go-atlassian.io/jira-software-cloud/time-tracking#set-time-tracking-settings
+func (t *TimeTrackingService) SetConfiguration(ctx context.Context, payload *model.TimeTrackingConfigurationScheme) (*model.TimeTrackingConfigurationScheme, *model.ResponseScheme, error) {
+	return t.internalClient.SetConfiguration(ctx, payload)
+}
+
+type internalTimeTrackingImpl struct {
+	c       service.Connector
+	version string
+}
+
+func (i *internalTimeTrackingImpl) Get(ctx context.Context) (*model.TimeTrackingProviderScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/configuration/timetracking", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	provider := new(model.TimeTrackingProviderScheme)
+	response, err := i.c.Call(request, provider)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return provider, response, nil
+}
+
+func (i *internalTimeTrackingImpl) GetConfiguration(ctx context.Context) (*model.TimeTrackingConfigurationScheme, *model.ResponseScheme, error) {
+
+	endpoint := fmt.Sprintf("rest/api/%v/configuration/timetracking/options", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configuration := new(model.TimeTrackingConfigurationScheme)
+	response, err := i.c.Call(request, configuration)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return configuration, response, nil
+}
+
+func (i *internalTimeTrackingImpl) SetConfiguration(ctx context.Context, payload *model.TimeTrackingConfigurationScheme) (*model.TimeTrackingConfigurationScheme, *model.ResponseScheme, error) {
+
+	if payload == nil {
+		return nil, nil, model.ErrNoTimeTrackingConfiguration
+	}
+
+	endpoint := fmt.Sprintf("rest/api/%v/configuration/timetracking/options", i.version)
+
+	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	configuration := new(model.TimeTrackingConfigurationScheme)
+	response, err := i.c.Call(request, configuration)
+	if err != nil {
+		return nil, response, err
+	}
+
+	return configuration, response, nil
+}
@@ -119,6 +119,10 @@ func (i *internalTypeImpl) Gets(ctx context.Context) ([]*model.IssueTypeScheme,
 
 func (i *internalTypeImpl) Create(ctx context.Context, payload *model.IssueTypePayloadScheme) (*model.IssueTypeScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/issuetype", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -163,6 +167,10 @@ func (i *internalTypeImpl) Update(ctx context.Context, issueTypeID string, paylo
 		return nil, nil, model.ErrNoIssueTypeID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/issuetype/%v", i.version, issueTypeID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -166,6 +166,10 @@ func (i *internalTypeSchemeImpl) Gets(ctx context.Context, issueTypeSchemeIDs []
 
 func (i *internalTypeSchemeImpl) Create(ctx context.Context, payload *model.IssueTypeSchemePayloadScheme) (*model.NewIssueTypeSchemeScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescheme", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -265,6 +269,10 @@ func (i *internalTypeSchemeImpl) Update(ctx context.Context, issueTypeSchemeID i
 		return nil, model.ErrNoIssueTypeSchemeID
 	}
 
+	if payload == nil {
+		return nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/issuetypescheme/%v", i.version, issueTypeSchemeID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
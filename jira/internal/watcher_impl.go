@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/fanout"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
 	"github.com/ctreminiom/go-atlassian/v2/service/jira"
@@ -58,6 +59,36 @@ func (w *WatcherService) Delete(ctx context.Context, issueKeyOrID, accountID str
 	return w.internalClient.Delete(ctx, issueKeyOrID, accountID)
 }
 
+// AddByJQL adds a user as a watcher of every issue matching a JQL query.
+//
+// The issues are searched using the token based pagination and the watcher is added with a bounded concurrency,
+// the requests rejected by the rate limiter are retried by the HTTP client, e.g. transport.WithRetry.
+//
+// The failed issues don't stop the operation, their errors are returned on the result keyed by the issue key.
+//
+// POST /rest/api/{2-3}/search/jql
+//
+// POST /rest/api/{2-3}/issue/{issueKeyOrID}/watchers
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues/watcher#add-watcher-by-jql
+func (w *WatcherService) AddByJQL(ctx context.Context, jql, accountID string) (*model.IssueWatcherBulkResultScheme, *model.ResponseScheme, error) {
+	return w.internalClient.AddByJQL(ctx, jql, accountID)
+}
+
+// GetBulk returns, for every issue, if the current user is watching it.
+//
+// The bulk endpoint only reports the watching status of the current user, it doesn't return the watchers of the issues.
+// The watcher lists are fetched per issue with Gets, e.g. with fanout.Map to bound the concurrent requests.
+//
+// The issue IDs are sent in chunks of 1000 issues, the maximum allowed by the endpoint, and the results are merged.
+//
+// POST /rest/api/{2-3}/issue/watching
+//
+// https://docs.go-atlassian.io/jira-software-cloud/issues/watcher#get-is-watching-issue-bulk
+func (w *WatcherService) GetBulk(ctx context.Context, issueIDs []string) (*model.BulkIssueWatchersScheme, *model.ResponseScheme, error) {
+	return w.internalClient.GetBulk(ctx, issueIDs)
+}
+
 type internalWatcherImpl struct {
 	c       service.Connector
 	version string
@@ -127,3 +158,81 @@ func (i *internalWatcherImpl) Delete(ctx context.Context, issueKeyOrID, accountI
 
 	return i.c.Call(request, nil)
 }
+
+// watcherBulkWorkers is the number of watchers added concurrently by AddByJQL.
+const watcherBulkWorkers = 5
+
+func (i *internalWatcherImpl) AddByJQL(ctx context.Context, jql, accountID string) (*model.IssueWatcherBulkResultScheme, *model.ResponseScheme, error) {
+
+	if jql == "" {
+		return nil, nil, model.ErrNoJQL
+	}
+
+	if accountID == "" {
+		return nil, nil, model.ErrNoAccountID
+	}
+
+	var issueKeys []string
+	response, err := walkJQLIssues(ctx, i.c, i.version, jql, func(issue *model.IssueScheme) error {
+		issueKeys = append(issueKeys, issue.Key)
+		return nil
+	})
+	if err != nil {
+		return nil, response, err
+	}
+
+	result := &model.IssueWatcherBulkResultScheme{Total: len(issueKeys), Errors: make(map[string]error)}
+
+	errs := fanout.Map(issueKeys, watcherBulkWorkers, func(issueKey string) error {
+		_, err := i.Add(ctx, issueKey, accountID)
+		return err
+	})
+
+	for index, err := range errs {
+
+		if err != nil {
+			result.Failed++
+			result.Errors[issueKeys[index]] = err
+		} else {
+			result.Succeeded++
+		}
+	}
+
+	return result, response, nil
+}
+
+// watcherBulkMaxIssues is the maximum number of issues accepted per request by the bulk watching endpoint.
+const watcherBulkMaxIssues = 1000
+
+func (i *internalWatcherImpl) GetBulk(ctx context.Context, issueIDs []string) (*model.BulkIssueWatchersScheme, *model.ResponseScheme, error) {
+
+	if len(issueIDs) == 0 {
+		return nil, nil, model.ErrNoIssueKeyOrID
+	}
+
+	result := &model.BulkIssueWatchersScheme{IssuesIsWatching: make(map[string]bool, len(issueIDs))}
+	endpoint := fmt.Sprintf("rest/api/%v/issue/watching", i.version)
+
+	var response *model.ResponseScheme
+	for start := 0; start < len(issueIDs); start += watcherBulkMaxIssues {
+
+		payload := map[string]interface{}{"issueIds": issueIDs[start:min(start+watcherBulkMaxIssues, len(issueIDs))]}
+
+		request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		chunk := new(model.BulkIssueWatchersScheme)
+		response, err = i.c.Call(request, chunk)
+		if err != nil {
+			return nil, response, err
+		}
+
+		for issueID, watching := range chunk.IssuesIsWatching {
+			result.IssuesIsWatching[issueID] = watching
+		}
+	}
+
+	return result, response, nil
+}
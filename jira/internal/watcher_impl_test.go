@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
 	"github.com/ctreminiom/go-atlassian/v2/service"
@@ -590,3 +592,482 @@ func Test_NewWatcherService(t *testing.T) {
 		})
 	}
 }
+
+func Test_internalWatcherImpl_AddByJQL(t *testing.T) {
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx       context.Context
+		jql       string
+		accountID string
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    *model.IssueWatcherBulkResultScheme
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the parameters are correct",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				jql:       "project = DUMMY",
+				accountID: "account-id-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/search/jql",
+					"",
+					map[string]interface{}{"jql": "project = DUMMY", "fields": []string{"id"}, "maxResults": 100}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSearchJQLScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.IssueSearchJQLScheme).Issues = []*model.IssueScheme{{Key: "DUMMY-1"}, {Key: "DUMMY-2"}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/DUMMY-1/watchers",
+					"",
+					"account-id-sample").
+					Return(&http.Request{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/DUMMY-2/watchers",
+					"",
+					"account-id-sample").
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+		},
+
+		{
+			name:   "when the watcher request fails",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				jql:       "project = DUMMY",
+				accountID: "account-id-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/search/jql",
+					"",
+					map[string]interface{}{"jql": "project = DUMMY", "fields": []string{"id"}, "maxResults": 100}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.IssueSearchJQLScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.IssueSearchJQLScheme).Issues = []*model.IssueScheme{{Key: "DUMMY-1"}}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/DUMMY-1/watchers",
+					"",
+					"account-id-sample").
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{Code: http.StatusTooManyRequests}, model.ErrInvalidStatusCode)
+
+				fields.c = client
+			},
+			want: &model.IssueWatcherBulkResultScheme{
+				Total:  1,
+				Failed: 1,
+				Errors: map[string]error{"DUMMY-1": model.ErrInvalidStatusCode},
+			},
+		},
+
+		{
+			name:   "when the next page token is repeated",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				jql:       "project = DUMMY",
+				accountID: "account-id-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/search/jql",
+					"",
+					map[string]interface{}{"jql": "project = DUMMY", "fields": []string{"id"}, "maxResults": 100}).
+					Return(&http.Request{RequestURI: "page-1"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "page-1"},
+					&model.IssueSearchJQLScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.IssueSearchJQLScheme).Issues = []*model.IssueScheme{{Key: "DUMMY-1"}}
+						args.Get(1).(*model.IssueSearchJQLScheme).NextPageToken = "token-1"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/search/jql",
+					"",
+					map[string]interface{}{"jql": "project = DUMMY", "fields": []string{"id"}, "maxResults": 100, "nextPageToken": "token-1"}).
+					Return(&http.Request{RequestURI: "page-2"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "page-2"},
+					&model.IssueSearchJQLScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.IssueSearchJQLScheme).Issues = []*model.IssueScheme{{Key: "DUMMY-2"}}
+						args.Get(1).(*model.IssueSearchJQLScheme).NextPageToken = "token-1"
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/DUMMY-1/watchers",
+					"",
+					"account-id-sample").
+					Return(&http.Request{}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/DUMMY-2/watchers",
+					"",
+					"account-id-sample").
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					nil).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: &model.IssueWatcherBulkResultScheme{
+				Total:     2,
+				Succeeded: 2,
+				Errors:    map[string]error{},
+			},
+		},
+
+		{
+			name:   "when the context is canceled",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       canceled,
+				jql:       "project = DUMMY",
+				accountID: "account-id-sample",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     context.Canceled,
+		},
+
+		{
+			name:   "when the jql is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				jql:       "",
+				accountID: "account-id-sample",
+			},
+			wantErr: true,
+			Err:     model.ErrNoJQL,
+		},
+
+		{
+			name:   "when the account id is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				jql:       "project = DUMMY",
+				accountID: "",
+			},
+			wantErr: true,
+			Err:     model.ErrNoAccountID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:       context.Background(),
+				jql:       "project = DUMMY",
+				accountID: "account-id-sample",
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/search/jql",
+					"",
+					map[string]interface{}{"jql": "project = DUMMY", "fields": []string{"id"}, "maxResults": 100}).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			newService, err := NewWatcherService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := newService.AddByJQL(testCase.args.ctx, testCase.args.jql, testCase.args.accountID)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.NotEqual(t, gotResult, nil)
+
+				if testCase.want != nil {
+					assert.Equal(t, testCase.want, gotResult)
+				} else {
+					assert.Equal(t, gotResult.Total, gotResult.Succeeded)
+				}
+			}
+
+		})
+	}
+}
+
+func Test_internalWatcherImpl_GetBulk(t *testing.T) {
+
+	issueIDs := make([]string, 1001)
+	for index := range issueIDs {
+		issueIDs[index] = strconv.Itoa(10000 + index)
+	}
+
+	type fields struct {
+		c       service.Connector
+		version string
+	}
+
+	type args struct {
+		ctx      context.Context
+		issueIDs []string
+	}
+
+	testCases := []struct {
+		name    string
+		fields  fields
+		args    args
+		on      func(*fields)
+		want    map[string]bool
+		wantErr bool
+		Err     error
+	}{
+		{
+			name:   "when the api version is v3",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				issueIDs: []string{"10001", "10002"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/watching",
+					"",
+					map[string]interface{}{"issueIds": []string{"10001", "10002"}}).
+					Return(&http.Request{}, nil)
+
+				client.On("Call",
+					&http.Request{},
+					&model.BulkIssueWatchersScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.BulkIssueWatchersScheme).IssuesIsWatching = map[string]bool{"10001": true, "10002": false}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: map[string]bool{"10001": true, "10002": false},
+		},
+
+		{
+			name:   "when the issues exceed the endpoint limit",
+			fields: fields{version: "2"},
+			args: args{
+				ctx:      context.Background(),
+				issueIDs: issueIDs,
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/watching",
+					"",
+					map[string]interface{}{"issueIds": issueIDs[:1000]}).
+					Return(&http.Request{RequestURI: "first"}, nil)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/2/issue/watching",
+					"",
+					map[string]interface{}{"issueIds": issueIDs[1000:]}).
+					Return(&http.Request{RequestURI: "second"}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "first"},
+					&model.BulkIssueWatchersScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.BulkIssueWatchersScheme).IssuesIsWatching = map[string]bool{"10000": true}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				client.On("Call",
+					&http.Request{RequestURI: "second"},
+					&model.BulkIssueWatchersScheme{}).
+					Run(func(args mock.Arguments) {
+						args.Get(1).(*model.BulkIssueWatchersScheme).IssuesIsWatching = map[string]bool{"11000": true}
+					}).
+					Return(&model.ResponseScheme{}, nil)
+
+				fields.c = client
+			},
+			want: map[string]bool{"10000": true, "11000": true},
+		},
+
+		{
+			name:   "when the issue ids are not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx: context.Background(),
+			},
+			wantErr: true,
+			Err:     model.ErrNoIssueKeyOrID,
+		},
+
+		{
+			name:   "when the http request cannot be created",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:      context.Background(),
+				issueIDs: []string{"10001"},
+			},
+			on: func(fields *fields) {
+
+				client := mocks.NewConnector(t)
+
+				client.On("NewRequest",
+					context.Background(),
+					http.MethodPost,
+					"rest/api/3/issue/watching",
+					"",
+					map[string]interface{}{"issueIds": []string{"10001"}}).
+					Return(&http.Request{}, errors.New("error, unable to create the http request"))
+
+				fields.c = client
+			},
+			wantErr: true,
+			Err:     errors.New("error, unable to create the http request"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			if testCase.on != nil {
+				testCase.on(&testCase.fields)
+			}
+
+			watcherService, err := NewWatcherService(testCase.fields.c, testCase.fields.version)
+			assert.NoError(t, err)
+
+			gotResult, gotResponse, err := watcherService.GetBulk(testCase.args.ctx, testCase.args.issueIDs)
+
+			if testCase.wantErr {
+
+				if err != nil {
+					t.Logf("error returned: %v", err.Error())
+				}
+
+				assert.EqualError(t, err, testCase.Err.Error())
+
+			} else {
+
+				assert.NoError(t, err)
+				assert.NotEqual(t, gotResponse, nil)
+				assert.Equal(t, testCase.want, gotResult.IssuesIsWatching)
+			}
+		})
+	}
+}
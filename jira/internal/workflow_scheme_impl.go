@@ -140,6 +140,10 @@ func (i *internalWorkflowSchemeImpl) Gets(ctx context.Context, startAt, maxResul
 
 func (i *internalWorkflowSchemeImpl) Create(ctx context.Context, payload *model.WorkflowSchemePayloadScheme) (*model.WorkflowSchemeScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/workflowscheme", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -193,6 +197,10 @@ func (i *internalWorkflowSchemeImpl) Update(ctx context.Context, schemeID int, p
 		return nil, nil, model.ErrNoWorkflowSchemeID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/workflowscheme/%v", i.version, schemeID)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
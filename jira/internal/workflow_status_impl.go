@@ -181,6 +181,10 @@ func (i *internalWorkflowStatusImpl) Gets(ctx context.Context, ids, expand []str
 
 func (i *internalWorkflowStatusImpl) Update(ctx context.Context, payload *model.WorkflowStatusPayloadScheme) (*model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/api/%v/statuses", i.version)
 
 	request, err := i.c.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
@@ -193,6 +197,10 @@ func (i *internalWorkflowStatusImpl) Update(ctx context.Context, payload *model.
 
 func (i *internalWorkflowStatusImpl) Create(ctx context.Context, payload *model.WorkflowStatusPayloadScheme) ([]*model.WorkflowStatusDetailScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	if len(payload.Statuses) == 0 {
 		return nil, nil, model.ErrNoWorkflowStatuses
 	}
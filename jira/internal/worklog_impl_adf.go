@@ -55,6 +55,8 @@ func (w *WorklogADFService) Get(ctx context.Context, issueKeyOrID, worklogID str
 
 // Issue returns worklogs for an issue, starting from the oldest worklog or from the worklog started on or after a date and time.
 //
+// The after date is a UNIX timestamp in milliseconds, e.g. time.Time.UnixMilli(), 0 returns every worklog.
+//
 // Time tracking must be enabled in Jira, otherwise this operation returns an error.
 //
 // GET /rest/api/{2-3}/issue/{issueKeyOrID}/worklog
@@ -348,6 +350,10 @@ func (i *internalWorklogAdfImpl) Add(ctx context.Context, issueKeyOrID string, p
 		return nil, nil, model.ErrNoIssueKeyOrID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	var endpoint strings.Builder
 	endpoint.WriteString(fmt.Sprintf("rest/api/%v/issue/%v/worklog", i.version, issueKeyOrID))
 
@@ -401,6 +407,10 @@ func (i *internalWorklogAdfImpl) Update(ctx context.Context, issueKeyOrID, workl
 		return nil, nil, model.ErrNoWorklogID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	var endpoint strings.Builder
 	endpoint.WriteString(fmt.Sprintf("rest/api/%v/issue/%v/worklog/%v", i.version, issueKeyOrID, worklogID))
 
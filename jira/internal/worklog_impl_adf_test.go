@@ -1118,6 +1118,28 @@ func Test_internalWorklogAdfImpl_Add(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to create the http request"),
 		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-5",
+				options: &model.WorklogOptionsScheme{
+					Notify:               true,
+					AdjustEstimate:       "new",
+					NewEstimate:          "2d",
+					ReduceBy:             "manual",
+					OverrideEditableFlag: true,
+					Expand:               []string{"properties"},
+				},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1332,6 +1354,29 @@ func Test_internalWorklogAdfImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to create the http request"),
 		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-5",
+				worklogID:    "3933828822",
+				options: &model.WorklogOptionsScheme{
+					Notify:               true,
+					AdjustEstimate:       "new",
+					NewEstimate:          "2d",
+					ReduceBy:             "manual",
+					OverrideEditableFlag: true,
+					Expand:               []string{"properties"},
+				},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
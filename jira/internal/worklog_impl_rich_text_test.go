@@ -1075,6 +1075,28 @@ func Test_internalWorklogRichTextImpl_Add(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to create the http request"),
 		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-5",
+				options: &model.WorklogOptionsScheme{
+					Notify:               true,
+					AdjustEstimate:       "new",
+					NewEstimate:          "2d",
+					ReduceBy:             "manual",
+					OverrideEditableFlag: true,
+					Expand:               []string{"properties"},
+				},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -1274,6 +1296,29 @@ func Test_internalWorklogRichTextImpl_Update(t *testing.T) {
 			wantErr: true,
 			Err:     errors.New("error, unable to create the http request"),
 		},
+
+		{
+			name:   "when the payload is not provided",
+			fields: fields{version: "3"},
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-5",
+				worklogID:    "3933828822",
+				options: &model.WorklogOptionsScheme{
+					Notify:               true,
+					AdjustEstimate:       "new",
+					NewEstimate:          "2d",
+					ReduceBy:             "manual",
+					OverrideEditableFlag: true,
+					Expand:               []string{"properties"},
+				},
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
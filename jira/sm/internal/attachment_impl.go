@@ -83,6 +83,10 @@ func (i *internalServiceRequestAttachmentImpl) Create(ctx context.Context, issue
 		return nil, nil, model.ErrNoIssueKeyOrID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	if len(payload.TemporaryAttachmentIDs) == 0 {
 		return nil, nil, model.ErrNoAttachmentID
 	}
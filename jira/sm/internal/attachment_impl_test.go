@@ -294,6 +294,19 @@ func Test_internalServiceRequestAttachmentImpl_Create(t *testing.T) {
 			Err:     model.ErrNoIssueKeyOrID,
 			wantErr: true,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:          context.Background(),
+				issueKeyOrID: "DUMMY-2",
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
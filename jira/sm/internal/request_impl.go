@@ -153,6 +153,10 @@ type internalServiceRequestImpl struct {
 
 func (i *internalServiceRequestImpl) Create(ctx context.Context, payload *model.CreateCustomerRequestPayloadScheme) (*model.CustomerRequestScheme, *model.ResponseScheme, error) {
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := "rest/servicedeskapi/request"
 
 	req, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
@@ -1060,6 +1060,18 @@ func Test_internalServiceRequestImpl_Create(t *testing.T) {
 			Err:     errors.New("client: no http request created"),
 			wantErr: true,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx: context.Background(),
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -158,6 +158,10 @@ func (i *internalTypeImpl) Create(ctx context.Context, serviceDeskID int, payloa
 		return nil, nil, model.ErrNoServiceDeskID
 	}
 
+	if payload == nil {
+		return nil, nil, model.ErrNilPayload
+	}
+
 	endpoint := fmt.Sprintf("rest/servicedeskapi/servicedesk/%v/requesttype", serviceDeskID)
 
 	req, err := i.c.NewRequest(ctx, http.MethodPost, endpoint, "", payload)
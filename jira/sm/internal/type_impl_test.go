@@ -886,6 +886,19 @@ func Test_internalTypeImpl_Create(t *testing.T) {
 			Err:     model.ErrNoServiceDeskID,
 			wantErr: true,
 		},
+
+		{
+			name: "when the payload is not provided",
+			args: args{
+				ctx:           context.Background(),
+				serviceDeskID: 10001,
+			},
+			on: func(fields *fields) {
+				fields.c = mocks.NewConnector(t)
+			},
+			wantErr: true,
+			Err:     model.ErrNilPayload,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -9,9 +9,12 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 
 	"github.com/ctreminiom/go-atlassian/v2/jira/internal"
 	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/stream"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/transport"
 	"github.com/ctreminiom/go-atlassian/v2/service/common"
 )
 
@@ -22,6 +25,17 @@ const APIVersion = "2"
 // If a nil httpClient is provided, http.DefaultClient will be used.
 // If the site is empty, an error will be returned.
 func New(httpClient common.HTTPClient, site string) (*Client, error) {
+	return NewWithConfig(&transport.Config{HTTPClient: httpClient, Site: site})
+}
+
+// NewWithConfig creates a new instance of Client using the settings of the configuration.
+func NewWithConfig(config *transport.Config) (*Client, error) {
+
+	if config == nil {
+		return nil, models.ErrNoClientConfig
+	}
+
+	httpClient, site := config.HTTP(), config.Site
 
 	if httpClient == nil {
 		httpClient = http.DefaultClient
@@ -346,6 +360,21 @@ func New(httpClient common.HTTPClient, site string) (*Client, error) {
 		return nil, err
 	}
 
+	timeTracking, err := internal.NewTimeTrackingService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	avatar, err := internal.NewAvatarService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	entityProperty, err := internal.NewEntityPropertyService(client, APIVersion)
+	if err != nil {
+		return nil, err
+	}
+
 	userSearch, err := internal.NewUserSearchService(client, APIVersion)
 	if err != nil {
 		return nil, err
@@ -390,6 +419,9 @@ func New(httpClient common.HTTPClient, site string) (*Client, error) {
 	client.Project = project
 	client.Screen = screen
 	client.Server = server
+	client.TimeTracking = timeTracking
+	client.Avatar = avatar
+	client.Property = entityProperty
 	client.Task = task
 	client.User = user
 	client.Workflow = workflow
@@ -399,6 +431,8 @@ func New(httpClient common.HTTPClient, site string) (*Client, error) {
 
 	client.Archive = internal.NewIssueArchivalService(client, APIVersion)
 
+	config.Authenticate(client.Auth)
+
 	return client, nil
 }
 
@@ -420,6 +454,9 @@ type Client struct {
 	Screen             *internal.ScreenService
 	Task               *internal.TaskService
 	Server             *internal.ServerService
+	TimeTracking       *internal.TimeTrackingService
+	Avatar             *internal.AvatarService
+	Property           *internal.EntityPropertyService
 	User               *internal.UserService
 	Workflow           *internal.WorkflowService
 	JQL                *internal.JQLService
@@ -427,6 +464,10 @@ type Client struct {
 	Team               *internal.TeamService
 
 	Archive *internal.IssueArchivalService
+
+	// deploymentType caches the deployment type detected by DeploymentType.
+	deploymentType   string
+	deploymentTypeMu sync.Mutex
 }
 
 // NewRequest creates an API request.
@@ -459,6 +500,14 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 
 	req.Header.Set("Accept", "application/json")
 
+	if accept := models.AcceptFromContext(ctx); accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+
+	if requestID := models.RequestIDFromContext(ctx); requestID != "" {
+		req.Header.Set("X-Request-Id", requestID)
+	}
+
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
@@ -473,16 +522,91 @@ func (c *Client) NewRequest(ctx context.Context, method, urlStr, contentType str
 		req.SetBasicAuth(c.Auth.GetBasicAuth())
 	}
 
+	userAgent := transport.DefaultUserAgent
 	if c.Auth.HasUserAgent() {
-		req.Header.Set("User-Agent", c.Auth.GetUserAgent())
+		userAgent = c.Auth.GetUserAgent()
 	}
 
+	req.Header.Set("User-Agent", userAgent)
+
 	if c.Auth.GetBearerToken() != "" && !c.Auth.HasBasicAuth() {
 		req.Header.Add("Authorization", fmt.Sprintf("Bearer %v", c.Auth.GetBearerToken()))
 	}
 
+	if provider := c.Auth.GetAuthProvider(); provider != nil {
+		if err = provider.Apply(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
 	return req, nil
 }
+
+// Validate verifies the credentials before running long jobs, calling the current user endpoint.
+//
+// It returns the authenticated account, the deployment type and the scopes granted to the OAuth 2.0 tokens.
+// The deployment type is the one reported by the server information, cached by the client, and the scopes
+// are the ones listed by the X-OAuth-Scopes header, never the ones accepted by the endpoint.
+func (c *Client) Validate(ctx context.Context) (*models.ValidationResultScheme, error) {
+
+	result, _, err := c.MySelf.Validate(ctx)
+	return result, err
+}
+
+// DeploymentType returns the deployment type of the Jira instance, models.DeploymentTypeCloud or models.DeploymentTypeServer
+// for the Server and Data Center instances.
+//
+// The server information is requested once, the deployment type is cached for the lifetime of the client.
+func (c *Client) DeploymentType(ctx context.Context) (string, error) {
+
+	c.deploymentTypeMu.Lock()
+	defer c.deploymentTypeMu.Unlock()
+
+	if c.deploymentType != "" {
+		return c.deploymentType, nil
+	}
+
+	deploymentType, _, err := c.Server.DeploymentType(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	c.deploymentType = deploymentType
+
+	return c.deploymentType, nil
+}
+
+// Close releases the resources held by the client, e.g. the background workers of the transport options.
+//
+// The HTTP client is closed if it implements io.Closer, the client must not be used after Close.
+func (c *Client) Close() error {
+
+	if closer, ok := c.HTTP.(io.Closer); ok {
+		return closer.Close()
+	}
+
+	return nil
+}
+
+// With returns a client sharing the site, the credentials and the HTTP transport of the client, decorated with the
+// additional transport options, e.g. a longer timeout or an extra header:
+//
+//	uploads, err := instance.With(transport.WithTimeout(5 * time.Minute))
+//
+// The HTTP client isn't rebuilt, the options are applied on a shallow copy of the transport decorator, so the
+// connections are reused. Closing the returned client doesn't close the HTTP client of the original one.
+func (c *Client) With(options ...transport.Option) (*Client, error) {
+
+	derived, err := NewWithConfig(&transport.Config{Site: c.Site.String(), HTTPClient: transport.Derive(c.HTTP, options...)})
+	if err != nil {
+		return nil, err
+	}
+
+	derived.Site, derived.Auth = c.Site, c.Auth
+
+	return derived, nil
+}
+
 func (c *Client) Call(request *http.Request, structure interface{}) (*models.ResponseScheme, error) {
 
 	response, err := c.HTTP.Do(request)
@@ -490,7 +614,58 @@ func (c *Client) Call(request *http.Request, structure interface{}) (*models.Res
 		return nil, err
 	}
 
-	return c.processResponse(response, structure)
+	res, err := c.processResponse(response, structure)
+	if err != nil {
+		// The Atlassian request ID is appended, so it can be quoted to the Atlassian support.
+		return res, models.NewRequestError(res, err)
+	}
+
+	return res, nil
+}
+
+// CallStream executes the request and calls fn for every element of the JSON array response as it's decoded,
+// instead of decoding the whole response in memory.
+//
+// The field names the member of the response object holding the array, e.g. "issues" or "values", the empty field
+// streams a top-level array. The other members of the response object are kept on the response Bytes, so the
+// pagination fields can be decoded from it. The unsuccessful responses are handled as Call does.
+func (c *Client) CallStream(request *http.Request, field string, fn func(element json.RawMessage) error) (*models.ResponseScheme, error) {
+
+	response, err := c.HTTP.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		res, err := c.processResponse(response, nil)
+		return res, models.NewRequestError(res, err)
+	}
+
+	defer response.Body.Close()
+
+	res := &models.ResponseScheme{
+		Response: response,
+		Code:     response.StatusCode,
+		Endpoint: response.Request.URL.String(),
+		Method:   response.Request.Method,
+	}
+
+	envelope, err := stream.Array(response.Body, field, fn)
+	if err != nil {
+		return res, err
+	}
+
+	if envelope != nil {
+
+		envelopeAsBytes, err := json.Marshal(envelope)
+		if err != nil {
+			return res, err
+		}
+
+		res.Bytes.Write(envelopeAsBytes)
+	}
+
+	return res, nil
 }
 
 func (c *Client) processResponse(response *http.Response, structure interface{}) (*models.ResponseScheme, error) {
@@ -523,6 +698,12 @@ func (c *Client) processResponse(response *http.Response, structure interface{})
 		case http.StatusUnauthorized:
 			return res, models.ErrUnauthorized
 
+		case http.StatusForbidden:
+			return res, models.ErrForbidden
+
+		case http.StatusConflict:
+			return res, models.ErrConflict
+
 		case http.StatusInternalServerError:
 			return res, models.ErrInternal
 
@@ -534,7 +715,7 @@ func (c *Client) processResponse(response *http.Response, structure interface{})
 		}
 	}
 
-	if structure != nil {
+	if structure != nil && models.AcceptsJSON(response.Request.Header.Get("Accept")) {
 		if err = json.Unmarshal(responseAsBytes, &structure); err != nil {
 			return res, err
 		}
@@ -11,6 +11,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/ctreminiom/go-atlassian/v2/jira/internal"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
@@ -551,3 +552,31 @@ func TestNew(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_DeploymentType(t *testing.T) {
+
+	httpClient := mocks.NewHTTPClient(t)
+	httpClient.On("Do", mock.Anything).
+		Return(func(request *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"deploymentType":"Cloud","versionNumbers":[1001,0,0]}`)),
+				Request:    request,
+			}
+		}, nil).
+		Once()
+
+	instance, err := New(httpClient, "https://ctreminiom.atlassian.net")
+	assert.NoError(t, err)
+
+	deploymentType, err := instance.DeploymentType(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, model.DeploymentTypeCloud, deploymentType)
+
+	// The deployment type is cached, the server information is not requested again.
+	deploymentType, err = instance.DeploymentType(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, model.DeploymentTypeCloud, deploymentType)
+
+	httpClient.AssertNumberOfCalls(t, "Do", 1)
+}
@@ -3,17 +3,22 @@ package v3
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/ctreminiom/go-atlassian/v2/jira/internal"
 	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/testutil"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/transport"
 	"github.com/ctreminiom/go-atlassian/v2/service/common"
 	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
 )
@@ -389,6 +394,35 @@ func TestClient_NewRequest(t *testing.T) {
 	}
 }
 
+func TestClient_NewRequest_ReplayableBody(t *testing.T) {
+
+	siteAsURL, err := url.Parse("https://ctreminiom.atlassian.net")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Client{
+		HTTP: http.DefaultClient,
+		Auth: internal.NewAuthenticationService(nil),
+		Site: siteAsURL,
+	}
+
+	payload := map[string]interface{}{"fields": map[string]interface{}{"summary": "New summary"}}
+
+	request, err := c.NewRequest(context.Background(), http.MethodPost, "rest/api/3/issue", "", payload)
+	assert.NoError(t, err)
+	assert.True(t, transport.Replayable(request))
+
+	firstRead, err := io.ReadAll(request.Body)
+	assert.NoError(t, err)
+
+	assert.NoError(t, transport.RewindBody(request))
+
+	secondRead, err := io.ReadAll(request.Body)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(firstRead), string(secondRead))
+}
+
 func TestClient_processResponse(t *testing.T) {
 
 	expectedJSONResponse := `
@@ -551,3 +585,295 @@ func TestNew(t *testing.T) {
 		})
 	}
 }
+
+func TestNewWithConfig(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		config  *transport.Config
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the configuration is correct",
+			config: &transport.Config{
+				Site:      "https://ctreminiom.atlassian.net",
+				Mail:      "mail@example.com",
+				Token:     "token",
+				UserAgent: "go-atlassian",
+				Timeout:   10 * time.Second,
+			},
+		},
+
+		{
+			name:    "when the site url is not provided",
+			config:  &transport.Config{},
+			wantErr: true,
+			Err:     model.ErrNoSite,
+		},
+
+		{
+			name:    "when the configuration is not provided",
+			wantErr: true,
+			Err:     model.ErrNoClientConfig,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			gotClient, err := NewWithConfig(testCase.config)
+
+			if testCase.wantErr {
+				assert.EqualError(t, err, testCase.Err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+
+			mail, token := gotClient.Auth.GetBasicAuth()
+			assert.Equal(t, testCase.config.Mail, mail)
+			assert.Equal(t, testCase.config.Token, token)
+			assert.Equal(t, testCase.config.UserAgent, gotClient.Auth.GetUserAgent())
+			assert.Equal(t, testCase.config.Timeout, gotClient.HTTP.(*http.Client).Timeout)
+		})
+	}
+}
+
+func TestClient_Close(t *testing.T) {
+
+	instance, err := New(http.DefaultClient, "https://ctreminiom.atlassian.net")
+	assert.NoError(t, err)
+	assert.NoError(t, instance.Close())
+
+	instance, err = New(transport.New(mocks.NewHTTPClient(t)), "https://ctreminiom.atlassian.net")
+	assert.NoError(t, err)
+	assert.NoError(t, instance.Close())
+}
+
+func TestClient_RequestID(t *testing.T) {
+
+	httpClient := mocks.NewHTTPClient(t)
+	httpClient.On("Do", mock.Anything).
+		Return(&http.Response{
+			StatusCode: http.StatusNotFound,
+			Header:     http.Header{"X-Arequestid": []string{"7c6b2b2e"}},
+			Body:       io.NopCloser(strings.NewReader("{}")),
+			Request:    &http.Request{Method: http.MethodGet, URL: &url.URL{}},
+		}, nil)
+
+	instance, err := New(httpClient, "https://ctreminiom.atlassian.net")
+	assert.NoError(t, err)
+
+	request, err := instance.NewRequest(model.WithRequestID(context.Background(), "c2b2f8a4"), http.MethodGet, "rest/api/3/issue/KP-1", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "c2b2f8a4", request.Header.Get("X-Request-Id"))
+
+	response, err := instance.Call(request, nil)
+	assert.True(t, errors.Is(err, model.ErrNotFound))
+	assert.ErrorContains(t, err, "atlassian request id: 7c6b2b2e")
+	assert.Equal(t, "7c6b2b2e", response.RequestID())
+}
+
+func TestClient_StatusErrors(t *testing.T) {
+
+	testCases := []struct {
+		status int
+		Err    error
+	}{
+		{status: http.StatusBadRequest, Err: model.ErrBadRequest},
+		{status: http.StatusUnauthorized, Err: model.ErrUnauthorized},
+		{status: http.StatusForbidden, Err: model.ErrForbidden},
+		{status: http.StatusNotFound, Err: model.ErrNotFound},
+		{status: http.StatusConflict, Err: model.ErrConflict},
+		{status: http.StatusInternalServerError, Err: model.ErrInternal},
+		{status: http.StatusTeapot, Err: model.ErrInvalidStatusCode},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(http.StatusText(testCase.status), func(t *testing.T) {
+
+			httpClient := mocks.NewHTTPClient(t)
+			httpClient.On("Do", mock.Anything).
+				Return(&http.Response{
+					StatusCode: testCase.status,
+					Header:     http.Header{"X-Arequestid": []string{"7c6b2b2e"}},
+					Body:       io.NopCloser(strings.NewReader(`{"errorMessages":["detailed error"]}`)),
+					Request:    &http.Request{Method: http.MethodGet, URL: &url.URL{}},
+				}, nil)
+
+			instance, err := New(httpClient, "https://ctreminiom.atlassian.net")
+			assert.NoError(t, err)
+
+			request, err := instance.NewRequest(context.Background(), http.MethodGet, "rest/api/3/issue/KP-1", "", nil)
+			assert.NoError(t, err)
+
+			response, err := instance.Call(request, nil)
+			assert.True(t, errors.Is(err, testCase.Err))
+			assert.Equal(t, testCase.status, response.Code)
+			assert.Contains(t, response.Bytes.String(), "detailed error")
+		})
+	}
+}
+
+func TestClient_AuthProvider(t *testing.T) {
+
+	instance, err := NewWithConfig(&transport.Config{
+		Site:         "https://ctreminiom.atlassian.net",
+		HTTPClient:   mocks.NewHTTPClient(t),
+		Mail:         "mail@example.com",
+		Token:        "static-token",
+		AuthProvider: transport.NewBearerAuthProvider("rotated-token"),
+	})
+	assert.NoError(t, err)
+
+	request, err := instance.NewRequest(context.Background(), http.MethodGet, "rest/api/3/myself", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer rotated-token", request.Header.Get("Authorization"))
+
+	instance.Auth.SetAuthProvider(transport.AuthProviderFunc(func(ctx context.Context, request *http.Request) error {
+		return errors.New("error, unable to fetch the credentials")
+	}))
+
+	_, err = instance.NewRequest(context.Background(), http.MethodGet, "rest/api/3/myself", "", nil)
+	assert.EqualError(t, err, "error, unable to fetch the credentials")
+}
+
+func TestClient_Accept(t *testing.T) {
+
+	instance, err := New(mocks.NewHTTPClient(t), "https://ctreminiom.atlassian.net")
+	assert.NoError(t, err)
+
+	request, err := instance.NewRequest(context.Background(), http.MethodGet, "rest/api/3/issue/KP-1", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "application/json", request.Header.Get("Accept"))
+	assert.Equal(t, transport.DefaultUserAgent, request.Header.Get("User-Agent"))
+
+	request, err = instance.NewRequest(model.WithAccept(context.Background(), "application/xml"), http.MethodGet, "rest/api/3/issue/KP-1", "", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "application/xml", request.Header.Get("Accept"))
+
+	httpClient := mocks.NewHTTPClient(t)
+	httpClient.On("Do", request).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("<issue><key>KP-1</key></issue>")),
+			Request:    request,
+		}, nil)
+
+	instance.HTTP = httpClient
+
+	issue := new(model.IssueScheme)
+	response, err := instance.Call(request, issue)
+	assert.NoError(t, err)
+	assert.Empty(t, issue.Key)
+	assert.Equal(t, "<issue><key>KP-1</key></issue>", response.Bytes.String())
+}
+
+func TestClient_DeploymentType(t *testing.T) {
+
+	httpClient := mocks.NewHTTPClient(t)
+	httpClient.On("Do", mock.Anything).
+		Return(func(request *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"deploymentType":"Cloud","versionNumbers":[1001,0,0]}`)),
+				Request:    request,
+			}
+		}, nil).
+		Once()
+
+	instance, err := New(httpClient, "https://ctreminiom.atlassian.net")
+	assert.NoError(t, err)
+
+	deploymentType, err := instance.DeploymentType(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, model.DeploymentTypeCloud, deploymentType)
+
+	// The deployment type is cached, the server information is not requested again.
+	deploymentType, err = instance.DeploymentType(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, model.DeploymentTypeCloud, deploymentType)
+
+	httpClient.AssertNumberOfCalls(t, "Do", 1)
+}
+
+func TestClient_With(t *testing.T) {
+
+	httpClient := mocks.NewHTTPClient(t)
+	httpClient.On("Do", mock.Anything).
+		Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"accountId":"5b10ac8d82e05b22cc7d4ef5"}`)),
+			Request:    &http.Request{Method: http.MethodGet, URL: &url.URL{}},
+		}, nil)
+
+	instance, err := NewWithConfig(&transport.Config{
+		Site:        "https://ctreminiom.atlassian.net",
+		HTTPClient:  httpClient,
+		BearerToken: "token",
+	})
+	assert.NoError(t, err)
+
+	derived, err := instance.With(transport.WithHeader("X-Experimentalapi", "opt-in"))
+	assert.NoError(t, err)
+	assert.Same(t, instance.Site, derived.Site)
+	assert.Same(t, instance.Auth, derived.Auth)
+
+	_, _, err = derived.MySelf.Details(context.Background(), nil)
+	assert.NoError(t, err)
+
+	sent := httpClient.Calls[0].Arguments.Get(0).(*http.Request)
+	assert.Equal(t, "opt-in", sent.Header.Get("X-Experimentalapi"))
+	assert.Equal(t, "Bearer token", sent.Header.Get("Authorization"))
+	assert.Equal(t, "ctreminiom.atlassian.net", sent.URL.Host)
+}
+
+func TestClient_CallStream(t *testing.T) {
+
+	recorder := testutil.NewRecorder()
+	recorder.Respond(`{"issues":[{"key":"KP-1"},{"key":"KP-2"}],"nextPageToken":"token"}`)
+	recorder.Respond(`{"issues":[{"key":"KP-3"}]}`)
+
+	instance, err := New(recorder, "https://ctreminiom.atlassian.net")
+	assert.NoError(t, err)
+
+	var keys []string
+	err = instance.Issue.Search.Walk(context.Background(), "project = KP", []string{"summary"}, nil, func(issue *model.IssueScheme) error {
+		keys = append(keys, issue.Key)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"KP-1", "KP-2", "KP-3"}, keys)
+
+	testutil.ExpectRequest(t, recorder, testutil.Request{
+		Method: http.MethodPost,
+		Path:   "rest/api/3/search/jql",
+		Body:   `{"jql":"project = KP","fields":["summary"],"maxResults":100}`,
+	})
+
+	testutil.ExpectRequest(t, recorder, testutil.Request{
+		Method: http.MethodPost,
+		Path:   "rest/api/3/search/jql",
+		Body:   `{"jql":"project = KP","fields":["summary"],"maxResults":100,"nextPageToken":"token"}`,
+	})
+
+	httpClient := mocks.NewHTTPClient(t)
+	httpClient.On("Do", mock.Anything).
+		Return(&http.Response{
+			StatusCode: http.StatusBadRequest,
+			Body:       io.NopCloser(strings.NewReader(`{"errorMessages":["The JQL query is invalid."]}`)),
+			Request:    &http.Request{Method: http.MethodPost, URL: &url.URL{}},
+		}, nil)
+
+	instance.HTTP = httpClient
+
+	request, err := instance.NewRequest(context.Background(), http.MethodPost, "rest/api/3/search/jql", "", nil)
+	assert.NoError(t, err)
+
+	response, err := instance.CallStream(request, "issues", func(element json.RawMessage) error {
+		return errors.New("the elements of an unsuccessful response must not be streamed")
+	})
+	assert.True(t, errors.Is(err, model.ErrBadRequest))
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
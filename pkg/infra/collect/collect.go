@@ -0,0 +1,228 @@
+// Package collect provides helpers to gather every page of the offset paginated endpoints,
+// such as the ones accepting the startAt and maxResults parameters and returning the total of results.
+//
+//	projects, err := collect.All(ctx, func(ctx context.Context, startAt int) ([]*models.ProjectScheme, int, error) {
+//
+//		page, _, err := instance.Project.Search(ctx, options, startAt, 50)
+//		if err != nil {
+//			return nil, 0, err
+//		}
+//
+//		return page.Values, page.Total, nil
+//	})
+//
+// The endpoints returning a models.Page can be adapted with FromPage:
+//
+//	values, err := collect.All(ctx, collect.FromPage(func(ctx context.Context, startAt int) (*models.Page[*models.ProjectScheme], error) {
+//		return fetchProjects(ctx, startAt, 50)
+//	}))
+//
+// The huge result sets, e.g. the issues of a JQL search, can be streamed one result at a time with WalkPages
+// or WalkCursor instead, keeping a single page in memory.
+package collect
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// defaultConcurrency is the number of pages fetched at the same time when no concurrency is set.
+const defaultConcurrency = 4
+
+// Fetcher returns the page of results starting at the offset and the total of results available.
+type Fetcher[T any] func(ctx context.Context, startAt int) (results []T, total int, err error)
+
+// FromPage adapts a function returning a models.Page to a Fetcher.
+func FromPage[T any](fetch func(ctx context.Context, startAt int) (*models.Page[T], error)) Fetcher[T] {
+	return func(ctx context.Context, startAt int) ([]T, int, error) {
+
+		page, err := fetch(ctx, startAt)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if page == nil {
+			return nil, 0, nil
+		}
+
+		return page.Values, page.Total, nil
+	}
+}
+
+// Option configures the collector.
+type Option func(*config)
+
+type config struct {
+	concurrency int
+}
+
+// WithConcurrency sets the maximum number of pages fetched at the same time.
+func WithConcurrency(concurrency int) Option {
+	return func(c *config) {
+		if concurrency > 0 {
+			c.concurrency = concurrency
+		}
+	}
+}
+
+// All returns the results of every page.
+//
+// The first page is fetched to know the page size and the total of results, then the offsets of the remaining
+// pages are computed and fetched concurrently. The results keep the order of the pages.
+//
+// The first error cancels the context passed to the pending fetches and it's returned.
+func All[T any](ctx context.Context, fetch Fetcher[T], options ...Option) ([]T, error) {
+
+	settings := &config{concurrency: defaultConcurrency}
+	for _, option := range options {
+		option(settings)
+	}
+
+	first, total, err := fetch(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := len(first)
+	if pageSize == 0 || pageSize >= total {
+		return first, nil
+	}
+
+	var offsets []int
+	for startAt := pageSize; startAt < total; startAt += pageSize {
+		offsets = append(offsets, startAt)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		pages    = make([][]T, len(offsets))
+		queue    = make(chan int)
+		wg       sync.WaitGroup
+		once     sync.Once
+		fetchErr error
+	)
+
+	for worker := 0; worker < min(settings.concurrency, len(offsets)); worker++ {
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for index := range queue {
+
+				results, _, err := fetch(ctx, offsets[index])
+				if err != nil {
+					once.Do(func() {
+						fetchErr = err
+						cancel()
+					})
+
+					continue
+				}
+
+				pages[index] = results
+			}
+		}()
+	}
+
+dispatch:
+	for index := range offsets {
+
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case queue <- index:
+		}
+	}
+
+	close(queue)
+	wg.Wait()
+
+	if fetchErr != nil {
+		return nil, fetchErr
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	results := make([]T, 0, total)
+	results = append(results, first...)
+
+	for _, page := range pages {
+		results = append(results, page...)
+	}
+
+	return results, nil
+}
+
+// CursorFetcher returns the page of results at the cursor and the cursor of the next page, empty on the last page.
+//
+// The cursor of the first page is empty.
+type CursorFetcher[T any] func(ctx context.Context, cursor string) (results []T, next string, err error)
+
+// WalkPages fetches the pages one after another and calls visit for every result, in order.
+//
+// The walk stops once the total of results is reached or an empty page is returned. The first error returned
+// by fetch or visit stops the walk and it's returned, the context is checked before fetching every page.
+func WalkPages[T any](ctx context.Context, fetch Fetcher[T], visit func(item T) error) error {
+
+	for startAt := 0; ; {
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		results, total, err := fetch(ctx, startAt)
+		if err != nil {
+			return err
+		}
+
+		for _, result := range results {
+			if err := visit(result); err != nil {
+				return err
+			}
+		}
+
+		startAt += len(results)
+
+		if len(results) == 0 || startAt >= total {
+			return nil
+		}
+	}
+}
+
+// WalkCursor fetches the pages of a cursor paginated endpoint one after another and calls visit for every result, in order.
+//
+// The walk stops once the next cursor is empty, or equal to the current one. The first error returned by fetch or visit stops the walk and
+// it's returned, the context is checked before fetching every page.
+func WalkCursor[T any](ctx context.Context, fetch CursorFetcher[T], visit func(item T) error) error {
+
+	for cursor := ""; ; {
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		results, next, err := fetch(ctx, cursor)
+		if err != nil {
+			return err
+		}
+
+		for _, result := range results {
+			if err := visit(result); err != nil {
+				return err
+			}
+		}
+
+		if next == "" || next == cursor {
+			return nil
+		}
+
+		cursor = next
+	}
+}
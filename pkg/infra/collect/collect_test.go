@@ -0,0 +1,231 @@
+package collect
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestAll(t *testing.T) {
+
+	newFetcher := func(total, pageSize int, failAt int, running, peak *int32) Fetcher[int] {
+		return func(ctx context.Context, startAt int) ([]int, int, error) {
+
+			if running != nil {
+				current := atomic.AddInt32(running, 1)
+				defer atomic.AddInt32(running, -1)
+
+				for {
+					previous := atomic.LoadInt32(peak)
+					if current <= previous || atomic.CompareAndSwapInt32(peak, previous, current) {
+						break
+					}
+				}
+			}
+
+			if startAt == failAt {
+				return nil, 0, errors.New("error, unable to fetch the page")
+			}
+
+			var results []int
+			for value := startAt; value < startAt+pageSize && value < total; value++ {
+				results = append(results, value)
+			}
+
+			return results, total, nil
+		}
+	}
+
+	sequence := func(total int) []int {
+		values := make([]int, total)
+		for index := range values {
+			values[index] = index
+		}
+		return values
+	}
+
+	testCases := []struct {
+		name        string
+		fetch       Fetcher[int]
+		concurrency int
+		want        []int
+		wantErr     bool
+		Err         error
+	}{
+		{
+			name:  "when the results are split on several pages",
+			fetch: newFetcher(230, 50, -1, nil, nil),
+			want:  sequence(230),
+		},
+
+		{
+			name:  "when the results fit on the first page",
+			fetch: newFetcher(20, 50, -1, nil, nil),
+			want:  sequence(20),
+		},
+
+		{
+			name:  "when there are no results",
+			fetch: newFetcher(0, 50, -1, nil, nil),
+		},
+
+		{
+			name:    "when the first page cannot be fetched",
+			fetch:   newFetcher(230, 50, 0, nil, nil),
+			wantErr: true,
+			Err:     errors.New("error, unable to fetch the page"),
+		},
+
+		{
+			name:        "when a remaining page cannot be fetched",
+			fetch:       newFetcher(230, 50, 100, nil, nil),
+			concurrency: 1,
+			wantErr:     true,
+			Err:         errors.New("error, unable to fetch the page"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			got, err := All(context.Background(), testCase.fetch, WithConcurrency(testCase.concurrency))
+
+			if testCase.wantErr {
+				assert.EqualError(t, err, testCase.Err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, got)
+		})
+	}
+
+	t.Run("when the concurrency is capped", func(t *testing.T) {
+
+		var running, peak int32
+
+		got, err := All(context.Background(), newFetcher(1000, 10, -1, &running, &peak), WithConcurrency(3))
+
+		assert.NoError(t, err)
+		assert.Equal(t, sequence(1000), got)
+		assert.LessOrEqual(t, peak, int32(3))
+	})
+}
+
+func TestFromPage(t *testing.T) {
+
+	fetch := FromPage(func(ctx context.Context, startAt int) (*models.Page[int], error) {
+
+		page := &models.Page[int]{StartAt: startAt, Total: 5}
+		for value := startAt; value < startAt+2 && value < 5; value++ {
+			page.Values = append(page.Values, value)
+		}
+
+		return page, nil
+	})
+
+	got, err := All(context.Background(), fetch)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, got)
+
+	_, err = All(context.Background(), FromPage(func(ctx context.Context, startAt int) (*models.Page[int], error) {
+		return nil, errors.New("error, unable to fetch the page")
+	}))
+
+	assert.EqualError(t, err, "error, unable to fetch the page")
+}
+
+func TestWalkPages(t *testing.T) {
+
+	fetch := func(ctx context.Context, startAt int) ([]int, int, error) {
+
+		var results []int
+		for value := startAt; value < startAt+10 && value < 25; value++ {
+			results = append(results, value)
+		}
+
+		return results, 25, nil
+	}
+
+	t.Run("when every page is walked", func(t *testing.T) {
+
+		var visited []int
+		err := WalkPages(context.Background(), fetch, func(item int) error {
+			visited = append(visited, item)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Len(t, visited, 25)
+		assert.Equal(t, 24, visited[24])
+	})
+
+	t.Run("when the visit fails", func(t *testing.T) {
+
+		var pages int
+		countPages := func(ctx context.Context, startAt int) ([]int, int, error) {
+			pages++
+			return fetch(ctx, startAt)
+		}
+
+		err := WalkPages(context.Background(), countPages, func(item int) error {
+			if item == 12 {
+				return errors.New("error, unable to export the item")
+			}
+
+			return nil
+		})
+
+		assert.EqualError(t, err, "error, unable to export the item")
+		assert.Equal(t, 2, pages)
+	})
+
+	t.Run("when the context is canceled", func(t *testing.T) {
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		var visited int
+		err := WalkPages(ctx, fetch, func(item int) error {
+			visited++
+			cancel()
+			return nil
+		})
+
+		assert.True(t, errors.Is(err, context.Canceled))
+		assert.Equal(t, 10, visited)
+	})
+}
+
+func TestWalkCursor(t *testing.T) {
+
+	pages := map[string]struct {
+		results []string
+		next    string
+	}{
+		"":       {results: []string{"KP-1", "KP-2"}, next: "page-2"},
+		"page-2": {results: []string{"KP-3"}},
+	}
+
+	var visited []string
+	err := WalkCursor(context.Background(), func(ctx context.Context, cursor string) ([]string, string, error) {
+		return pages[cursor].results, pages[cursor].next, nil
+	}, func(item string) error {
+		visited = append(visited, item)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"KP-1", "KP-2", "KP-3"}, visited)
+
+	err = WalkCursor(context.Background(), func(ctx context.Context, cursor string) ([]string, string, error) {
+		return nil, "", errors.New("error, unable to fetch the page")
+	}, func(item string) error { return nil })
+
+	assert.EqualError(t, err, "error, unable to fetch the page")
+}
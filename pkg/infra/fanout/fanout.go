@@ -0,0 +1,46 @@
+// Package fanout provides a helper to run the same operation over several items with a bounded concurrency,
+// such as the bulk operations sending a request per item:
+//
+//	errs := fanout.Map(attachmentIDs, 5, func(attachmentID string) error {
+//		_, err := instance.Issue.Attachment.Delete(ctx, attachmentID)
+//		return err
+//	})
+package fanout
+
+import "sync"
+
+// Map calls process for every item, running at most limit calls at the same time, and returns their results once
+// all of them are done.
+//
+// The results keep the order of the items. The items are processed in order, process must be safe for concurrent use.
+// A limit lower than 1 processes the items one after another.
+func Map[T, R any](items []T, limit int, process func(item T) R) []R {
+
+	results := make([]R, len(items))
+
+	var (
+		wg    sync.WaitGroup
+		queue = make(chan int)
+	)
+
+	for worker := 0; worker < min(max(limit, 1), len(items)); worker++ {
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for index := range queue {
+				results[index] = process(items[index])
+			}
+		}()
+	}
+
+	for index := range items {
+		queue <- index
+	}
+
+	close(queue)
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,74 @@
+package fanout
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap(t *testing.T) {
+
+	testCases := []struct {
+		name     string
+		items    []int
+		limit    int
+		wantPeak int32
+	}{
+		{
+			name:     "when the items exceed the limit",
+			items:    []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+			limit:    3,
+			wantPeak: 3,
+		},
+
+		{
+			name:     "when the limit is not set",
+			items:    []int{1, 2, 3},
+			limit:    0,
+			wantPeak: 1,
+		},
+
+		{
+			name:  "when there are no items",
+			items: nil,
+			limit: 5,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var running, peak int32
+
+			got := Map(testCase.items, testCase.limit, func(item int) int {
+
+				current := atomic.AddInt32(&running, 1)
+				defer atomic.AddInt32(&running, -1)
+
+				for {
+					previous := atomic.LoadInt32(&peak)
+					if current <= previous || atomic.CompareAndSwapInt32(&peak, previous, current) {
+						break
+					}
+				}
+
+				time.Sleep(5 * time.Millisecond)
+
+				return item * 10
+			})
+
+			assert.Len(t, got, len(testCase.items))
+			for index, item := range testCase.items {
+				assert.Equal(t, item*10, got[index])
+			}
+
+			assert.LessOrEqual(t, peak, max(int32(testCase.limit), 1))
+
+			if testCase.wantPeak != 0 {
+				assert.Equal(t, testCase.wantPeak, peak)
+			}
+		})
+	}
+}
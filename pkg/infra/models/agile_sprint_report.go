@@ -0,0 +1,113 @@
+package models
+
+// SprintReportScheme represents the sprint report of a board, as displayed on the Jira sprint report chart.
+type SprintReportScheme struct {
+	Contents *SprintReportContentsScheme `json:"contents,omitempty"` // The issues and the estimate sums of the sprint.
+	Sprint   *SprintReportSprintScheme   `json:"sprint,omitempty"`   // The sprint reported.
+}
+
+// Committed returns the estimate committed at the sprint start, including the issues removed from the sprint.
+//
+// The initial estimates of the issues added after the sprint start are excluded, they weren't committed.
+func (s *SprintReportScheme) Committed() float64 {
+
+	if s.Contents == nil {
+		return 0
+	}
+
+	committed := s.Contents.CompletedIssuesInitialEstimateSum.Number() +
+		s.Contents.IssuesNotCompletedInitialEstimateSum.Number() +
+		s.Contents.PuntedIssuesInitialEstimateSum.Number()
+
+	for _, issues := range [][]*SprintReportIssueScheme{s.Contents.CompletedIssues, s.Contents.IssuesNotCompletedInCurrentSprint, s.Contents.PuntedIssues} {
+		for _, issue := range issues {
+
+			if !s.Contents.IssueKeysAddedDuringSprint[issue.Key] || issue.EstimateStatistic == nil {
+				continue
+			}
+
+			committed -= issue.EstimateStatistic.StatFieldValue.Number()
+		}
+	}
+
+	return committed
+}
+
+// Completed returns the estimate completed during the sprint.
+func (s *SprintReportScheme) Completed() float64 {
+
+	if s.Contents == nil {
+		return 0
+	}
+
+	return s.Contents.CompletedIssuesEstimateSum.Number()
+}
+
+// SprintReportContentsScheme represents the issues and the estimate sums of a sprint report.
+type SprintReportContentsScheme struct {
+	CompletedIssues                      []*SprintReportIssueScheme  `json:"completedIssues,omitempty"`                      // The issues completed during the sprint.
+	IssuesNotCompletedInCurrentSprint    []*SprintReportIssueScheme  `json:"issuesNotCompletedInCurrentSprint,omitempty"`    // The issues not completed.
+	PuntedIssues                         []*SprintReportIssueScheme  `json:"puntedIssues,omitempty"`                         // The issues removed from the sprint.
+	IssuesCompletedInAnotherSprint       []*SprintReportIssueScheme  `json:"issuesCompletedInAnotherSprint,omitempty"`       // The issues completed outside of the sprint.
+	CompletedIssuesInitialEstimateSum    *SprintReportEstimateScheme `json:"completedIssuesInitialEstimateSum,omitempty"`    // The initial estimate of the completed issues.
+	CompletedIssuesEstimateSum           *SprintReportEstimateScheme `json:"completedIssuesEstimateSum,omitempty"`           // The estimate of the completed issues.
+	IssuesNotCompletedInitialEstimateSum *SprintReportEstimateScheme `json:"issuesNotCompletedInitialEstimateSum,omitempty"` // The initial estimate of the issues not completed.
+	IssuesNotCompletedEstimateSum        *SprintReportEstimateScheme `json:"issuesNotCompletedEstimateSum,omitempty"`        // The estimate of the issues not completed.
+	AllIssuesEstimateSum                 *SprintReportEstimateScheme `json:"allIssuesEstimateSum,omitempty"`                 // The estimate of all the issues.
+	PuntedIssuesInitialEstimateSum       *SprintReportEstimateScheme `json:"puntedIssuesInitialEstimateSum,omitempty"`       // The initial estimate of the issues removed.
+	PuntedIssuesEstimateSum              *SprintReportEstimateScheme `json:"puntedIssuesEstimateSum,omitempty"`              // The estimate of the issues removed.
+	IssueKeysAddedDuringSprint           map[string]bool             `json:"issueKeysAddedDuringSprint,omitempty"`           // The keys of the issues added after the sprint start.
+}
+
+// SprintReportEstimateScheme represents an estimate sum of a sprint report.
+type SprintReportEstimateScheme struct {
+	Value *float64 `json:"value,omitempty"` // The estimate, it's not returned if no issue is estimated.
+	Text  string   `json:"text,omitempty"`  // The estimate formatted.
+}
+
+// Number returns the estimate value, or 0 if no issue is estimated.
+func (e *SprintReportEstimateScheme) Number() float64 {
+
+	if e == nil || e.Value == nil {
+		return 0
+	}
+
+	return *e.Value
+}
+
+// SprintReportIssueScheme represents an issue of a sprint report.
+type SprintReportIssueScheme struct {
+	ID                       int                          `json:"id,omitempty"`                       // The ID of the issue.
+	Key                      string                       `json:"key,omitempty"`                      // The key of the issue.
+	Summary                  string                       `json:"summary,omitempty"`                  // The summary of the issue.
+	TypeID                   string                       `json:"typeId,omitempty"`                   // The ID of the issue type.
+	TypeName                 string                       `json:"typeName,omitempty"`                 // The name of the issue type.
+	PriorityName             string                       `json:"priorityName,omitempty"`             // The name of the priority.
+	StatusID                 string                       `json:"statusId,omitempty"`                 // The ID of the status.
+	StatusName               string                       `json:"statusName,omitempty"`               // The name of the status.
+	Done                     bool                         `json:"done,omitempty"`                     // Indicates if the issue is done.
+	Hidden                   bool                         `json:"hidden,omitempty"`                   // Indicates if the issue is hidden.
+	AssigneeAccountID        string                       `json:"assigneeAccountId,omitempty"`        // The account ID of the assignee.
+	AssigneeName             string                       `json:"assigneeName,omitempty"`             // The name of the assignee.
+	Epic                     string                       `json:"epic,omitempty"`                     // The key of the epic of the issue.
+	ProjectID                int                          `json:"projectId,omitempty"`                // The ID of the project.
+	EstimateStatistic        *SprintReportStatisticScheme `json:"estimateStatistic,omitempty"`        // The estimate at the sprint start.
+	CurrentEstimateStatistic *SprintReportStatisticScheme `json:"currentEstimateStatistic,omitempty"` // The current estimate.
+}
+
+// SprintReportStatisticScheme represents the estimate statistic of an issue on a sprint report.
+type SprintReportStatisticScheme struct {
+	StatFieldID    string                      `json:"statFieldId,omitempty"`    // The ID of the estimation field, e.g. the story points field.
+	StatFieldValue *SprintReportEstimateScheme `json:"statFieldValue,omitempty"` // The estimate.
+}
+
+// SprintReportSprintScheme represents the sprint of a sprint report.
+type SprintReportSprintScheme struct {
+	ID              int    `json:"id,omitempty"`              // The ID of the sprint.
+	Name            string `json:"name,omitempty"`            // The name of the sprint.
+	State           string `json:"state,omitempty"`           // The state of the sprint.
+	Goal            string `json:"goal,omitempty"`            // The goal of the sprint.
+	IsoStartDate    string `json:"isoStartDate,omitempty"`    // The start date of the sprint, ISO 8601 formatted.
+	IsoEndDate      string `json:"isoEndDate,omitempty"`      // The end date of the sprint, ISO 8601 formatted.
+	IsoCompleteDate string `json:"isoCompleteDate,omitempty"` // The complete date of the sprint, ISO 8601 formatted.
+}
@@ -0,0 +1,38 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSprintReportScheme_Points(t *testing.T) {
+
+	payload := `{
+		"contents": {
+			"completedIssues": [
+				{"id": 10001, "key": "KP-1", "done": true, "estimateStatistic": {"statFieldId": "customfield_10016", "statFieldValue": {"value": 2.0}}},
+				{"id": 10003, "key": "KP-3", "done": true, "estimateStatistic": {"statFieldId": "customfield_10016", "statFieldValue": {"value": 3.0}}}
+			],
+			"puntedIssues": [{"id": 10002, "key": "KP-2", "estimateStatistic": {"statFieldId": "customfield_10016", "statFieldValue": {"value": 3.0}}}],
+			"completedIssuesInitialEstimateSum": {"value": 5.0, "text": "5.0"},
+			"completedIssuesEstimateSum": {"value": 8.0, "text": "8.0"},
+			"issuesNotCompletedInitialEstimateSum": {"text": "null"},
+			"puntedIssuesInitialEstimateSum": {"value": 3.0, "text": "3.0"},
+			"issueKeysAddedDuringSprint": {"KP-1": true}
+		},
+		"sprint": {"id": 12, "name": "Sprint 12", "state": "CLOSED"}
+	}`
+
+	report := new(SprintReportScheme)
+	assert.NoError(t, json.Unmarshal([]byte(payload), report))
+
+	assert.Equal(t, 6.0, report.Committed())
+	assert.Equal(t, 8.0, report.Completed())
+	assert.Len(t, report.Contents.PuntedIssues, 1)
+	assert.True(t, report.Contents.IssueKeysAddedDuringSprint["KP-1"])
+
+	assert.Zero(t, (&SprintReportScheme{}).Committed())
+	assert.Zero(t, (&SprintReportScheme{}).Completed())
+}
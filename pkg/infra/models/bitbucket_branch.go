@@ -5,3 +5,33 @@ type BranchScheme struct {
 	MergeStrategies      []string `json:"merge_strategies"`       // The merge strategies available for the branch.
 	DefaultMergeStrategy string   `json:"default_merge_strategy"` // The default merge strategy used for the branch.
 }
+
+// BranchReferenceScheme represents a branch reference in a repository.
+type BranchReferenceScheme struct {
+	Type                 string                     `json:"type,omitempty"`                   // The type of the reference, e.g. "branch".
+	Name                 string                     `json:"name,omitempty"`                   // The name of the branch.
+	Target               *BranchTargetScheme        `json:"target,omitempty"`                 // The commit the branch points to.
+	MergeStrategies      []string                   `json:"merge_strategies,omitempty"`       // The merge strategies available for the branch.
+	DefaultMergeStrategy string                     `json:"default_merge_strategy,omitempty"` // The default merge strategy used for the branch.
+	Links                *BranchReferenceLinkScheme `json:"links,omitempty"`                  // The links related to the branch.
+}
+
+// BranchTargetScheme represents the commit targeted by a branch.
+type BranchTargetScheme struct {
+	Type string `json:"type,omitempty"` // The type of the target, e.g. "commit".
+	Hash string `json:"hash,omitempty"` // The hash of the commit.
+	Date string `json:"date,omitempty"` // The date of the commit.
+}
+
+// BranchReferenceLinkScheme represents the links related to a branch reference.
+type BranchReferenceLinkScheme struct {
+	Self    *BitbucketLinkScheme `json:"self,omitempty"`    // The link to the branch itself.
+	Commits *BitbucketLinkScheme `json:"commits,omitempty"` // The link to the commits of the branch.
+	HTML    *BitbucketLinkScheme `json:"html,omitempty"`    // The link to the branch's HTML page.
+}
+
+// BranchCreatePayloadScheme represents the payload to create a branch.
+type BranchCreatePayloadScheme struct {
+	Name   string              `json:"name"`   // The name of the branch.
+	Target *BranchTargetScheme `json:"target"` // The commit the branch points to, only the hash is required.
+}
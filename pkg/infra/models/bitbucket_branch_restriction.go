@@ -0,0 +1,61 @@
+package models
+
+// BranchRestrictionKind represents the kind of branch restriction.
+type BranchRestrictionKind string
+
+const (
+	// BranchRestrictionPush restricts the push to the matching branches to the listed users and groups.
+	BranchRestrictionPush BranchRestrictionKind = "push"
+	// BranchRestrictionDelete prevents the deletion of the matching branches.
+	BranchRestrictionDelete BranchRestrictionKind = "delete"
+	// BranchRestrictionForce prevents the rewrite of the history of the matching branches.
+	BranchRestrictionForce BranchRestrictionKind = "force"
+	// BranchRestrictionRequireApprovals requires a minimum number of approvals to merge, the number is set on the value.
+	BranchRestrictionRequireApprovals BranchRestrictionKind = "require_approvals_to_merge"
+	// BranchRestrictionRequirePassingBuilds requires a minimum number of successful builds to merge, the number is set on the value.
+	BranchRestrictionRequirePassingBuilds BranchRestrictionKind = "require_passing_builds_to_merge"
+	// BranchRestrictionRestrictMerges restricts the merges to the matching branches to the listed users and groups.
+	BranchRestrictionRestrictMerges BranchRestrictionKind = "restrict_merges"
+)
+
+// BranchRestrictionPageScheme represents a paginated list of branch restrictions.
+type BranchRestrictionPageScheme struct {
+	Size     int                        `json:"size,omitempty"`     // The number of restrictions in the current page.
+	Page     int                        `json:"page,omitempty"`     // The current page number.
+	Pagelen  int                        `json:"pagelen,omitempty"`  // The total number of pages.
+	Next     string                     `json:"next,omitempty"`     // The URL to the next page.
+	Previous string                     `json:"previous,omitempty"` // The URL to the previous page.
+	Values   []*BranchRestrictionScheme `json:"values,omitempty"`   // The branch restrictions in the current page.
+}
+
+// BranchRestrictionScheme represents a branch restriction.
+type BranchRestrictionScheme struct {
+	ID              int                             `json:"id,omitempty"`                // The ID of the restriction.
+	Type            string                          `json:"type,omitempty"`              // The type of the object, e.g. "branchrestriction".
+	Kind            BranchRestrictionKind           `json:"kind,omitempty"`              // The kind of the restriction.
+	BranchMatchKind string                          `json:"branch_match_kind,omitempty"` // How the branches are matched, "glob" or "branching_model".
+	BranchType      string                          `json:"branch_type,omitempty"`       // The branch type matched when the match kind is "branching_model", e.g. "production".
+	Pattern         string                          `json:"pattern,omitempty"`           // The glob pattern matched when the match kind is "glob".
+	Value           *int                            `json:"value,omitempty"`             // The number of approvals or builds required by the restriction, if applicable.
+	Users           []*BitbucketAccountScheme       `json:"users,omitempty"`             // The users exempted from the restriction.
+	Groups          []*BranchRestrictionGroupScheme `json:"groups,omitempty"`            // The groups exempted from the restriction.
+	Links           *BranchRestrictionLinkScheme    `json:"links,omitempty"`             // The links related to the restriction.
+}
+
+// BranchRestrictionGroupScheme represents a group exempted from a branch restriction.
+type BranchRestrictionGroupScheme struct {
+	Slug     string `json:"slug,omitempty"`      // The slug of the group.
+	Name     string `json:"name,omitempty"`      // The name of the group.
+	FullSlug string `json:"full_slug,omitempty"` // The workspace qualified slug of the group.
+}
+
+// BranchRestrictionLinkScheme represents the links related to a branch restriction.
+type BranchRestrictionLinkScheme struct {
+	Self *BitbucketLinkScheme `json:"self,omitempty"` // The link to the restriction itself.
+}
+
+// BranchRestrictionOptionsScheme represents the filters of the branch restrictions search.
+type BranchRestrictionOptionsScheme struct {
+	Kind    BranchRestrictionKind // Returns the restrictions of the kind.
+	Pattern string                // Returns the restrictions matching the branch pattern.
+}
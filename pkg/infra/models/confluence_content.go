@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // GetContentOptionsScheme represents the options for getting content.
 type GetContentOptionsScheme struct {
@@ -239,3 +242,56 @@ type ContentArchiveResultScheme struct {
 type ContentMoveScheme struct {
 	ID string `json:"pageId"` // The ID of the content to be moved.
 }
+
+// ContentV2ReferenceScheme represents the canonical reference of a REST v1 content on the REST v2 endpoints.
+type ContentV2ReferenceScheme struct {
+	ID       int    `json:"id,omitempty"`       // The numeric ID of the content.
+	Type     string `json:"type,omitempty"`     // The REST v1 type of the content, e.g. "page" or "blogpost".
+	Endpoint string `json:"endpoint,omitempty"` // The REST v2 endpoint of the content, e.g. "wiki/api/v2/pages/10001".
+}
+
+// ContentBulkResultScheme represents the result of fetching many contents by ID.
+type ContentBulkResultScheme struct {
+	Contents []*ContentScheme `json:"contents,omitempty"` // The contents ordered as the requested IDs, nil for the IDs not found or failed.
+	Errors   map[string]error `json:"-"`                  // The errors keyed by the content ID.
+}
+
+// ContentFindReplaceResultScheme represents the result, or the plan on a dry run, of a find and replace across a space.
+type ContentFindReplaceResultScheme struct {
+	Changes []*ContentReplacementScheme `json:"changes,omitempty"` // The contents changed, or to be changed on a dry run.
+	Skipped []string                    `json:"skipped,omitempty"` // The IDs of the contents matched by the search but not containing the text.
+	Errors  map[string]error            `json:"-"`                 // The errors keyed by the content ID.
+}
+
+// ContentPurgeResultScheme represents the result of purging the trashed contents of a space.
+type ContentPurgeResultScheme struct {
+	Total  int              `json:"total"`  // The number of trashed contents found.
+	Purged []string         `json:"purged"` // The IDs of the contents purged.
+	Errors map[string]error `json:"-"`      // The errors returned by the contents which could not be purged, keyed by the content ID.
+}
+
+// ContentReplacementScheme represents the replacement of a text on a content.
+type ContentReplacementScheme struct {
+	ContentID   string `json:"contentId,omitempty"`   // The ID of the content.
+	Title       string `json:"title,omitempty"`       // The title of the content.
+	Occurrences int    `json:"occurrences,omitempty"` // The number of occurrences replaced.
+	Version     int    `json:"version,omitempty"`     // The version created, 0 on a dry run.
+}
+
+// ContentDuplicateTitleError represents the error returned when several contents share the title looked up.
+//
+// It wraps ErrDuplicateContentTitle, so it can be checked with errors.Is, and carries the matches.
+type ContentDuplicateTitleError struct {
+	Title   string           // The title looked up.
+	Matches []*ContentScheme // The contents sharing the title.
+}
+
+// Error returns the error message, followed by the title and the number of matches.
+func (e *ContentDuplicateTitleError) Error() string {
+	return fmt.Sprintf("%v: %v (%v matches)", ErrDuplicateContentTitle, e.Title, len(e.Matches))
+}
+
+// Unwrap returns ErrDuplicateContentTitle.
+func (e *ContentDuplicateTitleError) Unwrap() error {
+	return ErrDuplicateContentTitle
+}
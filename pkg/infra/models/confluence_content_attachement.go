@@ -6,3 +6,9 @@ type GetContentAttachmentsOptionsScheme struct {
 	FileName  string   // The file name of the content attachments.
 	MediaType string   // The media type of the content attachments.
 }
+
+// ContentAttachmentUpsertScheme represents the result of uploading an attachment by its file name in Confluence.
+type ContentAttachmentUpsertScheme struct {
+	Attachment *ContentScheme // The attachment created or updated.
+	Created    bool           // Indicates if the attachment was created, false if a new version of the existing one was added.
+}
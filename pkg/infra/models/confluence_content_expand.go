@@ -0,0 +1,127 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// The expansions supported by the content endpoints, e.g. ContentService.Get.
+const (
+	ContentExpandAncestors               = "ancestors"
+	ContentExpandBody                    = "body"
+	ContentExpandBodyStorage             = "body.storage"
+	ContentExpandBodyView                = "body.view"
+	ContentExpandBodyExportView          = "body.export_view"
+	ContentExpandBodyStyledView          = "body.styled_view"
+	ContentExpandBodyEditor              = "body.editor"
+	ContentExpandBodyEditor2             = "body.editor2"
+	ContentExpandBodyAnonymousExportView = "body.anonymous_export_view"
+	ContentExpandBodyAtlasDocFormat      = "body.atlas_doc_format"
+	ContentExpandChildTypes              = "childTypes"
+	ContentExpandChildTypesAll           = "childTypes.all"
+	ContentExpandChildTypesAttachment    = "childTypes.attachment"
+	ContentExpandChildTypesComment       = "childTypes.comment"
+	ContentExpandChildTypesPage          = "childTypes.page"
+	ContentExpandChildren                = "children"
+	ContentExpandChildrenAttachment      = "children.attachment"
+	ContentExpandChildrenComment         = "children.comment"
+	ContentExpandChildrenPage            = "children.page"
+	ContentExpandContainer               = "container"
+	ContentExpandDescendants             = "descendants"
+	ContentExpandDescendantsAttachment   = "descendants.attachment"
+	ContentExpandDescendantsComment      = "descendants.comment"
+	ContentExpandDescendantsPage         = "descendants.page"
+	ContentExpandHistory                 = "history"
+	ContentExpandHistoryContributors     = "history.contributors"
+	ContentExpandHistoryLastUpdated      = "history.lastUpdated"
+	ContentExpandHistoryNextVersion      = "history.nextVersion"
+	ContentExpandHistoryPreviousVersion  = "history.previousVersion"
+	ContentExpandMetadata                = "metadata"
+	ContentExpandMetadataCurrentUser     = "metadata.currentuser"
+	ContentExpandMetadataFrontend        = "metadata.frontend"
+	ContentExpandMetadataLabels          = "metadata.labels"
+	ContentExpandMetadataProperties      = "metadata.properties"
+	ContentExpandOperations              = "operations"
+	ContentExpandRestrictions            = "restrictions"
+	ContentExpandRestrictionsReadUser    = "restrictions.read.restrictions.user"
+	ContentExpandRestrictionsReadGroup   = "restrictions.read.restrictions.group"
+	ContentExpandRestrictionsUpdateUser  = "restrictions.update.restrictions.user"
+	ContentExpandRestrictionsUpdateGroup = "restrictions.update.restrictions.group"
+	ContentExpandSpace                   = "space"
+	ContentExpandVersion                 = "version"
+)
+
+// The expansions supported by the content history endpoint, e.g. ContentService.History.
+const (
+	ContentHistoryExpandContributors    = "contributors"
+	ContentHistoryExpandLastUpdated     = "lastUpdated"
+	ContentHistoryExpandNextVersion     = "nextVersion"
+	ContentHistoryExpandPreviousVersion = "previousVersion"
+)
+
+// ContentExpands are the expansions supported by the content endpoints.
+var ContentExpands = []string{
+	ContentExpandAncestors, ContentExpandBody, ContentExpandBodyStorage, ContentExpandBodyView, ContentExpandBodyExportView,
+	ContentExpandBodyStyledView, ContentExpandBodyEditor, ContentExpandBodyEditor2, ContentExpandBodyAnonymousExportView,
+	ContentExpandBodyAtlasDocFormat, ContentExpandChildTypes, ContentExpandChildTypesAll, ContentExpandChildTypesAttachment,
+	ContentExpandChildTypesComment, ContentExpandChildTypesPage, ContentExpandChildren, ContentExpandChildrenAttachment,
+	ContentExpandChildrenComment, ContentExpandChildrenPage, ContentExpandContainer, ContentExpandDescendants,
+	ContentExpandDescendantsAttachment, ContentExpandDescendantsComment, ContentExpandDescendantsPage, ContentExpandHistory,
+	ContentExpandHistoryContributors, ContentExpandHistoryLastUpdated, ContentExpandHistoryNextVersion,
+	ContentExpandHistoryPreviousVersion, ContentExpandMetadata, ContentExpandMetadataCurrentUser, ContentExpandMetadataFrontend,
+	ContentExpandMetadataLabels, ContentExpandMetadataProperties, ContentExpandOperations, ContentExpandRestrictions,
+	ContentExpandRestrictionsReadUser, ContentExpandRestrictionsReadGroup, ContentExpandRestrictionsUpdateUser,
+	ContentExpandRestrictionsUpdateGroup, ContentExpandSpace, ContentExpandVersion,
+}
+
+// ContentHistoryExpands are the expansions supported by the content history endpoint.
+var ContentHistoryExpands = []string{
+	ContentHistoryExpandContributors, ContentHistoryExpandLastUpdated, ContentHistoryExpandNextVersion, ContentHistoryExpandPreviousVersion,
+}
+
+// rawExpandPrefix flags the expansions excluded from the validation.
+const rawExpandPrefix = "raw:"
+
+// RawExpand flags an expansion to be sent as it is, skipping the validation.
+//
+// Use it for the expansions whose first segment isn't listed yet, e.g. RawExpand("extensions.position").
+func RawExpand(value string) string {
+	return rawExpandPrefix + value
+}
+
+// ValidateExpand checks the expansions against the supported ones and returns them ready to be sent,
+// the raw expansions are unwrapped. It returns ErrInvalidExpand with the offending value otherwise.
+//
+// Only the first segment of the expansion path is validated, so the nested expansions of a supported one are accepted,
+// e.g. "version.by" or "space.homepage".
+func ValidateExpand(expand, supported []string) ([]string, error) {
+
+	if len(expand) == 0 {
+		return expand, nil
+	}
+
+	validated := make([]string, 0, len(expand))
+
+	for _, value := range expand {
+
+		if raw, ok := strings.CutPrefix(value, rawExpandPrefix); ok {
+			validated = append(validated, raw)
+			continue
+		}
+
+		if !slices.ContainsFunc(supported, func(candidate string) bool { return expandRoot(candidate) == expandRoot(value) }) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidExpand, value)
+		}
+
+		validated = append(validated, value)
+	}
+
+	return validated, nil
+}
+
+// expandRoot returns the first segment of the expansion path, e.g. "version" for "version.by".
+func expandRoot(value string) string {
+	root, _, _ := strings.Cut(value, ".")
+	return root
+}
@@ -21,3 +21,10 @@ type ContentLabelScheme struct {
 	ID     string `json:"id,omitempty"`     // The ID of the content label.
 	Label  string `json:"label,omitempty"`  // The label of the content label.
 }
+
+// ContentLabelRemovalScheme represents the result of removing the labels matching a prefix from a piece of content.
+type ContentLabelRemovalScheme struct {
+	Total   int              `json:"total"`   // The number of labels matching the prefix.
+	Removed []string         `json:"removed"` // The names of the labels removed.
+	Errors  map[string]error `json:"-"`       // The errors returned by the labels which could not be removed, keyed by the label name.
+}
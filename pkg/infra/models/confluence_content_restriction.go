@@ -51,3 +51,30 @@ type ContentRestrictionRestrictionUpdateScheme struct {
 type ContentRestrictionByOperationScheme struct {
 	OperationType *ContentRestrictionScheme `json:"operationType,omitempty"` // The type of the operation of the restriction.
 }
+
+// ContentEffectiveRestrictionScheme represents the restrictions applied to a content in Confluence, including the inherited ones.
+type ContentEffectiveRestrictionScheme struct {
+	ContentID string                            `json:"contentId,omitempty"` // The ID of the content.
+	Read      []*ContentRestrictionSourceScheme `json:"read,omitempty"`      // The read restrictions, a user must satisfy every one of them.
+	Update    []*ContentRestrictionSourceScheme `json:"update,omitempty"`    // The update restrictions, they're not inherited from the ancestors.
+
+	// ReadPrincipals are the principals allowed by every read restriction, nil if the content is not read restricted.
+	ReadPrincipals *ContentRestrictionPrincipalsScheme `json:"readPrincipals,omitempty"`
+	// UpdatePrincipals are the principals allowed by the update restriction and every read restriction, nil if the
+	// content is neither update nor read restricted.
+	UpdatePrincipals *ContentRestrictionPrincipalsScheme `json:"updatePrincipals,omitempty"`
+}
+
+// ContentRestrictionPrincipalsScheme represents the users and the groups allowed by a set of restrictions in Confluence.
+type ContentRestrictionPrincipalsScheme struct {
+	Users  []*ContentUserScheme `json:"users,omitempty"`  // The users allowed by the restrictions.
+	Groups []*SpaceGroupScheme  `json:"groups,omitempty"` // The groups allowed by the restrictions.
+}
+
+// ContentRestrictionSourceScheme represents the principals allowed by the restriction of a content or one of its ancestors in Confluence.
+type ContentRestrictionSourceScheme struct {
+	ContentID string               `json:"contentId,omitempty"` // The ID of the content defining the restriction.
+	Inherited bool                 `json:"inherited"`           // Indicates if the restriction is defined by an ancestor.
+	Users     []*ContentUserScheme `json:"users,omitempty"`     // The users allowed by the restriction.
+	Groups    []*SpaceGroupScheme  `json:"groups,omitempty"`    // The groups allowed by the restriction.
+}
@@ -0,0 +1,14 @@
+package models
+
+// ContentStateScheme represents a content state, such as the draft, verified or outdated states.
+type ContentStateScheme struct {
+	ID    int    `json:"id,omitempty"`    // The ID of the content state.
+	Name  string `json:"name,omitempty"`  // The name of the content state.
+	Color string `json:"color,omitempty"` // The color of the content state, as a hex string.
+}
+
+// ContentStateResponseScheme represents the content state applied to a piece of content.
+type ContentStateResponseScheme struct {
+	ContentState *ContentStateScheme `json:"contentState,omitempty"` // The content state, nil if no state is applied.
+	LastUpdated  string              `json:"lastUpdated,omitempty"`  // The date the content state was last updated.
+}
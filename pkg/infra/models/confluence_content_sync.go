@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// SyncStateScheme represents the state persisted between two incremental synchronizations of a space.
+type SyncStateScheme struct {
+	Versions map[string]int `json:"versions,omitempty"` // The version number of every content synchronized, keyed by the content ID.
+	SyncedAt time.Time      `json:"syncedAt,omitempty"` // The time the synchronization started.
+}
+
+// SyncPlan represents the changes of a space since the last synchronization.
+type SyncPlan struct {
+	Created []*ContentScheme `json:"created,omitempty"` // The contents created, or restored, since the last synchronization.
+	Updated []*ContentScheme `json:"updated,omitempty"` // The contents with a new version since the last synchronization.
+	Deleted []string         `json:"deleted,omitempty"` // The IDs of the contents deleted, or trashed, since the last synchronization.
+	State   *SyncStateScheme `json:"state,omitempty"`   // The state to persist once the plan is applied.
+}
+
+// Empty reports if the space didn't change since the last synchronization.
+func (p *SyncPlan) Empty() bool {
+	return p == nil || len(p.Created)+len(p.Updated)+len(p.Deleted) == 0
+}
@@ -43,3 +43,28 @@ type ContentRestoreParamsPayloadScheme struct {
 	Message       string `json:"message,omitempty"`       // The message for restoring the content.
 	RestoreTitle  bool   `json:"restoreTitle,omitempty"`  // Indicates if the title should be restored.
 }
+
+// ContentDiffOperation represents the operation of a segment on a content diff in Confluence.
+type ContentDiffOperation string
+
+const (
+	ContentDiffEqual   ContentDiffOperation = "equal"
+	ContentDiffAdded   ContentDiffOperation = "added"
+	ContentDiffRemoved ContentDiffOperation = "removed"
+)
+
+// ContentDiffScheme represents the differences between the storage bodies of two content versions in Confluence.
+type ContentDiffScheme struct {
+	ContentID   string                      `json:"contentId,omitempty"`   // The ID of the content.
+	FromVersion int                         `json:"fromVersion,omitempty"` // The number of the base version.
+	ToVersion   int                         `json:"toVersion,omitempty"`   // The number of the compared version.
+	Added       int                         `json:"added"`                 // The number of words added.
+	Removed     int                         `json:"removed"`               // The number of words removed.
+	Segments    []*ContentDiffSegmentScheme `json:"segments,omitempty"`    // The ordered segments of the diff.
+}
+
+// ContentDiffSegmentScheme represents a segment of a content diff in Confluence.
+type ContentDiffSegmentScheme struct {
+	Operation ContentDiffOperation `json:"operation,omitempty"` // The operation of the segment, equal, added or removed.
+	Text      string               `json:"text,omitempty"`      // The text of the segment.
+}
@@ -0,0 +1,13 @@
+package models
+
+// ContentWatchStatusScheme represents the watch status of a piece of content for a user in Confluence.
+type ContentWatchStatusScheme struct {
+	Watching bool `json:"watching,omitempty"` // Indicates if the user is watching the content.
+}
+
+// ContentWatchBulkResultScheme represents the result of adding many watchers to a piece of content.
+type ContentWatchBulkResultScheme struct {
+	Total  int              `json:"total"` // The number of accounts requested to watch the content.
+	Added  []string         `json:"added"` // The account IDs added as watchers.
+	Errors map[string]error `json:"-"`     // The errors returned by the accounts which could not be added, keyed by the account ID.
+}
@@ -0,0 +1,10 @@
+package models
+
+// ContentGroupMemberPageScheme represents a page of the members of a group in Confluence.
+type ContentGroupMemberPageScheme struct {
+	Results []*ContentUserScheme `json:"results,omitempty"` // The members of the group.
+	Start   int                  `json:"start,omitempty"`   // The starting index of the page.
+	Limit   int                  `json:"limit,omitempty"`   // The maximum number of members of the page.
+	Size    int                  `json:"size,omitempty"`    // The number of members of the page.
+	Links   *LinkScheme          `json:"_links,omitempty"`  // The links of the page.
+}
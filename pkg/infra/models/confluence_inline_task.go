@@ -0,0 +1,22 @@
+package models
+
+// The statuses of the Confluence inline tasks.
+const (
+	InlineTaskStatusComplete   = "complete"
+	InlineTaskStatusIncomplete = "incomplete"
+)
+
+// InlineTaskScheme represents an inline task, also known as action item, of a Confluence storage format body.
+type InlineTaskScheme struct {
+	ID        string `json:"id,omitempty"`        // The ID of the task on the content.
+	UUID      string `json:"uuid,omitempty"`      // The UUID of the task, if provided.
+	Status    string `json:"status,omitempty"`    // The status of the task, complete or incomplete.
+	AccountID string `json:"accountId,omitempty"` // The account ID of the first user mentioned on the task, the assignee.
+	DueDate   string `json:"dueDate,omitempty"`   // The due date of the task, formatted as YYYY-MM-DD, if provided.
+	Body      string `json:"body,omitempty"`      // The storage format body of the task.
+}
+
+// Completed reports if the task is complete.
+func (t InlineTaskScheme) Completed() bool {
+	return t.Status == InlineTaskStatusComplete
+}
@@ -0,0 +1,31 @@
+package models
+
+// ContentReactionSummaryScheme represents the reactions of a piece of content.
+type ContentReactionSummaryScheme struct {
+	ContentID string                   `json:"contentId,omitempty"` // The ID of the content.
+	Reactions []*ContentReactionScheme `json:"reactions,omitempty"` // The reactions, one per emoji.
+}
+
+// Count returns the total number of reactions of the content.
+func (c *ContentReactionSummaryScheme) Count() int {
+
+	if c == nil {
+		return 0
+	}
+
+	var total int
+	for _, reaction := range c.Reactions {
+		total += reaction.Count
+	}
+
+	return total
+}
+
+// ContentReactionScheme represents the reactions using the same emoji.
+type ContentReactionScheme struct {
+	EmojiID   string               `json:"emojiId,omitempty"`   // The ID of the emoji, e.g. 1f44d.
+	Shortname string               `json:"shortName,omitempty"` // The shortname of the emoji, e.g. :thumbsup:.
+	Count     int                  `json:"count,omitempty"`     // The number of users reacting with the emoji.
+	Reacted   bool                 `json:"reacted,omitempty"`   // Indicates if the current user reacted with the emoji.
+	Users     []*ContentUserScheme `json:"users,omitempty"`     // The users reacting with the emoji.
+}
@@ -123,3 +123,18 @@ type UpdateSpaceScheme struct {
 type UpdateSpaceHomepageScheme struct {
 	ID string `json:"id"`
 }
+
+// AnonymousAccessLevel represents the level where the anonymous access of a Confluence entity is decided.
+type AnonymousAccessLevel string
+
+const (
+	AnonymousAccessSpaceLevel   AnonymousAccessLevel = "space"
+	AnonymousAccessContentLevel AnonymousAccessLevel = "content"
+)
+
+// AnonymousAccessScheme represents whether anonymous users can view a space or a content in Confluence.
+type AnonymousAccessScheme struct {
+	Granted bool                 `json:"granted"` // Indicates if anonymous users can view the entity.
+	Level   AnonymousAccessLevel `json:"level"`   // The level where the access is decided, space or content.
+	Reason  string               `json:"reason"`  // The explanation of the decision.
+}
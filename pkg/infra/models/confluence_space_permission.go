@@ -31,3 +31,17 @@ type SpacePermissionV2Scheme struct {
 	Subject   *PermissionSubjectScheme        `json:"subject,omitempty"`   // The subject of the permission.
 	Operation *SpacePermissionOperationScheme `json:"operation,omitempty"` // The operation of the permission.
 }
+
+// SpacePermissionTemplate represents a named set of space permissions in Confluence, every principal is granted every operation.
+type SpacePermissionTemplate struct {
+	Name       string                            `json:"name,omitempty"`       // The name of the template.
+	Principals []*PermissionSubjectScheme        `json:"principals,omitempty"` // The principals granted by the template, users or groups.
+	Operations []*SpacePermissionOperationScheme `json:"operations,omitempty"` // The operations granted to the principals, the key and the target are required.
+}
+
+// SpacePermissionTemplateResultScheme represents the result of applying a space permission template in Confluence.
+type SpacePermissionTemplateResultScheme struct {
+	Template string                          `json:"template,omitempty"` // The name of the template applied.
+	Applied  []*SpacePermissionPayloadScheme `json:"applied,omitempty"`  // The permissions added to the space.
+	Skipped  []*SpacePermissionPayloadScheme `json:"skipped,omitempty"`  // The permissions skipped because the space already granted them.
+}
@@ -110,3 +110,27 @@ type OriginalTemplateScheme struct {
 type ContentTemplateExpandableScheme struct {
 	Body string `json:"body"`
 }
+
+// ContentTemplatePageScheme represents a page of Confluence templates.
+type ContentTemplatePageScheme struct {
+	Results []*ContentTemplateScheme `json:"results,omitempty"` // The templates of the page.
+	Start   int                      `json:"start,omitempty"`   // The starting index of the page.
+	Limit   int                      `json:"limit,omitempty"`   // The maximum number of templates of the page.
+	Size    int                      `json:"size,omitempty"`    // The number of templates of the page.
+	Links   *LinkScheme              `json:"_links,omitempty"`  // The links of the page.
+}
+
+// ContentBlueprintDraftScheme represents the payload used to publish a draft created from a blueprint.
+type ContentBlueprintDraftScheme struct {
+	Version   *ContentBlueprintDraftVersionScheme `json:"version,omitempty"`   // The version of the draft, it must be 1.
+	Title     string                              `json:"title,omitempty"`     // The title of the content, it's required if the draft has no title.
+	Type      string                              `json:"type,omitempty"`      // The type of the content, "page" by default.
+	Status    string                              `json:"status,omitempty"`    // The status of the content, "current" by default.
+	Space     *SpaceScheme                        `json:"space,omitempty"`     // The space of the content.
+	Ancestors []*ContentScheme                    `json:"ancestors,omitempty"` // The parent content, only the first ancestor is used.
+}
+
+// ContentBlueprintDraftVersionScheme represents the version of a blueprint draft.
+type ContentBlueprintDraftVersionScheme struct {
+	Number int `json:"number,omitempty"` // The version number.
+}
@@ -0,0 +1,76 @@
+package models
+
+// WhiteboardCreatePayloadScheme represents the payload for creating a whiteboard in Confluence.
+type WhiteboardCreatePayloadScheme struct {
+	SpaceID     string `json:"spaceId"`               // The ID of the space of the whiteboard.
+	Title       string `json:"title,omitempty"`       // The title of the whiteboard.
+	ParentID    string `json:"parentId,omitempty"`    // The ID of the parent content, the whiteboard is created at the space root if it's not set.
+	TemplateKey string `json:"templateKey,omitempty"` // The key of the template applied to the whiteboard.
+	Locale      string `json:"locale,omitempty"`      // The locale of the template, e.g. en-US.
+}
+
+// WhiteboardScheme represents a whiteboard in Confluence.
+type WhiteboardScheme struct {
+	ID         string             `json:"id,omitempty"`         // The ID of the whiteboard.
+	Type       string             `json:"type,omitempty"`       // The type of the content, always whiteboard.
+	Status     string             `json:"status,omitempty"`     // The status of the whiteboard.
+	Title      string             `json:"title,omitempty"`      // The title of the whiteboard.
+	ParentID   string             `json:"parentId,omitempty"`   // The ID of the parent content.
+	ParentType string             `json:"parentType,omitempty"` // The type of the parent content.
+	Position   int                `json:"position,omitempty"`   // The position of the whiteboard among its siblings.
+	AuthorID   string             `json:"authorId,omitempty"`   // The account ID of the author.
+	OwnerID    string             `json:"ownerId,omitempty"`    // The account ID of the owner.
+	CreatedAt  string             `json:"createdAt,omitempty"`  // The creation date of the whiteboard.
+	SpaceID    string             `json:"spaceId,omitempty"`    // The ID of the space of the whiteboard.
+	Version    *PageVersionScheme `json:"version,omitempty"`    // The version of the whiteboard.
+}
+
+// DatabaseCreatePayloadScheme represents the payload for creating a database in Confluence.
+type DatabaseCreatePayloadScheme struct {
+	SpaceID  string `json:"spaceId"`            // The ID of the space of the database.
+	Title    string `json:"title,omitempty"`    // The title of the database.
+	ParentID string `json:"parentId,omitempty"` // The ID of the parent content, the database is created at the space root if it's not set.
+}
+
+// DatabaseScheme represents a database in Confluence.
+type DatabaseScheme struct {
+	ID         string             `json:"id,omitempty"`         // The ID of the database.
+	Type       string             `json:"type,omitempty"`       // The type of the content, always database.
+	Status     string             `json:"status,omitempty"`     // The status of the database.
+	Title      string             `json:"title,omitempty"`      // The title of the database.
+	ParentID   string             `json:"parentId,omitempty"`   // The ID of the parent content.
+	ParentType string             `json:"parentType,omitempty"` // The type of the parent content.
+	Position   int                `json:"position,omitempty"`   // The position of the database among its siblings.
+	AuthorID   string             `json:"authorId,omitempty"`   // The account ID of the author.
+	OwnerID    string             `json:"ownerId,omitempty"`    // The account ID of the owner.
+	CreatedAt  string             `json:"createdAt,omitempty"`  // The creation date of the database.
+	SpaceID    string             `json:"spaceId,omitempty"`    // The ID of the space of the database.
+	Version    *PageVersionScheme `json:"version,omitempty"`    // The version of the database.
+}
+
+// ContentAncestorsScheme represents the ancestors of a piece of content in the content tree, from the top level to the parent.
+type ContentAncestorsScheme struct {
+	Results []*ContentAncestorScheme `json:"results,omitempty"` // The ancestors of the content.
+}
+
+// ContentAncestorScheme represents an ancestor of a piece of content in the content tree.
+type ContentAncestorScheme struct {
+	ID   string `json:"id,omitempty"`   // The ID of the ancestor.
+	Type string `json:"type,omitempty"` // The type of the ancestor, e.g. page, whiteboard or database.
+}
+
+// ContentChildrenChunkScheme represents a chunk of the direct children of a piece of content in the content tree.
+type ContentChildrenChunkScheme struct {
+	Results []*ContentChildScheme      `json:"results,omitempty"` // The children in the chunk.
+	Links   *ChildPageChunkLinksScheme `json:"_links,omitempty"`  // The links of the chunk.
+}
+
+// ContentChildScheme represents a direct child of a piece of content in the content tree.
+type ContentChildScheme struct {
+	ID            string `json:"id,omitempty"`            // The ID of the child.
+	Type          string `json:"type,omitempty"`          // The type of the child, e.g. page, whiteboard or database.
+	Status        string `json:"status,omitempty"`        // The status of the child.
+	Title         string `json:"title,omitempty"`         // The title of the child.
+	SpaceID       string `json:"spaceId,omitempty"`       // The ID of the space of the child.
+	ChildPosition int    `json:"childPosition,omitempty"` // The position of the child among its siblings.
+}
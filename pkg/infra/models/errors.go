@@ -31,11 +31,20 @@ var (
 	ErrNoSLAMetricID                  = errors.New("sm: no sla metric id set")
 	ErrNoContentAttachmentID          = errors.New("confluence: no attachment id set")
 	ErrNoContentAttachmentName        = errors.New("confluence: no attachment filename set")
+	ErrNoAttachmentDownloadLink       = errors.New("confluence: no attachment download link found")
 	ErrNoContentReader                = errors.New("confluence: no reader set")
 	ErrNoContentID                    = errors.New("confluence: no content id set")
+	ErrNoContentTitle                 = errors.New("confluence: no content title set")
+	ErrContentNotFound                = errors.New("confluence: no content found")
+	ErrDuplicateContentTitle          = errors.New("confluence: several contents share the title")
+	ErrNoReactionEmoji                = errors.New("confluence: no reaction emoji set")
+	ErrNoContentStateID               = errors.New("confluence: no content state id set")
 	ErrNoCustomContentType            = errors.New("confluence: no custom content type set")
 	ErrNoCustomContentID              = errors.New("confluence: no custom content id set")
 	ErrNoPageID                       = errors.New("confluence: no page id set")
+	ErrNoBlogPostID                   = errors.New("confluence: no blog post id set")
+	ErrNoWhiteboardID                 = errors.New("confluence: no whiteboard id set")
+	ErrNoDatabaseID                   = errors.New("confluence: no database id set")
 	ErrNoSpaceID                      = errors.New("confluence: no space id set")
 	ErrNoTargetID                     = errors.New("confluence: no target id set")
 	ErrNoPosition                     = errors.New("confluence: no position set")
@@ -48,12 +57,35 @@ var (
 	ValidEntityValues                 = []string{"blogposts", "custom-content", "labels", "pages"}
 	ErrNoEntityValue                  = errors.New("confluence: no valid entity id set")
 	ErrNoContentLabel                 = errors.New("confluence: no content label set")
+	ErrNoContentWatcherAccountID      = errors.New("confluence: no watcher account id set")
+	ErrNoLabelPrefix                  = errors.New("confluence: no label prefix set")
 	ErrNoContentProperty              = errors.New("confluence: no content property set")
+	ErrInvalidContentProperty         = errors.New("confluence: invalid content property key")
+	ErrInvalidExpand                  = errors.New("confluence: invalid expand value")
+	ErrNoContentPropertyValue         = errors.New("confluence: no content property value set")
 	ErrNoSpaceName                    = errors.New("confluence: no space name set")
 	ErrNoSpaceKey                     = errors.New("confluence: no space key set")
+	ErrNoPermissionPrincipals         = errors.New("confluence: no permission template principals set")
+	ErrNoPermissionOperations         = errors.New("confluence: no permission template operations set")
+	ErrNoSyncPlan                     = errors.New("confluence: no synchronization plan set")
 	ErrNoContentRestrictionKey        = errors.New("confluence: no content restriction operation key set")
 	ErrNoConfluenceGroup              = errors.New("confluence: no group id or name set")
 	ErrNoLabelName                    = errors.New("confluence: no label name set")
+	ErrNoContentVersion               = errors.New("confluence: no content version number set")
+	ErrCurrentContentVersion          = errors.New("confluence: the current content version cannot be deleted")
+	ErrContentVersionNotFound         = errors.New("confluence: content version not found")
+	ErrNoTemplateID                   = errors.New("confluence: no template id set")
+	ErrNoTemplateStorageBody          = errors.New("confluence: no template storage body found")
+	ErrNoContentPayload               = errors.New("confluence: no content payload set")
+	ErrNoParentContentID              = errors.New("confluence: no parent content id set")
+	ErrParentSpaceMismatch            = errors.New("confluence: the parent content belongs to a different space")
+	ErrInvalidContentID               = errors.New("confluence: the content id is not numeric")
+	ErrUnsupportedContentType         = errors.New("confluence: the content type has no v2 equivalent")
+	ErrInvalidContentType             = errors.New("confluence: the content type must be page or blogpost")
+	ErrInvalidContentStatus           = errors.New("confluence: invalid content status")
+	ErrContentNotTrashed              = errors.New("confluence: the content is not trashed")
+	ErrNoFindText                     = errors.New("confluence: no text to find set")
+	ErrMalformedStorage               = errors.New("confluence: the storage format is malformed")
 	ErrNoBoardID                      = errors.New("agile: no board id set")
 	ErrNoFilterID                     = errors.New("agile: no filter id set")
 	ErrNoNotificationSchemeID         = errors.New("jira: no notification scheme id set")
@@ -62,27 +94,47 @@ var (
 	ErrNoSprintID                     = errors.New("agile: no sprint id set")
 	ErrNoApplicationRole              = errors.New("jira: no application role key set")
 	ErrNoDashboardID                  = errors.New("jira: no dashboard id set")
+	ErrNoTimeTrackingConfiguration    = errors.New("jira: no time tracking configuration set")
 	ErrNoGroupName                    = errors.New("jira: no group name set")
 	ErrNoGroupsName                   = errors.New("jira: no groups names set")
 	ErrNoIssueKeyOrID                 = errors.New("jira: no issue key/id set")
 	ErrNoRemoteLinkID                 = errors.New("jira: no remote link id set")
 	ErrNoRemoteLinkGlobalID           = errors.New("jira: no global remote link id set")
+	ErrNoRemoteLinkURL                = errors.New("jira: no remote link url set")
+	ErrNoRemoteLinkAppLinkID          = errors.New("jira: no remote link application link id set")
+	ErrNoRemoteLinkPageID             = errors.New("jira: no remote link confluence page id set")
 	ErrNoTransitionID                 = errors.New("jira: no transition id set")
+	ErrNoHistoryActivityDescription   = errors.New("jira: no history metadata activity description set")
+	ErrNoHistoryParticipantID         = errors.New("jira: no history metadata participant id set")
 	ErrNoAttachmentID                 = errors.New("jira: no attachment id set")
 	ErrNoAttachmentName               = errors.New("jira: no attachment filename set")
 	ErrNoReader                       = errors.New("jira: no reader set")
+	ErrNoAvatarType                   = errors.New("jira: no valid avatar type set")
+	ErrNoAvatarID                     = errors.New("jira: no avatar id set")
+	ErrNoAvatarOwnerID                = errors.New("jira: no avatar owner id set")
 	ErrNoCommentID                    = errors.New("jira: no comment id set")
 	ErrNoProjectID                    = errors.New("jira: no project id set")
 	ErrNoProjectIDOrKey               = errors.New("jira: no project id or key set")
 	ErrNoProjectRoleID                = errors.New("jira: no project role id set")
 	ErrNoProjectCategoryID            = errors.New("jira: no project category id set")
 	ErrNoPropertyKey                  = errors.New("jira: no property key set")
+	ErrInvalidPropertyKey             = errors.New("jira: the property key exceeds 255 characters")
+	ErrPropertyValueTooLarge          = errors.New("jira: the property value exceeds 32768 bytes")
+	ErrNoPropertyEntityID             = errors.New("jira: no property entity id set")
+	ErrInvalidPropertyEntity          = errors.New("jira: invalid property entity type, expected issue, project, comment or issuetype")
+	ErrPropertyVersionConflict        = errors.New("jira: the property version does not match the expected version")
+	ErrTooManyIssueProperties         = errors.New("jira: more than 5 issue properties requested")
 	ErrNoProjectFeatureKey            = errors.New("jira: no project feature key set")
 	ErrNoProjectFeatureState          = errors.New("jira: no project state key set")
 	ErrNoFieldID                      = errors.New("jira: no field id set")
 	ErrNoEditOperator                 = errors.New("jira: no update operation set")
+	ErrIssueFieldUpdateConflict       = errors.New("jira: the field is set on both the fields and the update operations")
+	ErrInvalidUpdateOperation         = errors.New("jira: the update operations of a field must be a []map[string]interface{}")
 	ErrNoOperator                     = errors.New("jira: no operation set")
 	ErrNoEditValue                    = errors.New("jira: no update operation value set")
+	ErrNoEditMetadata                 = errors.New("jira: no issue edit metadata set")
+	ErrFieldNotEditable               = errors.New("jira: field not available on the issue edit metadata")
+	ErrInvalidFieldOperation          = errors.New("jira: update operation not supported by the field")
 	ErrNoCustomField                  = errors.New("jira: no custom-fields set")
 	ErrNoCustomFieldID                = errors.New("jira: no custom-field id set")
 	ErrNoWorkflowStatuses             = errors.New("jira: no workflow statuses set")
@@ -97,10 +149,19 @@ var (
 	ErrNoPriorityID                   = errors.New("jira: no priority id set")
 	ErrNoResolutionID                 = errors.New("jira: no resolution id set")
 	ErrNoJQL                          = errors.New("jira: no sql set")
+	ErrNoBulkEditFields               = errors.New("jira: no bulk edit fields set")
+	ErrBulkEditLimitExceeded          = errors.New("jira: the query matches more issues than the bulk edit limit")
+	ErrNoBulkMoveTargets              = errors.New("jira: no bulk move targets set")
+	ErrNoBulkMoveIssues               = errors.New("jira: no issues set on the bulk move target")
+	ErrBulkMoveLimitExceeded          = errors.New("jira: the bulk move exceeds the issues limit")
+	ErrBulkFetchLimitExceeded         = errors.New("jira: the bulk fetch is limited to 100 issues")
+	ErrNoSubtaskKeys                  = errors.New("jira: no subtask keys set")
+	ErrNotSubtaskOfParent             = errors.New("jira: the issue is not a subtask of the parent")
 	ErrNoIssueTypeID                  = errors.New("jira: no issue type id set")
 	ErrNoIssueTypeScreenSchemeID      = errors.New("jira: no issue type screen scheme id set")
 	ErrNoScreenSchemeID               = errors.New("jira: no screen scheme id set")
 	ErrNoAccountID                    = errors.New("jira: no account id set")
+	ErrNoAssignableAccount            = errors.New("jira: no assignable account left on the pool")
 	ErrNoWorklogID                    = errors.New("jira: no worklog id set")
 	ErrNpWorklogs                     = errors.New("jira: no worklog's id set")
 	ErrNoPermissionSchemeID           = errors.New("jira: no permission scheme id set")
@@ -131,9 +192,21 @@ var (
 	ErrInvalidStatusCode              = errors.New("client: invalid http response status, please refer the response.body for more details")
 	ErrNotFound                       = errors.New("client: no atlassian resource found")
 	ErrUnauthorized                   = errors.New("client: atlassian insufficient permissions")
+	ErrForbidden                      = errors.New("client: atlassian forbidden resource")
+	ErrConflict                       = errors.New("client: atlassian conflicting resource state")
 	ErrInternal                       = errors.New("client: atlassian internal error")
 	ErrBadRequest                     = errors.New("client: atlassian invalid payload")
 	ErrNoSite                         = errors.New("client: no atlassian site set")
+	ErrNoReplayableBody               = errors.New("client: the request body cannot be replayed")
+	ErrNoClientConfig                 = errors.New("client: no client configuration set")
+	ErrNilPayload                     = errors.New("client: no payload set")
+	ErrNoAccessToken                  = errors.New("client: no access token set")
+	ErrNoStreamArray                  = errors.New("client: no json array to stream found")
+	ErrNoResponse                     = errors.New("client: no http response set")
+	ErrRetryBudgetExhausted           = errors.New("client: the retry budget is exhausted")
+	ErrTaskFailed                     = errors.New("client: the asynchronous task failed")
+	ErrNoConnectSharedSecret          = errors.New("client: no connect shared secret set")
+	ErrNoConnectIssuer                = errors.New("client: no connect issuer set")
 	ErrNoFloatType                    = errors.New("custom-field: no float type set")
 	ErrNoSprintType                   = errors.New("custom-field: no sprint type found")
 	ErrNoMultiVersionType             = errors.New("custom-field: no multiversion type found")
@@ -172,6 +245,10 @@ var (
 	ErrNoMemberID                     = errors.New("bitbucket: no member id set")
 	ErrNoWebhookID                    = errors.New("bitbucket: no webhook id set")
 	ErrNoRepository                   = errors.New("bitbucket: no repository set")
+	ErrNoBranchName                   = errors.New("bitbucket: no branch name set")
+	ErrNoBranchTarget                 = errors.New("bitbucket: no branch target hash set")
+	ErrNoBranchRestrictionID          = errors.New("bitbucket: no branch restriction id set")
+	ErrNoBranchRestrictionKind        = errors.New("bitbucket: no branch restriction kind set")
 	ErrNoKeyError                     = errors.New("jira: no key set")
 
 	ErrNoIssueTypeReorderAttr         = errors.New("no position or after attribute set for issue type scheme reorder. one must be set")
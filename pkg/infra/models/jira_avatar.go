@@ -7,3 +7,27 @@ type AvatarURLScheme struct {
 	One6X16   string `json:"16x16,omitempty"` // The URL for the 16x16 size of the avatar.
 	Three2X32 string `json:"32x32,omitempty"` // The URL for the 32x32 size of the avatar.
 }
+
+// The avatar types supported by the universal avatar endpoints.
+const (
+	AvatarTypeProject   = "project"   // The avatar of a project.
+	AvatarTypeIssueType = "issuetype" // The avatar of an issue type.
+	AvatarTypePriority  = "priority"  // The avatar of a priority.
+)
+
+// AvatarsScheme represents the system and custom avatars available for an entity in Jira.
+type AvatarsScheme struct {
+	System []*AvatarScheme `json:"system,omitempty"` // The system avatars.
+	Custom []*AvatarScheme `json:"custom,omitempty"` // The custom avatars.
+}
+
+// AvatarScheme represents an avatar in Jira.
+type AvatarScheme struct {
+	ID             string           `json:"id,omitempty"`             // The ID of the avatar.
+	Owner          string           `json:"owner,omitempty"`          // The owner of the avatar, e.g. the project ID.
+	IsSystemAvatar bool             `json:"isSystemAvatar,omitempty"` // Indicates if the avatar is a system avatar.
+	IsSelected     bool             `json:"isSelected,omitempty"`     // Indicates if the avatar is used by the entity.
+	IsDeletable    bool             `json:"isDeletable,omitempty"`    // Indicates if the avatar can be deleted.
+	FileName       string           `json:"fileName,omitempty"`       // The file name of the avatar icon, only returned for the system avatars.
+	Urls           *AvatarURLScheme `json:"urls,omitempty"`           // The URLs of the avatar in the different sizes.
+}
@@ -8,6 +8,17 @@ type IssueChangelogScheme struct {
 	Histories  []*IssueChangelogHistoryScheme `json:"histories,omitempty"`  // The history of changes in the changelog.
 }
 
+// IssueChangelogPageScheme represents a page of an issue's changelog in Jira.
+type IssueChangelogPageScheme struct {
+	Self       string                         `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string                         `json:"nextPage,omitempty"`   // The URL of the next page.
+	StartAt    int                            `json:"startAt,omitempty"`    // The starting index of the page.
+	MaxResults int                            `json:"maxResults,omitempty"` // The maximum number of results in the page.
+	Total      int                            `json:"total,omitempty"`      // The total number of changes in the changelog.
+	IsLast     bool                           `json:"isLast,omitempty"`     // Indicates if this is the last page.
+	Values     []*IssueChangelogHistoryScheme `json:"values,omitempty"`     // The history of changes in the page.
+}
+
 // IssueChangelogHistoryScheme represents a history of changes in an issue's changelog in Jira.
 type IssueChangelogHistoryScheme struct {
 	ID      string                             `json:"id,omitempty"`      // The ID of the history.
@@ -1,6 +1,8 @@
 // Package models provides the data structures used in the admin package.
 package models
 
+import "strings"
+
 // CommentNodeScheme represents a node in a comment.
 type CommentNodeScheme struct {
 	Version int                    `json:"version,omitempty"` // The version of the node.
@@ -16,6 +18,26 @@ func (n *CommentNodeScheme) AppendNode(node *CommentNodeScheme) {
 	n.Content = append(n.Content, node)
 }
 
+// NewCommentTextBody wraps a plain text in a minimal ADF document, every line of the text is mapped to a paragraph.
+func NewCommentTextBody(text string) *CommentNodeScheme {
+
+	document := &CommentNodeScheme{Version: 1, Type: "doc"}
+
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+
+		paragraph := &CommentNodeScheme{Type: "paragraph"}
+
+		// The empty text nodes are rejected by Jira, the empty lines are mapped to empty paragraphs.
+		if line != "" {
+			paragraph.AppendNode(&CommentNodeScheme{Type: "text", Text: line})
+		}
+
+		document.AppendNode(paragraph)
+	}
+
+	return document
+}
+
 // MarkScheme represents a mark in a comment.
 type MarkScheme struct {
 	Type  string                 `json:"type,omitempty"`  // The type of the mark.
@@ -0,0 +1,20 @@
+package models
+
+// AssigneeAutomatic is the accountID used to assign an issue to the default assignee of the project.
+//
+// A nil accountID sets the issue as unassigned, while any other value assigns the issue to that user.
+const AssigneeAutomatic = "-1"
+
+// AutomaticAssignee returns a new pointer to AssigneeAutomatic, ready to be passed to the issue Assign methods.
+func AutomaticAssignee() *string {
+	accountID := AssigneeAutomatic
+	return &accountID
+}
+
+// IssueAssignBulkResultScheme represents the result of assigning many issues to a pool of users in Jira.
+type IssueAssignBulkResultScheme struct {
+	Total    int                 `json:"total"`    // The number of issues requested to be assigned.
+	Assigned map[string]string   `json:"assigned"` // The account ID assigned to every issue assigned, keyed by the issue key.
+	Skipped  map[string][]string `json:"skipped"`  // The account IDs skipped because they cannot be assigned to the issues of a project, keyed by the project key.
+	Errors   map[string]error    `json:"-"`        // The errors returned by the issues which could not be assigned, keyed by the issue key.
+}
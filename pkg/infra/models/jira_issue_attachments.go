@@ -49,3 +49,10 @@ type IssueAttachmentHumanMetadataEntryScheme struct {
 	MediaType string `json:"mediaType,omitempty"` // The media type of the entry.
 	Label     string `json:"label,omitempty"`     // The label of the entry.
 }
+
+// IssueAttachmentDeleteBulkScheme represents the result of deleting many attachments in Jira.
+type IssueAttachmentDeleteBulkScheme struct {
+	Total   int              `json:"total"`   // The number of attachments requested to be deleted.
+	Deleted []string         `json:"deleted"` // The IDs of the attachments deleted.
+	Errors  map[string]error `json:"-"`       // The errors returned by the attachments which could not be deleted, keyed by the attachment ID.
+}
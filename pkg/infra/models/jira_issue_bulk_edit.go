@@ -0,0 +1,92 @@
+package models
+
+// BulkEditFieldsScheme represents the payload of the issue fields bulk edit.
+type BulkEditFieldsScheme struct {
+	SelectedActions        []string                   `json:"selectedActions"`                // The IDs of the fields to edit, e.g. "labels", "assignee" or "priority".
+	SelectedIssueIdsOrKeys []string                   `json:"selectedIssueIdsOrKeys"`         // The IDs or keys of the issues to edit, it's filled with the issues matching the JQL query.
+	EditedFieldsInput      *BulkEditFieldsInputScheme `json:"editedFieldsInput"`              // The new values of the selected fields.
+	SendBulkNotification   *bool                      `json:"sendBulkNotification,omitempty"` // Indicates if a bulk change notification email is sent, true by default.
+}
+
+// BulkEditFieldsInputScheme represents the new values of the fields edited in bulk.
+type BulkEditFieldsInputScheme struct {
+	LabelsFields                          []*BulkEditLabelsFieldScheme      `json:"labelsFields,omitempty"`                          // The labels fields.
+	SingleSelectClearableUserPickerFields []*BulkEditUserPickerFieldScheme  `json:"singleSelectClearableUserPickerFields,omitempty"` // The single user picker fields, e.g. the assignee.
+	MultipleSelectFields                  []*BulkEditMultiSelectFieldScheme `json:"multipleSelectFields,omitempty"`                  // The multi select fields.
+	SingleLineTextFields                  []*BulkEditTextFieldScheme        `json:"singleLineTextFields,omitempty"`                  // The single line text fields.
+	ClearableNumberFields                 []*BulkEditNumberFieldScheme      `json:"clearableNumberFields,omitempty"`                 // The number fields.
+	Priority                              *BulkEditPriorityFieldScheme      `json:"priority,omitempty"`                              // The priority.
+}
+
+// BulkEditLabelsFieldScheme represents the new value of a labels field edited in bulk.
+type BulkEditLabelsFieldScheme struct {
+	FieldID                        string                `json:"fieldId"`                        // The ID of the field, e.g. "labels".
+	BulkEditMultiSelectFieldOption string                `json:"bulkEditMultiSelectFieldOption"` // The operation, "ADD", "REMOVE", "REPLACE" or "REMOVE_ALL".
+	Labels                         []*BulkEditNameScheme `json:"labels"`                         // The labels.
+}
+
+// BulkEditNameScheme represents a value identified by its name.
+type BulkEditNameScheme struct {
+	Name string `json:"name"` // The name of the value.
+}
+
+// BulkEditUserPickerFieldScheme represents the new value of a user picker field edited in bulk.
+type BulkEditUserPickerFieldScheme struct {
+	FieldID string                   `json:"fieldId"` // The ID of the field, e.g. "assignee".
+	User    *BulkEditAccountIDScheme `json:"user"`    // The user, nil clears the field.
+}
+
+// BulkEditAccountIDScheme represents a user identified by its account ID.
+type BulkEditAccountIDScheme struct {
+	AccountID string `json:"accountId"` // The account ID of the user.
+}
+
+// BulkEditMultiSelectFieldScheme represents the new value of a multi select field edited in bulk.
+type BulkEditMultiSelectFieldScheme struct {
+	FieldID                        string                    `json:"fieldId"`                        // The ID of the field.
+	BulkEditMultiSelectFieldOption string                    `json:"bulkEditMultiSelectFieldOption"` // The operation, "ADD", "REMOVE", "REPLACE" or "REMOVE_ALL".
+	Options                        []*BulkEditOptionIDScheme `json:"options"`                        // The options.
+}
+
+// BulkEditOptionIDScheme represents an option identified by its ID.
+type BulkEditOptionIDScheme struct {
+	OptionID int `json:"optionId"` // The ID of the option.
+}
+
+// BulkEditTextFieldScheme represents the new value of a text field edited in bulk.
+type BulkEditTextFieldScheme struct {
+	FieldID string `json:"fieldId"` // The ID of the field.
+	Text    string `json:"text"`    // The text.
+}
+
+// BulkEditNumberFieldScheme represents the new value of a number field edited in bulk.
+type BulkEditNumberFieldScheme struct {
+	FieldID string   `json:"fieldId"` // The ID of the field.
+	Value   *float64 `json:"value"`   // The number, nil clears the field.
+}
+
+// BulkEditPriorityFieldScheme represents the new priority of the issues edited in bulk.
+type BulkEditPriorityFieldScheme struct {
+	PriorityID string `json:"priorityId"` // The ID of the priority.
+}
+
+// BulkEditTaskScheme represents the asynchronous task processing a bulk edit.
+type BulkEditTaskScheme struct {
+	TaskID string `json:"taskId,omitempty"` // The ID of the task, used to track the progress with Issue.BulkProgress or Issue.AwaitBulk.
+	Issues int    `json:"-"`                // The number of issues submitted to the bulk edit.
+}
+
+// BulkOperationProgressScheme represents the progress of a bulk operation queued on Jira, e.g. a bulk edit or a bulk move.
+type BulkOperationProgressScheme struct {
+	TaskID                          string              `json:"taskId,omitempty"`                          // The ID of the task.
+	Status                          string              `json:"status,omitempty"`                          // The status, ENQUEUED, RUNNING, COMPLETE, FAILED, CANCEL_REQUESTED, CANCELLED or DEAD.
+	ProgressPercent                 int                 `json:"progressPercent,omitempty"`                 // The progress of the task, in percent.
+	SubmittedBy                     *UserScheme         `json:"submittedBy,omitempty"`                     // The user who submitted the task.
+	Created                         string              `json:"created,omitempty"`                         // The date the task was created.
+	Started                         string              `json:"started,omitempty"`                         // The date the task started.
+	Updated                         string              `json:"updated,omitempty"`                         // The date the task was last updated.
+	TotalIssueCount                 int                 `json:"totalIssueCount,omitempty"`                 // The number of issues submitted.
+	ProcessedAccessibleIssues       []int               `json:"processedAccessibleIssues,omitempty"`       // The IDs of the issues processed.
+	FailedAccessibleIssues          map[string][]string `json:"failedAccessibleIssues,omitempty"`          // The errors of the issues that failed, keyed by the issue ID.
+	InvalidOrInaccessibleIssueCount int                 `json:"invalidOrInaccessibleIssueCount,omitempty"` // The number of issues invalid or not accessible.
+}
@@ -0,0 +1,17 @@
+package models
+
+// IssueBulkFetchPayloadScheme represents the payload to fetch many issues by their IDs or keys.
+type IssueBulkFetchPayloadScheme struct {
+	IssueIdsOrKeys []string `json:"issueIdsOrKeys"`          // The IDs or keys of the issues, up to 100.
+	Fields         []string `json:"fields,omitempty"`        // The fields returned, all the navigable fields are returned by default.
+	FieldsByKeys   bool     `json:"fieldsByKeys,omitempty"`  // Indicates if the fields are referenced by their keys instead of their IDs.
+	Expand         []string `json:"expand,omitempty"`        // The additional information to include, e.g. renderedFields or changelog.
+	Properties     []string `json:"properties,omitempty"`    // The issue properties returned, up to 5.
+	UpdateHistory  bool     `json:"updateHistory,omitempty"` // Indicates if the issues are added to the user's recently viewed issues.
+}
+
+// IssueBulkFetchErrorScheme represents the error of an issue not fetched.
+type IssueBulkFetchErrorScheme struct {
+	ID           string `json:"id,omitempty"`           // The ID or key of the issue.
+	ErrorMessage string `json:"errorMessage,omitempty"` // The reason the issue was not fetched, e.g. it doesn't exist or isn't visible.
+}
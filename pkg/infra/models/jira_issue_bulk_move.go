@@ -0,0 +1,59 @@
+package models
+
+import "strings"
+
+// IssueBulkMovePayloadScheme represents the payload to move issues between projects and issue types.
+type IssueBulkMovePayloadScheme struct {
+	SendBulkNotification   *bool                                 `json:"sendBulkNotification,omitempty"` // Indicates if the bulk change notification email is sent, true by default.
+	TargetToSourcesMapping map[string]*IssueBulkMoveTargetScheme `json:"targetToSourcesMapping"`         // The issues moved keyed by the target, see IssueBulkMoveTarget.
+}
+
+// IssueBulkMoveTarget builds the key of a bulk move target, the parent is only required to move sub-tasks.
+func IssueBulkMoveTarget(projectKeyOrID, issueTypeID, parentKeyOrID string) string {
+
+	target := []string{projectKeyOrID, issueTypeID}
+	if parentKeyOrID != "" {
+		target = append(target, parentKeyOrID)
+	}
+
+	return strings.Join(target, ",")
+}
+
+// IssueBulkMoveTargetScheme represents the issues moved to a target project and issue type, with the field and status mappings.
+type IssueBulkMoveTargetScheme struct {
+	IssueIdsOrKeys              []string                              `json:"issueIdsOrKeys"`                  // The IDs or keys of the issues moved to the target.
+	InferClassificationDefaults bool                                  `json:"inferClassificationDefaults"`     // Indicates if the default classification is used when it's not mapped.
+	InferFieldDefaults          bool                                  `json:"inferFieldDefaults"`              // Indicates if the default values are used for the required fields not mapped.
+	InferStatusDefaults         bool                                  `json:"inferStatusDefaults"`             // Indicates if the default status is used for the statuses not mapped.
+	InferSubtaskTypeDefault     bool                                  `json:"inferSubtaskTypeDefault"`         // Indicates if the default sub-task type is used.
+	TargetClassification        []*IssueBulkMoveClassificationScheme  `json:"targetClassification,omitempty"`  // The classification mappings.
+	TargetMandatoryFields       []*IssueBulkMoveMandatoryFieldsScheme `json:"targetMandatoryFields,omitempty"` // The values of the fields required by the target.
+	TargetStatus                []*IssueBulkMoveStatusMappingScheme   `json:"targetStatus,omitempty"`          // The status mappings.
+}
+
+// IssueBulkMoveClassificationScheme represents the classification mappings of a bulk move.
+type IssueBulkMoveClassificationScheme struct {
+	Classifications map[string][]string `json:"classifications,omitempty"` // The source classification IDs keyed by the target classification ID.
+}
+
+// IssueBulkMoveMandatoryFieldsScheme represents the values of the fields required by the target of a bulk move.
+type IssueBulkMoveMandatoryFieldsScheme struct {
+	Fields map[string]*IssueBulkMoveFieldValueScheme `json:"fields,omitempty"` // The field values keyed by the field ID.
+}
+
+// IssueBulkMoveFieldValueScheme represents the value of a field required by the target of a bulk move.
+type IssueBulkMoveFieldValueScheme struct {
+	Retain bool        `json:"retain"`          // Indicates if the current value of the issue is retained, if it's valid on the target.
+	Type   string      `json:"type,omitempty"`  // The value format, raw or adf.
+	Value  interface{} `json:"value,omitempty"` // The value, e.g. a list of option IDs.
+}
+
+// IssueBulkMoveStatusMappingScheme represents the status mappings of a bulk move.
+type IssueBulkMoveStatusMappingScheme struct {
+	Statuses map[string][]string `json:"statuses,omitempty"` // The source status IDs keyed by the target status ID.
+}
+
+// IssueBulkMoveTaskScheme represents the asynchronous task processing a bulk move.
+type IssueBulkMoveTaskScheme struct {
+	TaskID string `json:"taskId,omitempty"` // The ID of the task, used to track the progress with Issue.BulkProgress or Issue.AwaitBulk.
+}
@@ -0,0 +1,54 @@
+package models
+
+import "fmt"
+
+// HistoryMetadataScheme represents the metadata stored on the issue history entry of a change, e.g. to attribute
+// the transitions performed by an integration to the system triggering them.
+type HistoryMetadataScheme struct {
+	Type                   string                            `json:"type,omitempty"`                   // The type of the history record.
+	Description            string                            `json:"description,omitempty"`            // The description of the history record.
+	DescriptionKey         string                            `json:"descriptionKey,omitempty"`         // The description key of the history record.
+	ActivityDescription    string                            `json:"activityDescription,omitempty"`    // The activity described in the history record.
+	ActivityDescriptionKey string                            `json:"activityDescriptionKey,omitempty"` // The key of the activity described in the history record.
+	EmailDescription       string                            `json:"emailDescription,omitempty"`       // The description of the email address associated with the history record.
+	EmailDescriptionKey    string                            `json:"emailDescriptionKey,omitempty"`    // The description key of the email address associated with the history record.
+	Actor                  *HistoryMetadataParticipantScheme `json:"actor,omitempty"`                  // The user or system performing the change.
+	Generator              *HistoryMetadataParticipantScheme `json:"generator,omitempty"`              // The system generating the change.
+	Cause                  *HistoryMetadataParticipantScheme `json:"cause,omitempty"`                  // The event causing the change.
+	ExtraData              map[string]string                 `json:"extraData,omitempty"`              // The additional information about the change.
+}
+
+// HistoryMetadataParticipantScheme represents a participant of the history metadata, e.g. the actor or the generator.
+type HistoryMetadataParticipantScheme struct {
+	ID             string `json:"id,omitempty"`             // The ID of the participant.
+	DisplayName    string `json:"displayName,omitempty"`    // The display name of the participant.
+	DisplayNameKey string `json:"displayNameKey,omitempty"` // The key of the display name of the participant.
+	Type           string `json:"type,omitempty"`           // The type of the participant.
+	AvatarURL      string `json:"avatarUrl,omitempty"`      // The URL to an avatar for the participant.
+	URL            string `json:"url,omitempty"`            // The URL of the participant details.
+}
+
+// Validate checks the history metadata describes the activity, and every participant provided is identified.
+func (h *HistoryMetadataScheme) Validate() error {
+
+	if h.ActivityDescription == "" && h.ActivityDescriptionKey == "" {
+		return ErrNoHistoryActivityDescription
+	}
+
+	participants := []struct {
+		role        string
+		participant *HistoryMetadataParticipantScheme
+	}{
+		{"actor", h.Actor},
+		{"generator", h.Generator},
+		{"cause", h.Cause},
+	}
+
+	for _, current := range participants {
+		if current.participant != nil && current.participant.ID == "" {
+			return fmt.Errorf("%w: %v", ErrNoHistoryParticipantID, current.role)
+		}
+	}
+
+	return nil
+}
@@ -8,3 +8,71 @@ type IssueMetadataCreateOptions struct {
 	IssueTypeNames []string // The names of the issue types.
 	Expand         string   // The fields to be expanded in the issue metadata.
 }
+
+// IssueEditMetadataScheme represents the edit screen fields for an issue that are visible to and editable by the user.
+type IssueEditMetadataScheme struct {
+	Fields map[string]*IssueFieldMetadataScheme `json:"fields,omitempty"` // The fields on the edit screen, keyed by field ID.
+}
+
+// IssueFieldMetadataScheme represents the metadata of a field available on an issue screen.
+type IssueFieldMetadataScheme struct {
+	Required        bool                    `json:"required,omitempty"`        // Indicates if the field is required.
+	Schema          *IssueFieldSchemaScheme `json:"schema,omitempty"`          // The data type of the field.
+	Name            string                  `json:"name,omitempty"`            // The name of the field.
+	Key             string                  `json:"key,omitempty"`             // The key of the field.
+	FieldID         string                  `json:"fieldId,omitempty"`         // The ID of the field.
+	AutoCompleteURL string                  `json:"autoCompleteUrl,omitempty"` // The URL that can be used to automatically complete the field.
+	HasDefaultValue bool                    `json:"hasDefaultValue,omitempty"` // Indicates if the field has a default value.
+	Operations      []string                `json:"operations,omitempty"`      // The list of operations that can be performed on the field.
+	AllowedValues   []interface{}           `json:"allowedValues,omitempty"`   // The list of values allowed in the field.
+	DefaultValue    interface{}             `json:"defaultValue,omitempty"`    // The default value of the field.
+}
+
+// SupportsOperation reports whether the field supports the provided update operation (set, add, remove, edit, copy).
+func (f *IssueFieldMetadataScheme) SupportsOperation(operation string) bool {
+
+	for _, supported := range f.Operations {
+		if supported == operation {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IssueCreateMetaOptions represents the options to get the create field metadata of an issue type in a project.
+type IssueCreateMetaOptions struct {
+	ProjectKeyOrID string // The key or ID of the project.
+	IssueTypeID    string // The ID of the issue type.
+	StartAt        int    // The index of the first field to return.
+	MaxResults     int    // The maximum number of fields to return, 50 by default.
+}
+
+// IssueCreateMetaIssueTypePageScheme represents a page of the issue types available to create issues in a project.
+type IssueCreateMetaIssueTypePageScheme struct {
+	StartAt    int                `json:"startAt,omitempty"`    // The index of the first issue type returned.
+	MaxResults int                `json:"maxResults,omitempty"` // The maximum number of issue types returned.
+	Total      int                `json:"total,omitempty"`      // The total number of issue types.
+	IssueTypes []*IssueTypeScheme `json:"issueTypes,omitempty"` // The issue types.
+}
+
+// IssueCreateMetaFieldPageScheme represents a page of the fields available to create issues of an issue type in a project.
+type IssueCreateMetaFieldPageScheme struct {
+	StartAt    int                         `json:"startAt,omitempty"`    // The index of the first field returned.
+	MaxResults int                         `json:"maxResults,omitempty"` // The maximum number of fields returned.
+	Total      int                         `json:"total,omitempty"`      // The total number of fields.
+	Fields     []*IssueFieldMetadataScheme `json:"fields,omitempty"`     // The fields, with their required flags and allowed values.
+}
+
+// Required returns the fields required to create an issue.
+func (p *IssueCreateMetaFieldPageScheme) Required() []*IssueFieldMetadataScheme {
+
+	var required []*IssueFieldMetadataScheme
+	for _, field := range p.Fields {
+		if field.Required {
+			required = append(required, field)
+		}
+	}
+
+	return required
+}
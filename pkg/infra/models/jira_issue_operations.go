@@ -1,5 +1,7 @@
 package models
 
+import "fmt"
+
 // UpdateOperations represents a collection of update operations.
 // Fields is a slice of maps, each containing a string key and an interface{} value.
 type UpdateOperations struct{ Fields []map[string]interface{} }
@@ -106,3 +108,28 @@ func (u *UpdateOperations) AddMultiRawOperation(customFieldID string, mappings [
 	u.Fields = append(u.Fields, updateNode)
 	return nil
 }
+
+// Update returns the "update" block of the operations, keyed by the field ID.
+// The operations of the same field are joined in order, and ErrInvalidUpdateOperation is returned if the operations
+// of a field are not a []map[string]interface{}.
+func (u *UpdateOperations) Update() (map[string]interface{}, error) {
+
+	update := make(map[string]interface{})
+
+	for _, node := range u.Fields {
+
+		fieldNode, _ := node["update"].(map[string]interface{})
+		for fieldID, fieldOperations := range fieldNode {
+
+			added, ok := fieldOperations.([]map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%w: %v", ErrInvalidUpdateOperation, fieldID)
+			}
+
+			joined, _ := update[fieldID].([]map[string]interface{})
+			update[fieldID] = append(joined, added...)
+		}
+	}
+
+	return update, nil
+}
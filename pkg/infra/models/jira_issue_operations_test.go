@@ -221,3 +221,51 @@ func TestUpdateOperations_AddMultiRawOperation(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateOperations_Update(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		fields  []map[string]interface{}
+		want    map[string]interface{}
+		wantErr bool
+		Err     error
+	}{
+		{
+			name: "when the operations of a field are joined",
+			fields: []map[string]interface{}{
+				{"update": map[string]interface{}{"labels": []map[string]interface{}{{"add": "triaged"}}}},
+				{"update": map[string]interface{}{"labels": []map[string]interface{}{{"remove": "new"}}}},
+				{"update": map[string]interface{}{"summary": []map[string]interface{}{{"set": "Updated summary"}}}},
+			},
+			want: map[string]interface{}{
+				"labels":  []map[string]interface{}{{"add": "triaged"}, {"remove": "new"}},
+				"summary": []map[string]interface{}{{"set": "Updated summary"}},
+			},
+		},
+
+		{
+			name: "when the operations of a field are not a list",
+			fields: []map[string]interface{}{
+				{"update": map[string]interface{}{"labels": map[string]interface{}{"add": "triaged"}}},
+			},
+			wantErr: true,
+			Err:     fmt.Errorf("%w: labels", ErrInvalidUpdateOperation),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+
+			u := &UpdateOperations{Fields: tt.fields}
+
+			got, err := u.Update()
+
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.Err.Error())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"unicode/utf8"
+)
+
+const (
+	// issuePropertyKeyMaxLength is the maximum number of characters of an entity property key.
+	issuePropertyKeyMaxLength = 255
+
+	// issuePropertyValueMaxSize is the maximum size, in bytes, of the JSON representation of an entity property value.
+	issuePropertyValueMaxSize = 32768
+)
+
+// IssuePropertiesScheme represents the entity properties set atomically when an issue is created or updated.
+//
+// It's sent as an array of key/value pairs, but it also accepts the key/value object returned
+// when the issue is fetched with the properties, in that case the properties are sorted by key.
+type IssuePropertiesScheme []*EntityPropertyScheme
+
+// UnmarshalJSON decodes the properties from both the array and the object representations.
+func (p *IssuePropertiesScheme) UnmarshalJSON(data []byte) error {
+
+	var properties []*EntityPropertyScheme
+	if err := json.Unmarshal(data, &properties); err == nil {
+		*p = properties
+		return nil
+	}
+
+	values := make(map[string]interface{})
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	properties = make([]*EntityPropertyScheme, 0, len(keys))
+	for _, key := range keys {
+		properties = append(properties, &EntityPropertyScheme{Key: key, Value: values[key]})
+	}
+
+	*p = properties
+	return nil
+}
+
+// Validate checks the properties against the Jira limits, the keys are required and limited to 255 characters
+// and the values are limited to 32768 bytes.
+func (p IssuePropertiesScheme) Validate() error {
+
+	for _, property := range p {
+
+		if property == nil || property.Key == "" {
+			return ErrNoPropertyKey
+		}
+
+		if utf8.RuneCountInString(property.Key) > issuePropertyKeyMaxLength {
+			return fmt.Errorf("%w: %v", ErrInvalidPropertyKey, property.Key)
+		}
+
+		value, err := json.Marshal(property.Value)
+		if err != nil {
+			return err
+		}
+
+		if len(value) > issuePropertyValueMaxSize {
+			return fmt.Errorf("%w: %v", ErrPropertyValueTooLarge, property.Key)
+		}
+	}
+
+	return nil
+}
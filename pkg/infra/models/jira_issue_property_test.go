@@ -0,0 +1,105 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssuePropertiesScheme_UnmarshalJSON(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		data    string
+		want    IssuePropertiesScheme
+		wantErr bool
+	}{
+		{
+			name: "when the properties are an array",
+			data: `{"properties":[{"key":"com.example.sync","value":{"source":"crm"}}]}`,
+			want: IssuePropertiesScheme{
+				{Key: "com.example.sync", Value: map[string]interface{}{"source": "crm"}},
+			},
+		},
+
+		{
+			name: "when the properties are a key/value object",
+			data: `{"properties":{"com.example.sync":{"source":"crm"},"com.example.audit":true}}`,
+			want: IssuePropertiesScheme{
+				{Key: "com.example.audit", Value: true},
+				{Key: "com.example.sync", Value: map[string]interface{}{"source": "crm"}},
+			},
+		},
+
+		{
+			name:    "when the properties are not valid",
+			data:    `{"properties":"com.example.sync"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			issue := new(IssueScheme)
+			err := json.Unmarshal([]byte(testCase.data), issue)
+
+			if testCase.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.want, issue.Properties)
+		})
+	}
+}
+
+func TestIssuePropertiesScheme_Validate(t *testing.T) {
+
+	testCases := []struct {
+		name       string
+		properties IssuePropertiesScheme
+		Err        error
+	}{
+		{
+			name:       "when the properties are valid",
+			properties: IssuePropertiesScheme{{Key: "com.example.sync", Value: map[string]interface{}{"source": "crm"}}},
+		},
+
+		{
+			name:       "when the property key is not provided",
+			properties: IssuePropertiesScheme{{Value: "value"}},
+			Err:        ErrNoPropertyKey,
+		},
+
+		{
+			name:       "when the property key exceeds the limit",
+			properties: IssuePropertiesScheme{{Key: strings.Repeat("k", 256), Value: "value"}},
+			Err:        ErrInvalidPropertyKey,
+		},
+
+		{
+			name:       "when the property value exceeds the limit",
+			properties: IssuePropertiesScheme{{Key: "com.example.sync", Value: strings.Repeat("v", 32768)}},
+			Err:        ErrPropertyValueTooLarge,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			err := testCase.properties.Validate()
+
+			if testCase.Err != nil {
+				assert.True(t, errors.Is(err, testCase.Err))
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
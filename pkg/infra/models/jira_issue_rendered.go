@@ -0,0 +1,42 @@
+package models
+
+// IssueRenderedScheme represents an issue fetched with the renderedFields expand, along with its raw fields.
+//
+// The rich-text fields, e.g. the description or the environment, are rendered as HTML on the RenderedFields map,
+// the fields without a rendered form, e.g. the labels, are only available on the Fields map.
+type IssueRenderedScheme struct {
+	ID             string                 `json:"id,omitempty"`             // The ID of the issue.
+	Key            string                 `json:"key,omitempty"`            // The key of the issue.
+	Self           string                 `json:"self,omitempty"`           // The URL of the issue.
+	Fields         map[string]interface{} `json:"fields,omitempty"`         // The raw values of the fields, keyed by field ID.
+	RenderedFields map[string]interface{} `json:"renderedFields,omitempty"` // The HTML rendered values of the fields, keyed by field ID.
+}
+
+// Raw returns the raw value of the field, it reports false if the field wasn't returned.
+func (i *IssueRenderedScheme) Raw(fieldID string) (interface{}, bool) {
+
+	if i == nil {
+		return nil, false
+	}
+
+	value, ok := i.Fields[fieldID]
+	return value, ok
+}
+
+// Rendered returns the HTML rendered value of the field.
+//
+// It reports false if the field has no rendered form, the rendered value is null or empty for
+// the fields Jira doesn't render, so the raw value must be used instead.
+func (i *IssueRenderedScheme) Rendered(fieldID string) (string, bool) {
+
+	if i == nil {
+		return "", false
+	}
+
+	rendered, ok := i.RenderedFields[fieldID].(string)
+	if !ok || rendered == "" {
+		return "", false
+	}
+
+	return rendered, true
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueRenderedScheme(t *testing.T) {
+
+	issue := new(IssueRenderedScheme)
+	err := json.Unmarshal([]byte(`{
+		"key": "KP-1",
+		"fields": {"description": "h1. Summary", "labels": ["triage"], "environment": null},
+		"renderedFields": {"description": "<h1>Summary</h1>", "labels": null, "environment": ""}
+	}`), issue)
+	assert.NoError(t, err)
+
+	rendered, ok := issue.Rendered("description")
+	assert.True(t, ok)
+	assert.Equal(t, "<h1>Summary</h1>", rendered)
+
+	for _, fieldID := range []string{"labels", "environment", "customfield_10000"} {
+		_, ok = issue.Rendered(fieldID)
+		assert.False(t, ok, fieldID)
+	}
+
+	raw, ok := issue.Raw("labels")
+	assert.True(t, ok)
+	assert.Equal(t, []interface{}{"triage"}, raw)
+
+	_, ok = issue.Raw("customfield_10000")
+	assert.False(t, ok)
+
+	var empty *IssueRenderedScheme
+	_, ok = empty.Rendered("description")
+	assert.False(t, ok)
+}
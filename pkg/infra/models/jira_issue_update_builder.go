@@ -0,0 +1,100 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+)
+
+// IssueUpdateBuilder builds the update operations of an issue edit payload.
+// Every operation is validated against the issue edit metadata before being added,
+// so invalid operations, like an add on a single-value field, are caught client-side.
+type IssueUpdateBuilder struct {
+	metadata   *IssueEditMetadataScheme
+	operations map[string][]map[string]interface{}
+}
+
+// NewIssueUpdateBuilder creates a new IssueUpdateBuilder using the edit metadata returned by the EditMeta method.
+func NewIssueUpdateBuilder(metadata *IssueEditMetadataScheme) *IssueUpdateBuilder {
+	return &IssueUpdateBuilder{
+		metadata:   metadata,
+		operations: make(map[string][]map[string]interface{}),
+	}
+}
+
+// Set adds a set operation for the field.
+func (b *IssueUpdateBuilder) Set(fieldID string, value interface{}) error {
+	return b.Operation(fieldID, "set", value)
+}
+
+// Add adds an add operation for the field.
+func (b *IssueUpdateBuilder) Add(fieldID string, value interface{}) error {
+	return b.Operation(fieldID, "add", value)
+}
+
+// Remove adds a remove operation for the field.
+func (b *IssueUpdateBuilder) Remove(fieldID string, value interface{}) error {
+	return b.Operation(fieldID, "remove", value)
+}
+
+// Operation adds an update operation for the field.
+// It returns ErrFieldNotEditable if the field is not available on the edit metadata,
+// and ErrInvalidFieldOperation if the field doesn't support the operation.
+func (b *IssueUpdateBuilder) Operation(fieldID, operation string, value interface{}) error {
+
+	if fieldID == "" {
+		return ErrNoFieldID
+	}
+
+	if operation == "" {
+		return ErrNoEditOperator
+	}
+
+	if b.metadata == nil {
+		return ErrNoEditMetadata
+	}
+
+	field, ok := b.metadata.Fields[fieldID]
+	if !ok || field == nil {
+		return fmt.Errorf("%w: %v", ErrFieldNotEditable, fieldID)
+	}
+
+	if !field.SupportsOperation(operation) {
+		return fmt.Errorf("%w: %v on %v, supported operations: %v", ErrInvalidFieldOperation, operation, fieldID, field.Operations)
+	}
+
+	b.operations[fieldID] = append(b.operations[fieldID], map[string]interface{}{operation: value})
+
+	return nil
+}
+
+// Operations returns the update operations built, ready to be used on the issue Update method.
+func (b *IssueUpdateBuilder) Operations() *UpdateOperations {
+
+	fieldIDs := make([]string, 0, len(b.operations))
+	for fieldID := range b.operations {
+		fieldIDs = append(fieldIDs, fieldID)
+	}
+
+	sort.Strings(fieldIDs)
+
+	operations := &UpdateOperations{}
+	for _, fieldID := range fieldIDs {
+
+		operations.Fields = append(operations.Fields, map[string]interface{}{
+			"update": map[string]interface{}{fieldID: b.operations[fieldID]},
+		})
+	}
+
+	return operations
+}
+
+// Payload returns the update node of the issue edit payload.
+func (b *IssueUpdateBuilder) Payload() map[string]interface{} {
+
+	update := make(map[string]interface{}, len(b.operations))
+	for fieldID, operations := range b.operations {
+		update[fieldID] = operations
+	}
+
+	return map[string]interface{}{"update": update}
+}
@@ -0,0 +1,125 @@
+package models
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueUpdateBuilder_Operation(t *testing.T) {
+
+	metadata := &IssueEditMetadataScheme{
+		Fields: map[string]*IssueFieldMetadataScheme{
+			"labels":  {Key: "labels", Operations: []string{"add", "set", "remove"}},
+			"summary": {Key: "summary", Operations: []string{"set"}},
+		},
+	}
+
+	type args struct {
+		fieldID   string
+		operation string
+		value     interface{}
+	}
+
+	testCases := []struct {
+		name     string
+		metadata *IssueEditMetadataScheme
+		args     args
+		wantErr  bool
+		Err      error
+	}{
+		{
+			name:     "when the operation is supported by the field",
+			metadata: metadata,
+			args:     args{fieldID: "labels", operation: "add", value: "triage"},
+		},
+
+		{
+			name:     "when the operation is not supported by the field",
+			metadata: metadata,
+			args:     args{fieldID: "summary", operation: "add", value: "New summary"},
+			wantErr:  true,
+			Err:      ErrInvalidFieldOperation,
+		},
+
+		{
+			name:     "when the field is not available on the edit metadata",
+			metadata: metadata,
+			args:     args{fieldID: "customfield_10000", operation: "set", value: "value"},
+			wantErr:  true,
+			Err:      ErrFieldNotEditable,
+		},
+
+		{
+			name:     "when the edit metadata is not provided",
+			metadata: nil,
+			args:     args{fieldID: "labels", operation: "add", value: "triage"},
+			wantErr:  true,
+			Err:      ErrNoEditMetadata,
+		},
+
+		{
+			name:     "when the field id is not provided",
+			metadata: metadata,
+			args:     args{operation: "add", value: "triage"},
+			wantErr:  true,
+			Err:      ErrNoFieldID,
+		},
+
+		{
+			name:     "when the operation is not provided",
+			metadata: metadata,
+			args:     args{fieldID: "labels", value: "triage"},
+			wantErr:  true,
+			Err:      ErrNoEditOperator,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			builder := NewIssueUpdateBuilder(testCase.metadata)
+			err := builder.Operation(testCase.args.fieldID, testCase.args.operation, testCase.args.value)
+
+			if testCase.wantErr {
+				assert.True(t, errors.Is(err, testCase.Err))
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestIssueUpdateBuilder_Payload(t *testing.T) {
+
+	metadata := &IssueEditMetadataScheme{
+		Fields: map[string]*IssueFieldMetadataScheme{
+			"labels":  {Key: "labels", Operations: []string{"add", "set", "remove"}},
+			"summary": {Key: "summary", Operations: []string{"set"}},
+		},
+	}
+
+	builder := NewIssueUpdateBuilder(metadata)
+	assert.NoError(t, builder.Add("labels", "triage"))
+	assert.NoError(t, builder.Remove("labels", "stale"))
+	assert.NoError(t, builder.Set("summary", "New summary"))
+
+	expected := map[string]interface{}{
+		"update": map[string]interface{}{
+			"labels":  []map[string]interface{}{{"add": "triage"}, {"remove": "stale"}},
+			"summary": []map[string]interface{}{{"set": "New summary"}},
+		},
+	}
+
+	assert.Equal(t, expected, builder.Payload())
+
+	operations := builder.Operations()
+	assert.Len(t, operations.Fields, 2)
+	assert.Equal(t, map[string]interface{}{
+		"update": map[string]interface{}{
+			"labels": []map[string]interface{}{{"add": "triage"}, {"remove": "stale"}},
+		},
+	}, operations.Fields[0])
+}
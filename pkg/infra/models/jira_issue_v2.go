@@ -15,6 +15,7 @@ type IssueSchemeV2 struct {
 	Changelog      *IssueChangelogScheme    `json:"changelog,omitempty"`   // The changelog of the issue.
 	Fields         *IssueFieldsSchemeV2     `json:"fields,omitempty"`      // The fields of the issue.
 	RenderedFields map[string]interface{}   `json:"renderedFields,omitempty"`
+	Properties     IssuePropertiesScheme    `json:"properties,omitempty"` // The entity properties set on the issue create or update.
 }
 
 // MergeCustomFields merges custom fields into the issue scheme.
@@ -74,13 +75,14 @@ func (i *IssueSchemeV2) MergeOperations(operations *UpdateOperations) (map[strin
 		return nil, err
 	}
 
-	// For each customField created, merge it into the eAsMap
-	for _, customField := range operations.Fields {
-		if err := mergo.Merge(&issueSchemeAsMap, customField, mergo.WithOverride); err != nil {
-			return nil, err
-		}
+	// The operations of the same field are joined, see UpdateOperations.Update
+	update, err := operations.Update()
+	if err != nil {
+		return nil, err
 	}
 
+	issueSchemeAsMap["update"] = update
+
 	return issueSchemeAsMap, nil
 }
 
@@ -189,7 +191,8 @@ type IssueBulkResponseErrorScheme struct {
 
 // IssueMoveOptionsV2 represents the move options for an issue in Jira.
 type IssueMoveOptionsV2 struct {
-	Fields       *IssueSchemeV2    // The fields of the issue.
-	CustomFields *CustomFields     // The custom fields of the issue.
-	Operations   *UpdateOperations // The operations for the issue.
+	Fields          *IssueSchemeV2         // The fields of the issue.
+	CustomFields    *CustomFields          // The custom fields of the issue.
+	Operations      *UpdateOperations      // The operations for the issue.
+	HistoryMetadata *HistoryMetadataScheme // The history metadata attributing the transition, e.g. to an integration.
 }
@@ -2,6 +2,7 @@ package models
 
 import (
 	"encoding/json"
+	"sort"
 
 	"dario.cat/mergo"
 )
@@ -15,6 +16,7 @@ type IssueScheme struct {
 	Changelog      *IssueChangelogScheme    `json:"changelog,omitempty"`
 	Fields         *IssueFieldsScheme       `json:"fields,omitempty"`
 	RenderedFields map[string]interface{}   `json:"renderedFields,omitempty"`
+	Properties     IssuePropertiesScheme    `json:"properties,omitempty"`
 }
 
 // MergeCustomFields merges custom fields into the issue scheme.
@@ -74,13 +76,14 @@ func (i *IssueScheme) MergeOperations(operations *UpdateOperations) (map[string]
 		return nil, err
 	}
 
-	//For each customField created, merge it into the eAsMap
-	for _, customField := range operations.Fields {
-		if err := mergo.Merge(&issueSchemeAsMap, customField, mergo.WithOverride); err != nil {
-			return nil, err
-		}
+	// The operations of the same field are joined, see UpdateOperations.Update
+	update, err := operations.Update()
+	if err != nil {
+		return nil, err
 	}
 
+	issueSchemeAsMap["update"] = update
+
 	return issueSchemeAsMap, nil
 }
 
@@ -138,15 +141,40 @@ type IssueFieldsScheme struct {
 
 // IssueTransitionScheme represents a transition of an issue in Jira.
 type IssueTransitionScheme struct {
-	ID            string        `json:"id,omitempty"`            // The ID of the transition.
-	Name          string        `json:"name,omitempty"`          // The name of the transition.
-	To            *StatusScheme `json:"to,omitempty"`            // The status the issue transitions to.
-	HasScreen     bool          `json:"hasScreen,omitempty"`     // Indicates if the transition has a screen.
-	IsGlobal      bool          `json:"isGlobal,omitempty"`      // Indicates if the transition is global.
-	IsInitial     bool          `json:"isInitial,omitempty"`     // Indicates if the transition is initial.
-	IsAvailable   bool          `json:"isAvailable,omitempty"`   // Indicates if the transition is available.
-	IsConditional bool          `json:"isConditional,omitempty"` // Indicates if the transition is conditional.
-	IsLooped      bool          `json:"isLooped,omitempty"`      // Indicates if the transition is looped.
+	ID            string                               `json:"id,omitempty"`            // The ID of the transition.
+	Name          string                               `json:"name,omitempty"`          // The name of the transition.
+	To            *StatusScheme                        `json:"to,omitempty"`            // The status the issue transitions to.
+	HasScreen     bool                                 `json:"hasScreen,omitempty"`     // Indicates if the transition has a screen.
+	IsGlobal      bool                                 `json:"isGlobal,omitempty"`      // Indicates if the transition is global.
+	IsInitial     bool                                 `json:"isInitial,omitempty"`     // Indicates if the transition is initial.
+	IsAvailable   bool                                 `json:"isAvailable,omitempty"`   // Indicates if the transition is available.
+	IsConditional bool                                 `json:"isConditional,omitempty"` // Indicates if the transition is conditional.
+	IsLooped      bool                                 `json:"isLooped,omitempty"`      // Indicates if the transition is looped.
+	Fields        map[string]*IssueFieldMetadataScheme `json:"fields,omitempty"`        // The fields on the transition screen, returned with the transitions.fields expand.
+}
+
+// RequiredFields returns the sorted IDs of the fields required to perform the transition.
+//
+// The fields are only available when the transitions are fetched with the transitions.fields expand.
+func (t *IssueTransitionScheme) RequiredFields() []string {
+
+	var required []string
+	for fieldID, field := range t.Fields {
+		if field != nil && field.Required {
+			required = append(required, fieldID)
+		}
+	}
+
+	sort.Strings(required)
+
+	return required
+}
+
+// TransitionOptionsScheme represents the options to get the transitions of an issue in Jira.
+type TransitionOptionsScheme struct {
+	Expand                  []string // The transition information to expand, e.g. transitions.fields.
+	TransitionID            string   // The ID of the transition to return, e.g. to validate a transition.
+	SkipRemoteOnlyCondition bool     // Indicates if the transitions with the remote only condition are evaluated.
 }
 
 // StatusScheme represents the status of an issue in Jira.
@@ -222,7 +250,8 @@ type BulkIssueSchemeV3 struct {
 
 // IssueMoveOptionsV3 represents the options for moving a version 3 issue in Jira.
 type IssueMoveOptionsV3 struct {
-	Fields       *IssueScheme      // The fields for the move operation.
-	CustomFields *CustomFields     // The custom fields for the move operation.
-	Operations   *UpdateOperations // The operations for the move operation.
+	Fields          *IssueScheme           // The fields for the move operation.
+	CustomFields    *CustomFields          // The custom fields for the move operation.
+	Operations      *UpdateOperations      // The operations for the move operation.
+	HistoryMetadata *HistoryMetadataScheme // The history metadata attributing the transition, e.g. to an integration.
 }
@@ -208,3 +208,24 @@ func TestIssueScheme_ToMap(t *testing.T) {
 		})
 	}
 }
+
+func TestIssueTransitionScheme_RequiredFields(t *testing.T) {
+
+	transition := &IssueTransitionScheme{
+		ID:   "31",
+		Name: "Done",
+		Fields: map[string]*IssueFieldMetadataScheme{
+			"resolution":        {Required: true},
+			"comment":           {Required: false},
+			"customfield_10042": {Required: true},
+		},
+	}
+
+	if got := transition.RequiredFields(); !reflect.DeepEqual(got, []string{"customfield_10042", "resolution"}) {
+		t.Errorf("RequiredFields() = %v", got)
+	}
+
+	if got := (&IssueTransitionScheme{}).RequiredFields(); got != nil {
+		t.Errorf("RequiredFields() = %v, want nil", got)
+	}
+}
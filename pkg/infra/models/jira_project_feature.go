@@ -1,5 +1,11 @@
 package models
 
+// The states of a project feature.
+const (
+	ProjectFeatureEnabled  = "ENABLED"
+	ProjectFeatureDisabled = "DISABLED"
+)
+
 // ProjectFeaturesScheme represents the features of a project in Jira.
 type ProjectFeaturesScheme struct {
 	Features []*ProjectFeatureScheme `json:"features,omitempty"` // The features of the project.
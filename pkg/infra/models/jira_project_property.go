@@ -1,5 +1,13 @@
 package models
 
+// The entity types storing entity properties.
+const (
+	PropertyEntityIssue     = "issue"     // The issue properties.
+	PropertyEntityProject   = "project"   // The project properties.
+	PropertyEntityComment   = "comment"   // The comment properties.
+	PropertyEntityIssueType = "issuetype" // The issue type properties.
+)
+
 // PropertyPageScheme represents a page of properties in Jira.
 type PropertyPageScheme struct {
 	Keys []*PropertyScheme `json:"keys,omitempty"` // The keys of the properties on the page.
@@ -1,5 +1,25 @@
 package models
 
+const (
+	// RemoteLinkConfluenceApplicationType is the application type of the remote links to Confluence pages, the links
+	// with this type are listed on the Confluence content panel of the issues.
+	RemoteLinkConfluenceApplicationType = "com.atlassian.confluence"
+
+	// RemoteLinkConfluenceApplicationName is the application name of the remote links to Confluence pages.
+	RemoteLinkConfluenceApplicationName = "Confluence"
+
+	// RemoteLinkConfluenceRelationship is the relationship of the remote links to Confluence pages.
+	RemoteLinkConfluenceRelationship = "Wiki Page"
+)
+
+// RemoteLinkConfluencePageScheme represents a Confluence page linked to an issue in Jira.
+type RemoteLinkConfluencePageScheme struct {
+	AppLinkID string // The ID of the application link between Jira and the Confluence site hosting the page.
+	PageID    string // The ID of the Confluence page.
+	URL       string // The URL of the Confluence page.
+	Title     string // The title of the link, the URL is used when it's not set.
+}
+
 // RemoteLinkIdentify represents the identification of a remote link in Jira.
 type RemoteLinkIdentify struct {
 	ID   int    `json:"id,omitempty"`   // The ID of the remote link.
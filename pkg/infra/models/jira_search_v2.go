@@ -23,5 +23,6 @@ type IssueSearchJQLSchemeV2 struct {
 
 // IssueBulkFetchSchemeV2 represents the response from the bulk fetch endpoint for richtext (v2 API)
 type IssueBulkFetchSchemeV2 struct {
-	Issues []*IssueSchemeV2 `json:"issues,omitempty"`
+	Issues      []*IssueSchemeV2             `json:"issues,omitempty"`
+	IssueErrors []*IssueBulkFetchErrorScheme `json:"issueErrors,omitempty"`
 }
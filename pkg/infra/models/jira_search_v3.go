@@ -29,5 +29,6 @@ type IssueSearchJQLScheme struct {
 
 // IssueBulkFetchScheme represents the response from the bulk fetch endpoint for ADF (v3 API)
 type IssueBulkFetchScheme struct {
-	Issues []*IssueScheme `json:"issues,omitempty"`
+	Issues      []*IssueScheme               `json:"issues,omitempty"`
+	IssueErrors []*IssueBulkFetchErrorScheme `json:"issueErrors,omitempty"`
 }
@@ -1,5 +1,7 @@
 package models
 
+import "fmt"
+
 // ServerInformationScheme represents the server information in Jira.
 type ServerInformationScheme struct {
 	BaseURL        string                     `json:"baseUrl,omitempty"`        // The base URL of the Jira server.
@@ -20,3 +22,51 @@ type ServerHealthCheckScheme struct {
 	Description string `json:"description,omitempty"` // The description of the health check.
 	Passed      bool   `json:"passed,omitempty"`      // Indicates if the health check passed.
 }
+
+// VersionTuple returns the major, minor and patch numbers of the Jira version, the missing numbers are 0.
+func (s *ServerInformationScheme) VersionTuple() ServerVersionScheme {
+
+	var version ServerVersionScheme
+	if s == nil {
+		return version
+	}
+
+	for index, number := range s.VersionNumbers {
+		switch index {
+		case 0:
+			version.Major = number
+		case 1:
+			version.Minor = number
+		case 2:
+			version.Patch = number
+		}
+	}
+
+	return version
+}
+
+// ServerVersionScheme represents the major, minor and patch numbers of a Jira version, e.g. 9.12.4.
+type ServerVersionScheme struct {
+	Major int `json:"major"` // The major version number.
+	Minor int `json:"minor"` // The minor version number.
+	Patch int `json:"patch"` // The patch version number.
+}
+
+// AtLeast reports whether the version is equal to or greater than the provided version.
+func (v ServerVersionScheme) AtLeast(major, minor, patch int) bool {
+
+	if v.Major != major {
+		return v.Major > major
+	}
+
+	if v.Minor != minor {
+		return v.Minor > minor
+	}
+
+	return v.Patch >= patch
+}
+
+// String returns the version formatted as major.minor.patch.
+func (v ServerVersionScheme) String() string {
+	return fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+}
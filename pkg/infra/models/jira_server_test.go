@@ -0,0 +1,21 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerInformationScheme_VersionTuple(t *testing.T) {
+
+	version := (&ServerInformationScheme{VersionNumbers: []int{9, 12, 4}}).VersionTuple()
+
+	assert.Equal(t, ServerVersionScheme{Major: 9, Minor: 12, Patch: 4}, version)
+	assert.Equal(t, "9.12.4", version.String())
+	assert.True(t, version.AtLeast(9, 12, 4))
+	assert.True(t, version.AtLeast(8, 20, 0))
+	assert.False(t, version.AtLeast(9, 13, 0))
+	assert.False(t, version.AtLeast(10, 0, 0))
+
+	assert.Equal(t, ServerVersionScheme{Major: 1001}, (&ServerInformationScheme{VersionNumbers: []int{1001}}).VersionTuple())
+}
@@ -0,0 +1,16 @@
+package models
+
+// TimeTrackingProviderScheme represents a time tracking provider in Jira.
+type TimeTrackingProviderScheme struct {
+	Key  string `json:"key,omitempty"`  // The key of the time tracking provider, e.g. "JIRA".
+	Name string `json:"name,omitempty"` // The name of the time tracking provider.
+	URL  string `json:"url,omitempty"`  // The URL of the configuration page of the provider app.
+}
+
+// TimeTrackingConfigurationScheme represents the time tracking settings in Jira.
+type TimeTrackingConfigurationScheme struct {
+	WorkingHoursPerDay float64 `json:"workingHoursPerDay,omitempty"` // The number of hours in a working day.
+	WorkingDaysPerWeek float64 `json:"workingDaysPerWeek,omitempty"` // The number of days in a working week.
+	TimeFormat         string  `json:"timeFormat,omitempty"`         // The format of the time tracking information, e.g. "pretty", "days" or "hours".
+	DefaultUnit        string  `json:"defaultUnit,omitempty"`        // The default unit of the time logged without a unit, e.g. "minute", "hour", "day" or "week".
+}
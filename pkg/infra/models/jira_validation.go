@@ -0,0 +1,14 @@
+package models
+
+// The deployment types reported by the Jira instances.
+const (
+	DeploymentTypeCloud  = "Cloud"  // The Atlassian Cloud deployment.
+	DeploymentTypeServer = "Server" // The Server or Data Center deployment.
+)
+
+// ValidationResultScheme represents the result of a credentials pre-flight validation.
+type ValidationResultScheme struct {
+	Account        *UserScheme `json:"account,omitempty"`        // The account authenticated by the credentials.
+	DeploymentType string      `json:"deploymentType,omitempty"` // The deployment type of the instance, Cloud or Server.
+	Scopes         []string    `json:"scopes,omitempty"`         // The scopes granted to the token, only reported for the OAuth 2.0 tokens.
+}
@@ -20,3 +20,17 @@ type UserDetailScheme struct {
 	TimeZone     string `json:"timeZone,omitempty"`     // The time zone of the user.
 	AccountType  string `json:"accountType,omitempty"`  // The account type of the user.
 }
+
+// IssueWatcherBulkResultScheme represents the result of adding a watcher to the issues matching a JQL query in Jira.
+type IssueWatcherBulkResultScheme struct {
+	Total     int              `json:"total"`     // The number of issues matching the JQL query.
+	Succeeded int              `json:"succeeded"` // The number of issues where the watcher was added.
+	Failed    int              `json:"failed"`    // The number of issues where the watcher could not be added.
+	Errors    map[string]error `json:"-"`         // The errors returned by the failed issues, keyed by the issue key.
+}
+
+// BulkIssueWatchersScheme represents the watching status of the current user on many issues in Jira.
+// It doesn't hold the watchers of the issues, only if the current user is watching them.
+type BulkIssueWatchersScheme struct {
+	IssuesIsWatching map[string]bool `json:"issuesIsWatching,omitempty"` // Indicates if the current user is watching the issues, keyed by the issue ID.
+}
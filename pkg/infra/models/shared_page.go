@@ -0,0 +1,72 @@
+package models
+
+import (
+	"encoding/json"
+)
+
+// Page represents the offset paginated envelope shared by many Atlassian endpoints, e.g. the Jira v3 ones
+// returning the startAt, maxResults, total, isLast and values fields.
+//
+// It's the preferred way to map a paginated endpoint on new services, instead of declaring a page scheme
+// per endpoint. The existing page schemes are kept for compatibility.
+type Page[T any] struct {
+	Self       string `json:"self,omitempty"`       // The URL of the page.
+	NextPage   string `json:"nextPage,omitempty"`   // The URL of the next page, if any.
+	StartAt    int    `json:"startAt,omitempty"`    // The starting index of the page.
+	MaxResults int    `json:"maxResults,omitempty"` // The maximum number of results of the page.
+	Total      int    `json:"total,omitempty"`      // The total number of results.
+	IsLast     bool   `json:"isLast,omitempty"`     // Indicates if this is the last page.
+	Values     []T    `json:"values,omitempty"`     // The results of the page.
+}
+
+// HasNext reports if there are results after the page.
+//
+// The isLast field is used when the endpoint returns it, otherwise the total of results is compared with the
+// results fetched so far.
+func (p *Page[T]) HasNext() bool {
+
+	if p == nil || len(p.Values) == 0 {
+		return false
+	}
+
+	if p.IsLast {
+		return false
+	}
+
+	if p.Total == 0 {
+		return p.NextPage != ""
+	}
+
+	return p.NextStartAt() < p.Total
+}
+
+// NextStartAt returns the starting index of the next page.
+func (p *Page[T]) NextStartAt() int {
+
+	if p == nil {
+		return 0
+	}
+
+	return p.StartAt + len(p.Values)
+}
+
+// DecodePage decodes the JSON encoded page.
+func DecodePage[T any](data []byte) (*Page[T], error) {
+
+	page := new(Page[T])
+	if err := json.Unmarshal(data, page); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// DecodePageResponse decodes the page from the body of the response.
+func DecodePageResponse[T any](response *ResponseScheme) (*Page[T], error) {
+
+	if response == nil {
+		return nil, ErrNoResponse
+	}
+
+	return DecodePage[T](response.Bytes.Bytes())
+}
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodePage(t *testing.T) {
+
+	page, err := DecodePage[*ProjectScheme]([]byte(`{
+		"startAt": 0,
+		"maxResults": 2,
+		"total": 3,
+		"isLast": false,
+		"values": [{"id": "10000", "key": "KP"}, {"id": "10001", "key": "DUMMY"}]
+	}`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, page.Total)
+	assert.Len(t, page.Values, 2)
+	assert.Equal(t, "DUMMY", page.Values[1].Key)
+	assert.True(t, page.HasNext())
+	assert.Equal(t, 2, page.NextStartAt())
+
+	_, err = DecodePage[*ProjectScheme]([]byte(`{"values": {}}`))
+	assert.Error(t, err)
+
+	_, err = DecodePageResponse[*ProjectScheme](nil)
+	assert.ErrorIs(t, err, ErrNoResponse)
+
+	response := &ResponseScheme{}
+	response.Bytes.WriteString(`{"startAt": 2, "total": 3, "values": [{"id": "10002"}]}`)
+
+	page, err = DecodePageResponse[*ProjectScheme](response)
+	assert.NoError(t, err)
+	assert.False(t, page.HasNext())
+}
+
+func TestPage_HasNext(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		page *Page[int]
+		want bool
+	}{
+		{
+			name: "when the page is the last one",
+			page: &Page[int]{IsLast: true, Total: 10, Values: []int{1}},
+		},
+		{
+			name: "when the total is not reached",
+			page: &Page[int]{StartAt: 5, Total: 10, Values: []int{5, 6}},
+			want: true,
+		},
+		{
+			name: "when the total is not returned and there is a next page url",
+			page: &Page[int]{NextPage: "https://ctreminiom.atlassian.net/rest/api/3/project/search?startAt=1", Values: []int{1}},
+			want: true,
+		},
+		{
+			name: "when the page is empty",
+			page: &Page[int]{Total: 10},
+		},
+		{
+			name: "when the page is nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.want, testCase.page.HasNext())
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type requestIDContextKey struct{}
+
+// WithRequestID returns a copy of the context carrying the request ID.
+//
+// The clients send it on the X-Request-Id header of the requests created with the context,
+// so the requests can be correlated end to end:
+//
+//	ctx = models.WithRequestID(ctx, "c2b2f8a4-6f0e-4a8e-9d4e-2b7a3c1d9e10")
+//	issue, response, err := instance.Issue.Get(ctx, "KP-1", nil, nil)
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request ID carried by the context, or an empty string if there is none.
+func RequestIDFromContext(ctx context.Context) string {
+
+	if ctx == nil {
+		return ""
+	}
+
+	requestID, _ := ctx.Value(requestIDContextKey{}).(string)
+	return requestID
+}
+
+// RequestError represents the error of a failed request, along with the IDs Atlassian assigned to the request.
+//
+// It wraps the original error, so errors.Is and errors.As keep working, e.g. errors.Is(err, models.ErrNotFound).
+type RequestError struct {
+	Err       error  // The error of the request.
+	RequestID string // The ID Atlassian assigned to the request, sent on the X-AREQUESTID header.
+	TraceID   string // The trace ID Atlassian assigned to the request, sent on the X-Trace-Id header.
+}
+
+// Error returns the error message, followed by the Atlassian request and trace IDs.
+func (e *RequestError) Error() string {
+
+	var ids []string
+	if e.RequestID != "" {
+		ids = append(ids, "atlassian request id: "+e.RequestID)
+	}
+
+	if e.TraceID != "" {
+		ids = append(ids, "trace id: "+e.TraceID)
+	}
+
+	return fmt.Sprintf("%v (%v)", e.Err, strings.Join(ids, ", "))
+}
+
+// Unwrap returns the error of the request.
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// NewRequestError wraps the error with the Atlassian request and trace IDs of the response.
+// The error is returned as it is if it's nil or the response does not carry any of the IDs.
+func NewRequestError(response *ResponseScheme, err error) error {
+
+	if err == nil {
+		return nil
+	}
+
+	requestID, traceID := response.RequestID(), response.TraceID()
+	if requestID == "" && traceID == "" {
+		return err
+	}
+
+	return &RequestError{Err: err, RequestID: requestID, TraceID: traceID}
+}
+
+type acceptContextKey struct{}
+
+// WithAccept returns a copy of the context carrying the media type accepted on the response, e.g. application/xml.
+//
+// The clients send it on the Accept header of the requests created with the context, instead of application/json,
+// so the callers can negotiate the representation returned by the endpoints supporting several ones. The responses
+// not accepted as JSON aren't decoded, the raw body is available on the ResponseScheme.Bytes field:
+//
+//	ctx = models.WithAccept(ctx, "application/xml")
+//	_, response, err := instance.Content.Get(ctx, "65611", nil, 0)
+//	fmt.Println(response.Bytes.String())
+func WithAccept(ctx context.Context, mime string) context.Context {
+	return context.WithValue(ctx, acceptContextKey{}, mime)
+}
+
+// AcceptFromContext returns the media type accepted carried by the context, or an empty string if there is none.
+func AcceptFromContext(ctx context.Context) string {
+
+	if ctx == nil {
+		return ""
+	}
+
+	accept, _ := ctx.Value(acceptContextKey{}).(string)
+	return accept
+}
+
+// AcceptsJSON reports if the Accept header value accepts a JSON representation, an empty value accepts it.
+func AcceptsJSON(accept string) bool {
+
+	if accept == "" {
+		return true
+	}
+
+	for _, mediaType := range strings.Split(accept, ",") {
+
+		mediaType = strings.TrimSpace(strings.Split(mediaType, ";")[0])
+		if mediaType == "*/*" || mediaType == "application/*" || strings.HasSuffix(mediaType, "json") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,93 @@
+package models
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestIDFromContext(t *testing.T) {
+
+	ctx := WithRequestID(context.Background(), "c2b2f8a4")
+
+	assert.Equal(t, "c2b2f8a4", RequestIDFromContext(ctx))
+	assert.Empty(t, RequestIDFromContext(context.Background()))
+}
+
+func TestNewRequestError(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		header  http.Header
+		err     error
+		wantErr string
+	}{
+		{
+			name:    "when the response carries the atlassian ids",
+			header:  http.Header{"X-Arequestid": []string{"7c6b2b2e"}, "X-Trace-Id": []string{"0a1b2c3d"}},
+			err:     ErrNotFound,
+			wantErr: ErrNotFound.Error() + " (atlassian request id: 7c6b2b2e, trace id: 0a1b2c3d)",
+		},
+
+		{
+			name:    "when the response carries only the request id",
+			header:  http.Header{"X-Arequestid": []string{"7c6b2b2e"}},
+			err:     ErrNotFound,
+			wantErr: ErrNotFound.Error() + " (atlassian request id: 7c6b2b2e)",
+		},
+
+		{
+			name:    "when the response does not carry the atlassian ids",
+			header:  http.Header{},
+			err:     ErrNotFound,
+			wantErr: ErrNotFound.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			response := &ResponseScheme{Response: &http.Response{Header: testCase.header}}
+
+			err := NewRequestError(response, testCase.err)
+
+			assert.EqualError(t, err, testCase.wantErr)
+			assert.True(t, errors.Is(err, ErrNotFound))
+		})
+	}
+
+	assert.NoError(t, NewRequestError(&ResponseScheme{}, nil))
+	assert.Equal(t, ErrNotFound, NewRequestError(nil, ErrNotFound))
+}
+
+func TestAcceptFromContext(t *testing.T) {
+
+	ctx := WithAccept(context.Background(), "application/xml")
+
+	assert.Equal(t, "application/xml", AcceptFromContext(ctx))
+	assert.Empty(t, AcceptFromContext(context.Background()))
+}
+
+func TestAcceptsJSON(t *testing.T) {
+
+	testCases := []struct {
+		accept string
+		want   bool
+	}{
+		{accept: "", want: true},
+		{accept: "application/json", want: true},
+		{accept: "application/vnd.api+json; charset=utf-8", want: true},
+		{accept: "text/html, */*;q=0.8", want: true},
+		{accept: "application/xml"},
+		{accept: "text/plain"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.accept, func(t *testing.T) {
+			assert.Equal(t, testCase.want, AcceptsJSON(testCase.accept))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package models
 import (
 	"bytes"
 	"net/http"
+	"strings"
 )
 
 // ResponseScheme represents the response from an HTTP request.
@@ -14,3 +15,88 @@ type ResponseScheme struct {
 	Method   string       // The HTTP method used for the request.
 	Bytes    bytes.Buffer // The response body.
 }
+
+// Deprecation returns the deprecation notices sent by Atlassian on the response.
+// It returns nil if the response does not contain any Warning, Deprecation or Sunset header.
+func (r *ResponseScheme) Deprecation() *DeprecationScheme {
+
+	if r == nil || r.Response == nil {
+		return nil
+	}
+
+	return NewDeprecationScheme(r.Header)
+}
+
+// RequestID returns the ID Atlassian assigned to the request, sent on the X-AREQUESTID header.
+// Quote it when contacting Atlassian support about the request.
+func (r *ResponseScheme) RequestID() string {
+
+	if r == nil || r.Response == nil {
+		return ""
+	}
+
+	return r.Header.Get("X-Arequestid")
+}
+
+// TraceID returns the trace ID Atlassian assigned to the request, sent on the X-Trace-Id header.
+func (r *ResponseScheme) TraceID() string {
+
+	if r == nil || r.Response == nil {
+		return ""
+	}
+
+	return r.Header.Get("X-Trace-Id")
+}
+
+// DeprecationScheme represents the deprecation notices sent by Atlassian on the response headers.
+type DeprecationScheme struct {
+	Warnings    []string // The warning texts extracted from the Warning headers.
+	Deprecation string   // The value of the Deprecation header, if any.
+	Sunset      string   // The value of the Sunset header, the date when the endpoint will be removed.
+}
+
+// NewDeprecationScheme extracts the deprecation notices from the Warning, Deprecation and Sunset headers.
+// It returns nil if none of those headers are present.
+func NewDeprecationScheme(header http.Header) *DeprecationScheme {
+
+	if header == nil {
+		return nil
+	}
+
+	scheme := &DeprecationScheme{
+		Deprecation: header.Get("Deprecation"),
+		Sunset:      header.Get("Sunset"),
+	}
+
+	for _, value := range header.Values("Warning") {
+		if warning := parseWarningText(value); warning != "" {
+			scheme.Warnings = append(scheme.Warnings, warning)
+		}
+	}
+
+	if len(scheme.Warnings) == 0 && scheme.Deprecation == "" && scheme.Sunset == "" {
+		return nil
+	}
+
+	return scheme
+}
+
+// parseWarningText extracts the warn-text from a Warning header value.
+// e.g: 299 - "Deprecated API" returns Deprecated API.
+// If the value doesn't follow the RFC 7234 format, the raw value is returned.
+func parseWarningText(value string) string {
+
+	value = strings.TrimSpace(value)
+
+	start := strings.Index(value, `"`)
+	if start == -1 {
+		return value
+	}
+
+	end := strings.Index(value[start+1:], `"`)
+	if end == -1 {
+		return value
+	}
+
+	return value[start+1 : start+1+end]
+}
@@ -0,0 +1,127 @@
+// Package stream decodes the JSON arrays of the responses one element at a time, so the huge responses, e.g.
+// the pages of a JQL search fetching every field, are processed with a bounded memory.
+//
+//	envelope, err := stream.Array(response.Body, "issues", func(element json.RawMessage) error {
+//
+//		issue := new(models.IssueScheme)
+//		if err := json.Unmarshal(element, issue); err != nil {
+//			return err
+//		}
+//
+//		return export(issue)
+//	})
+//
+// The other members of the document, e.g. the nextPageToken, are returned on the envelope.
+package stream
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// Array calls fn for every element of a JSON array, in order, as the elements are decoded from the reader.
+//
+// The streamed array is the document itself if the field is empty, otherwise it's the member of the document object
+// named by the field, e.g. "values" or "issues". The other members of the object are returned, a missing or null
+// member is streamed as an empty array.
+//
+// The first error returned by fn stops the decoding and it's returned.
+func Array(reader io.Reader, field string, fn func(element json.RawMessage) error) (map[string]json.RawMessage, error) {
+
+	decoder := json.NewDecoder(reader)
+
+	if field == "" {
+
+		if err := expectDelim(decoder, '['); err != nil {
+			return nil, err
+		}
+
+		return nil, elements(decoder, fn)
+	}
+
+	if err := expectDelim(decoder, '{'); err != nil {
+		return nil, err
+	}
+
+	envelope := make(map[string]json.RawMessage)
+	for decoder.More() {
+
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		key, _ := token.(string)
+		if key != field {
+
+			var value json.RawMessage
+			if err = decoder.Decode(&value); err != nil {
+				return nil, err
+			}
+
+			envelope[key] = value
+			continue
+		}
+
+		token, err = decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		if token == nil {
+			continue
+		}
+
+		if delim, ok := token.(json.Delim); !ok || delim != '[' {
+			return nil, fmt.Errorf("%w: %v", models.ErrNoStreamArray, field)
+		}
+
+		if err = elements(decoder, fn); err != nil {
+			return nil, err
+		}
+	}
+
+	// The closing brace of the object.
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+
+	return envelope, nil
+}
+
+// elements streams the elements of the array, the opening bracket must be consumed already.
+func elements(decoder *json.Decoder, fn func(element json.RawMessage) error) error {
+
+	for decoder.More() {
+
+		var element json.RawMessage
+		if err := decoder.Decode(&element); err != nil {
+			return err
+		}
+
+		if err := fn(element); err != nil {
+			return err
+		}
+	}
+
+	// The closing bracket of the array.
+	_, err := decoder.Token()
+	return err
+}
+
+func expectDelim(decoder *json.Decoder, want json.Delim) error {
+
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if delim, ok := token.(json.Delim); !ok || delim != want {
+		return fmt.Errorf("%w: unexpected %v", models.ErrNoStreamArray, token)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package stream
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestArray(t *testing.T) {
+
+	testCases := []struct {
+		name         string
+		document     string
+		field        string
+		wantElements []string
+		wantEnvelope map[string]json.RawMessage
+		wantErr      error
+	}{
+		{
+			name:         "when the array is a member of the document",
+			document:     `{"startAt":0,"values":[{"id":"1"},{"id":"2"}],"isLast":true}`,
+			field:        "values",
+			wantElements: []string{`{"id":"1"}`, `{"id":"2"}`},
+			wantEnvelope: map[string]json.RawMessage{"startAt": json.RawMessage(`0`), "isLast": json.RawMessage(`true`)},
+		},
+
+		{
+			name:         "when the document is an array",
+			document:     `[1, "two", {"three":3}]`,
+			wantElements: []string{`1`, `"two"`, `{"three":3}`},
+		},
+
+		{
+			name:         "when the member is null",
+			document:     `{"issues":null,"nextPageToken":"token"}`,
+			field:        "issues",
+			wantEnvelope: map[string]json.RawMessage{"nextPageToken": json.RawMessage(`"token"`)},
+		},
+
+		{
+			name:     "when the member is not an array",
+			document: `{"issues":{"id":"1"}}`,
+			field:    "issues",
+			wantErr:  models.ErrNoStreamArray,
+		},
+
+		{
+			name:     "when the document is not an array",
+			document: `{"values":[]}`,
+			wantErr:  models.ErrNoStreamArray,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			var elements []string
+			envelope, err := Array(strings.NewReader(testCase.document), testCase.field, func(element json.RawMessage) error {
+				elements = append(elements, string(element))
+				return nil
+			})
+
+			if testCase.wantErr != nil {
+				assert.True(t, errors.Is(err, testCase.wantErr))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantElements, elements)
+
+			if testCase.wantEnvelope != nil {
+				assert.Equal(t, testCase.wantEnvelope, envelope)
+			}
+		})
+	}
+}
+
+func TestArray_CallbackError(t *testing.T) {
+
+	var visited int
+	_, err := Array(strings.NewReader(`{"values":[1,2,3]}`), "values", func(element json.RawMessage) error {
+		visited++
+		return errors.New("unable to export the element")
+	})
+
+	assert.EqualError(t, err, "unable to export the element")
+	assert.Equal(t, 1, visited)
+}
@@ -0,0 +1,178 @@
+// Package task provides helpers to wait for the Atlassian asynchronous tasks, such as the ones returned with
+// 202 Accepted by the Jira bulk operations or the Confluence space deletion.
+//
+// A Getter fetches the task status, Await polls it with an exponential backoff until the task finishes. The services
+// starting the tasks expose it, e.g. the Confluence LongTask service:
+//
+//	deletion, _, err := instance.Space.Delete(ctx, "DUMMY")
+//	if err != nil {
+//		return err
+//	}
+//
+//	longTask, err := instance.LongTask.Await(ctx, deletion.ID, task.WithMaxInterval(10*time.Second))
+package task
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/confluence"
+	"github.com/ctreminiom/go-atlassian/v2/service/jira"
+)
+
+const (
+	// defaultInterval is the delay before the second poll when no interval is set.
+	defaultInterval = time.Second
+
+	// defaultMaxInterval is the maximum delay between two polls when no maximum interval is set.
+	defaultMaxInterval = 30 * time.Second
+)
+
+// Getter returns the current payload of the task and reports if the task reached a terminal state.
+//
+// The task failures must be returned as errors, preferably wrapping models.ErrTaskFailed, they stop the polling.
+type Getter[T any] func(ctx context.Context) (payload T, done bool, err error)
+
+// Option configures the polling.
+type Option func(*config)
+
+type config struct {
+	interval, maxInterval time.Duration
+}
+
+// WithInterval sets the delay before the second poll, the delay is doubled after every poll.
+func WithInterval(interval time.Duration) Option {
+	return func(c *config) {
+		if interval > 0 {
+			c.interval = interval
+		}
+	}
+}
+
+// WithMaxInterval sets the maximum delay between two polls.
+func WithMaxInterval(maxInterval time.Duration) Option {
+	return func(c *config) {
+		if maxInterval > 0 {
+			c.maxInterval = maxInterval
+		}
+	}
+}
+
+// Await polls the task until it reaches a terminal state and returns its final payload.
+//
+// The task is polled right away, then the delay between the polls is doubled up to the maximum interval.
+// The polling stops on the first error returned by the getter, or when the context is done, in that case
+// the last payload fetched is returned along with the context error.
+func Await[T any](ctx context.Context, getter Getter[T], options ...Option) (T, error) {
+
+	settings := &config{interval: defaultInterval, maxInterval: defaultMaxInterval}
+	for _, option := range options {
+		option(settings)
+	}
+
+	interval := min(settings.interval, settings.maxInterval)
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	var payload T
+
+	for {
+
+		select {
+		case <-ctx.Done():
+			return payload, ctx.Err()
+		case <-timer.C:
+		}
+
+		var (
+			done bool
+			err  error
+		)
+
+		payload, done, err = getter(ctx)
+		if err != nil || done {
+			return payload, err
+		}
+
+		timer.Reset(interval)
+		interval = min(interval*2, settings.maxInterval)
+	}
+}
+
+// JiraTask returns a Getter polling a Jira task, e.g. the Task service of the Jira clients.
+//
+// The COMPLETE status finishes the task, the FAILED, CANCELLED and DEAD statuses fail it.
+func JiraTask(tasks jira.TaskConnector, taskID string) Getter[*models.TaskScheme] {
+	return func(ctx context.Context) (*models.TaskScheme, bool, error) {
+
+		task, _, err := tasks.Get(ctx, taskID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		switch task.Status {
+		case "COMPLETE":
+			return task, true, nil
+		case "FAILED", "CANCELLED", "DEAD":
+			return task, true, fmt.Errorf("%w: %v %v", models.ErrTaskFailed, task.Status, task.Result)
+		default:
+			return task, false, nil
+		}
+	}
+}
+
+// JiraBulkTask returns a Getter polling a Jira bulk operation, e.g. the tasks of the bulk edit and bulk move of the Issue service.
+//
+// The COMPLETE status finishes the task, the FAILED, CANCELLED and DEAD statuses fail it.
+func JiraBulkTask(issues jira.IssueSharedConnector, taskID string) Getter[*models.BulkOperationProgressScheme] {
+	return func(ctx context.Context) (*models.BulkOperationProgressScheme, bool, error) {
+
+		progress, _, err := issues.BulkProgress(ctx, taskID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		switch progress.Status {
+		case "COMPLETE":
+			return progress, true, nil
+		case "FAILED", "CANCELLED", "DEAD":
+			return progress, true, fmt.Errorf("%w: %v", models.ErrTaskFailed, progress.Status)
+		default:
+			return progress, false, nil
+		}
+	}
+}
+
+// ConfluenceLongTask returns a Getter polling a Confluence long task, e.g. the LongTask service of the Confluence client.
+//
+// The unsuccessful finished tasks fail with their error messages.
+func ConfluenceLongTask(tasks confluence.TaskConnector, taskID string) Getter[*models.LongTaskScheme] {
+	return func(ctx context.Context) (*models.LongTaskScheme, bool, error) {
+
+		task, _, err := tasks.Get(ctx, taskID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if !task.Finished {
+			return task, false, nil
+		}
+
+		if task.Successful {
+			return task, true, nil
+		}
+
+		var messages []string
+		for _, message := range task.Errors {
+			if message != nil && message.Translation != "" {
+				messages = append(messages, message.Translation)
+			}
+		}
+
+		return task, true, fmt.Errorf("%w: %v", models.ErrTaskFailed, strings.Join(messages, ", "))
+	}
+}
@@ -0,0 +1,127 @@
+package task_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ctreminiom/go-atlassian/v2/confluence"
+	v3 "github.com/ctreminiom/go-atlassian/v2/jira/v3"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/task"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/testutil"
+)
+
+func TestAwait(t *testing.T) {
+
+	t.Run("when the task finishes", func(t *testing.T) {
+
+		var polls int
+		getter := func(ctx context.Context) (int, bool, error) {
+			polls++
+			return polls, polls == 3, nil
+		}
+
+		got, err := task.Await[int](context.Background(), getter, task.WithInterval(time.Millisecond))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, got)
+		assert.Equal(t, 3, polls)
+	})
+
+	t.Run("when the getter fails", func(t *testing.T) {
+
+		getter := func(ctx context.Context) (int, bool, error) {
+			return 0, false, models.ErrNotFound
+		}
+
+		_, err := task.Await[int](context.Background(), getter)
+		assert.True(t, errors.Is(err, models.ErrNotFound))
+	})
+
+	t.Run("when the context is done", func(t *testing.T) {
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		getter := func(ctx context.Context) (string, bool, error) {
+			return "RUNNING", false, nil
+		}
+
+		got, err := task.Await[string](ctx, getter, task.WithInterval(time.Millisecond), task.WithMaxInterval(5*time.Millisecond))
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+		assert.Equal(t, "RUNNING", got)
+	})
+}
+
+func TestJiraTask(t *testing.T) {
+
+	recorder := testutil.NewRecorder()
+	recorder.Respond(`{"id":"10641","status":"RUNNING","progress":50}`)
+	recorder.Respond(`{"id":"10641","status":"COMPLETE","progress":100}`)
+	recorder.Respond(`{"id":"10642","status":"FAILED","result":"Issue KP-1 not found"}`)
+
+	instance, err := v3.New(recorder, "https://ctreminiom.atlassian.net")
+	assert.NoError(t, err)
+
+	got, err := instance.Task.Await(context.Background(), "10641", task.WithInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, "COMPLETE", got.Status)
+
+	testutil.ExpectRequest(t, recorder, testutil.Request{Method: http.MethodGet, Path: "rest/api/3/task/10641"})
+	testutil.ExpectRequest(t, recorder, testutil.Request{Method: http.MethodGet, Path: "rest/api/3/task/10641"})
+
+	got, err = task.Await(context.Background(), task.JiraTask(instance.Task, "10642"))
+	assert.True(t, errors.Is(err, models.ErrTaskFailed))
+	assert.EqualError(t, err, "client: the asynchronous task failed: FAILED Issue KP-1 not found")
+	assert.Equal(t, "10642", got.ID)
+}
+
+func TestJiraBulkTask(t *testing.T) {
+
+	recorder := testutil.NewRecorder()
+	recorder.Respond(`{"taskId":"10641","status":"RUNNING","progressPercent":50}`)
+	recorder.Respond(`{"taskId":"10641","status":"COMPLETE","progressPercent":100,"processedAccessibleIssues":[10001,10002]}`)
+	recorder.Respond(`{"taskId":"10642","status":"FAILED"}`)
+
+	instance, err := v3.New(recorder, "https://ctreminiom.atlassian.net")
+	assert.NoError(t, err)
+
+	got, err := instance.Issue.AwaitBulk(context.Background(), "10641", task.WithInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.Equal(t, "COMPLETE", got.Status)
+	assert.Equal(t, []int{10001, 10002}, got.ProcessedAccessibleIssues)
+
+	testutil.ExpectRequest(t, recorder, testutil.Request{Method: http.MethodGet, Path: "rest/api/3/bulk/queue/10641"})
+	testutil.ExpectRequest(t, recorder, testutil.Request{Method: http.MethodGet, Path: "rest/api/3/bulk/queue/10641"})
+
+	got, err = task.Await(context.Background(), task.JiraBulkTask(instance.Issue, "10642"))
+	assert.True(t, errors.Is(err, models.ErrTaskFailed))
+	assert.EqualError(t, err, "client: the asynchronous task failed: FAILED")
+	assert.Equal(t, "10642", got.TaskID)
+}
+
+func TestConfluenceLongTask(t *testing.T) {
+
+	recorder := testutil.NewRecorder()
+	recorder.Respond(`{"id":"65611","finished":false}`)
+	recorder.Respond(`{"id":"65611","finished":true,"successful":true}`)
+	recorder.Respond(`{"id":"65612","finished":true,"successful":false,"errors":[{"translation":"Space not found"}]}`)
+
+	instance, err := confluence.New(recorder, "https://ctreminiom.atlassian.net")
+	assert.NoError(t, err)
+
+	got, err := instance.LongTask.Await(context.Background(), "65611", task.WithInterval(time.Millisecond))
+	assert.NoError(t, err)
+	assert.True(t, got.Successful)
+
+	testutil.ExpectRequest(t, recorder, testutil.Request{Method: http.MethodGet, Path: "wiki/rest/api/longtask/65611"})
+	testutil.ExpectRequest(t, recorder, testutil.Request{Method: http.MethodGet, Path: "wiki/rest/api/longtask/65611"})
+
+	_, err = task.Await(context.Background(), task.ConfluenceLongTask(instance.LongTask, "65612"))
+	assert.True(t, errors.Is(err, models.ErrTaskFailed))
+	assert.EqualError(t, err, "client: the asynchronous task failed: Space not found")
+}
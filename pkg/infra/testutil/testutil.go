@@ -0,0 +1,206 @@
+// Package testutil provides helpers to assert the shape of the requests built by the go-atlassian services.
+//
+// The Recorder implements both the service.Connector and the common.HTTPClient interfaces, so it can be
+// injected on the internal services or on the module clients, and ExpectRequest compares the recorded
+// requests with the expected ones:
+//
+//	recorder := testutil.NewRecorder()
+//	instance, _ := v3.New(recorder, "https://ctreminiom.atlassian.net")
+//
+//	_, _ = instance.Issue.Assign(context.Background(), "KP-1", &accountID)
+//
+//	testutil.ExpectRequest(t, recorder, testutil.Request{
+//		Method: http.MethodPut,
+//		Path:   "/rest/api/3/issue/KP-1/assignee",
+//		Body:   `{"accountId":"5b10ac8d82e05b22cc7d4ef5"}`,
+//	})
+package testutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// Request describes the shape of an HTTP request.
+type Request struct {
+	Method string     // The HTTP method, e.g. http.MethodGet.
+	Path   string     // The endpoint path, the leading slash is optional.
+	Query  url.Values // The query parameters, the order is not relevant.
+	Body   string     // The JSON body, it's compared after normalizing the formatting and the key order.
+}
+
+// Recorder is a service.Connector and common.HTTPClient recording every request executed by a service.
+type Recorder struct {
+	mu        sync.Mutex
+	requests  []Request
+	responses []string
+}
+
+// NewRecorder creates a new Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Respond queues a JSON response body, the responses are returned in the order they're queued.
+// The requests executed without a queued response receive an empty body.
+func (r *Recorder) Respond(body string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.responses = append(r.responses, body)
+}
+
+// NewRequest creates the request the same way the module clients do, encoding the body as JSON.
+func (r *Recorder) NewRequest(ctx context.Context, method, urlStr, contentType string, body interface{}) (*http.Request, error) {
+
+	buf := new(bytes.Buffer)
+	if body != nil {
+		if err := json.NewEncoder(buf).Encode(body); err != nil {
+			return nil, err
+		}
+	}
+
+	if attachBuffer, ok := body.(*bytes.Buffer); ok {
+		buf = attachBuffer
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, urlStr, buf)
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType != "" {
+		request.Header.Set("Content-Type", contentType)
+	}
+
+	return request, nil
+}
+
+// Call records the request and decodes the next queued response on the structure, if any.
+func (r *Recorder) Call(request *http.Request, structure interface{}) (*models.ResponseScheme, error) {
+
+	payload, err := r.record(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &models.ResponseScheme{
+		Response: &http.Response{StatusCode: http.StatusOK, Request: request},
+		Code:     http.StatusOK,
+		Endpoint: request.URL.String(),
+		Method:   request.Method,
+	}
+
+	response.Bytes.WriteString(payload)
+
+	if structure != nil && payload != "" {
+		if err = json.Unmarshal([]byte(payload), structure); err != nil {
+			return response, err
+		}
+	}
+
+	return response, nil
+}
+
+// Do records the request and returns a successful response with the next queued response body, if any.
+func (r *Recorder) Do(request *http.Request) (*http.Response, error) {
+
+	payload, err := r.record(request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(payload)),
+		Request:    request,
+	}, nil
+}
+
+func (r *Recorder) record(request *http.Request) (string, error) {
+
+	var body []byte
+	if request.Body != nil {
+
+		var err error
+		if body, err = io.ReadAll(request.Body); err != nil {
+			return "", err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests = append(r.requests, Request{
+		Method: request.Method,
+		Path:   request.URL.Path,
+		Query:  request.URL.Query(),
+		Body:   string(body),
+	})
+
+	if len(r.responses) == 0 {
+		return "", nil
+	}
+
+	payload := r.responses[0]
+	r.responses = r.responses[1:]
+
+	return payload, nil
+}
+
+// Requests returns the requests recorded and not consumed by ExpectRequest yet.
+func (r *Recorder) Requests() []Request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Request(nil), r.requests...)
+}
+
+// ExpectRequest asserts the next recorded request matches the expected method, path, query parameters and JSON body.
+// Every call consumes one request, so the requests of a method executing several calls are asserted in order.
+func ExpectRequest(t testing.TB, rec *Recorder, want Request) bool {
+	t.Helper()
+
+	rec.mu.Lock()
+	if len(rec.requests) == 0 {
+		rec.mu.Unlock()
+		return assert.Fail(t, "no request recorded", "expected %v %v", want.Method, want.Path)
+	}
+
+	got := rec.requests[0]
+	rec.requests = rec.requests[1:]
+	rec.mu.Unlock()
+
+	ok := assert.Equal(t, want.Method, got.Method, "unexpected method")
+	ok = assert.Equal(t, strings.TrimPrefix(want.Path, "/"), strings.TrimPrefix(got.Path, "/"), "unexpected path") && ok
+	ok = assert.Equal(t, normalizeQuery(want.Query), normalizeQuery(got.Query), "unexpected query parameters") && ok
+
+	switch {
+	case strings.TrimSpace(want.Body) == "" || strings.TrimSpace(got.Body) == "":
+		ok = assert.Equal(t, strings.TrimSpace(want.Body), strings.TrimSpace(got.Body), "unexpected body") && ok
+	default:
+		ok = assert.JSONEq(t, want.Body, got.Body, "unexpected body") && ok
+	}
+
+	return ok
+}
+
+func normalizeQuery(query url.Values) url.Values {
+	if len(query) == 0 {
+		return nil
+	}
+
+	return query
+}
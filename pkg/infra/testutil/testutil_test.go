@@ -0,0 +1,73 @@
+package testutil
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v3 "github.com/ctreminiom/go-atlassian/v2/jira/v3"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestExpectRequest_HTTPClient(t *testing.T) {
+
+	recorder := NewRecorder()
+	recorder.Respond(`{"accountId":"5b10ac8d82e05b22cc7d4ef5","displayName":"Carlos"}`)
+
+	instance, err := v3.New(recorder, "https://ctreminiom.atlassian.net")
+	assert.NoError(t, err)
+
+	user, _, err := instance.User.Get(context.Background(), "5b10ac8d82e05b22cc7d4ef5", []string{"groups"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Carlos", user.DisplayName)
+
+	accountID := "5b10ac8d82e05b22cc7d4ef5"
+	_, err = instance.Issue.Assign(context.Background(), "KP-1", &accountID)
+	assert.NoError(t, err)
+
+	ExpectRequest(t, recorder, Request{
+		Method: http.MethodGet,
+		Path:   "/rest/api/3/user",
+		Query:  url.Values{"accountId": {"5b10ac8d82e05b22cc7d4ef5"}, "expand": {"groups"}},
+	})
+
+	ExpectRequest(t, recorder, Request{
+		Method: http.MethodPut,
+		Path:   "rest/api/3/issue/KP-1/assignee",
+		Body: `{
+			"accountId": "5b10ac8d82e05b22cc7d4ef5"
+		}`,
+	})
+
+	assert.Empty(t, recorder.Requests())
+}
+
+func TestExpectRequest_Connector(t *testing.T) {
+
+	recorder := NewRecorder()
+	recorder.Respond(`{"id":"10001","key":"KP-1"}`)
+
+	request, err := recorder.NewRequest(context.Background(), http.MethodPost, "rest/api/3/issue?updateHistory=true", "",
+		map[string]interface{}{"fields": map[string]interface{}{"summary": "New summary", "labels": []string{"triage"}}})
+	assert.NoError(t, err)
+
+	issue := new(models.IssueScheme)
+	response, err := recorder.Call(request, issue)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "KP-1", issue.Key)
+
+	mockT := new(testing.T)
+	assert.False(t, ExpectRequest(mockT, recorder, Request{
+		Method: http.MethodPost,
+		Path:   "rest/api/3/issue",
+		Query:  url.Values{"updateHistory": {"true"}},
+		Body:   `{"fields":{"summary":"Other summary","labels":["triage"]}}`,
+	}))
+	assert.True(t, mockT.Failed())
+
+	assert.False(t, ExpectRequest(new(testing.T), recorder, Request{Method: http.MethodGet}))
+}
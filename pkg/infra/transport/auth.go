@@ -0,0 +1,102 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/common"
+)
+
+// oauth2ExpirySkew renews the OAuth 2.0 access tokens before they expire, so they aren't rejected in flight.
+const oauth2ExpirySkew = time.Minute
+
+// AuthProviderFunc adapts a function to the common.AuthProvider interface.
+type AuthProviderFunc func(ctx context.Context, request *http.Request) error
+
+// Apply calls the function with the context and the request.
+func (f AuthProviderFunc) Apply(ctx context.Context, request *http.Request) error {
+	return f(ctx, request)
+}
+
+// NewBasicAuthProvider returns a provider setting the basic authentication credentials on every request.
+func NewBasicAuthProvider(mail, token string) common.AuthProvider {
+	return AuthProviderFunc(func(_ context.Context, request *http.Request) error {
+		request.SetBasicAuth(mail, token)
+		return nil
+	})
+}
+
+// NewBearerAuthProvider returns a provider setting the bearer token on the Authorization header of every request.
+func NewBearerAuthProvider(token string) common.AuthProvider {
+	return AuthProviderFunc(func(_ context.Context, request *http.Request) error {
+
+		if token == "" {
+			return model.ErrNoAccessToken
+		}
+
+		request.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	})
+}
+
+// OAuth2Token represents an OAuth 2.0 access token, along with its expiration time.
+type OAuth2Token struct {
+	AccessToken string    // The access token sent on the Authorization header.
+	Expiry      time.Time // The expiration time of the access token, the zero value means the token doesn't expire.
+}
+
+// OAuth2TokenSource returns a valid OAuth 2.0 access token, e.g. exchanging a refresh token or reading a secret manager.
+type OAuth2TokenSource func(ctx context.Context) (*OAuth2Token, error)
+
+// NewOAuth2Provider returns a provider setting the OAuth 2.0 access token returned by the source on every request.
+//
+// The token is cached until one minute before its expiry, then the source is called again to renew it. The source is
+// called by one request at a time, the concurrent requests wait for the renewed token.
+func NewOAuth2Provider(source OAuth2TokenSource) common.AuthProvider {
+	return &oauth2Provider{source: source, now: time.Now}
+}
+
+type oauth2Provider struct {
+	source OAuth2TokenSource
+	now    func() time.Time
+
+	mu    sync.Mutex
+	token *OAuth2Token
+}
+
+func (p *oauth2Provider) Apply(ctx context.Context, request *http.Request) error {
+
+	token, err := p.current(ctx)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return nil
+}
+
+// current returns the cached token, renewing it if it's missing or about to expire.
+func (p *oauth2Provider) current(ctx context.Context) (*OAuth2Token, error) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token != nil && (p.token.Expiry.IsZero() || p.now().Add(oauth2ExpirySkew).Before(p.token.Expiry)) {
+		return p.token, nil
+	}
+
+	token, err := p.source(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if token == nil || token.AccessToken == "" {
+		return nil, model.ErrNoAccessToken
+	}
+
+	p.token = token
+	return token, nil
+}
@@ -0,0 +1,80 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestNewBasicAuthProvider(t *testing.T) {
+
+	request, _ := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/myself", nil)
+
+	assert.NoError(t, NewBasicAuthProvider("mail@example.com", "token").Apply(context.Background(), request))
+
+	mail, token, ok := request.BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "mail@example.com", mail)
+	assert.Equal(t, "token", token)
+}
+
+func TestNewBearerAuthProvider(t *testing.T) {
+
+	request, _ := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/myself", nil)
+
+	assert.NoError(t, NewBearerAuthProvider("access-token").Apply(context.Background(), request))
+	assert.Equal(t, "Bearer access-token", request.Header.Get("Authorization"))
+
+	err := NewBearerAuthProvider("").Apply(context.Background(), request)
+	assert.True(t, errors.Is(err, model.ErrNoAccessToken))
+}
+
+func TestNewOAuth2Provider(t *testing.T) {
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	calls := 0
+
+	provider := NewOAuth2Provider(func(ctx context.Context) (*OAuth2Token, error) {
+		calls++
+		return &OAuth2Token{AccessToken: "access-token-" + strconv.Itoa(calls), Expiry: now.Add(10 * time.Minute)}, nil
+	}).(*oauth2Provider)
+	provider.now = func() time.Time { return now }
+
+	apply := func() string {
+		request, _ := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/myself", nil)
+		assert.NoError(t, provider.Apply(context.Background(), request))
+		return request.Header.Get("Authorization")
+	}
+
+	// The token is cached while it's valid.
+	assert.Equal(t, "Bearer access-token-1", apply())
+	assert.Equal(t, "Bearer access-token-1", apply())
+	assert.Equal(t, 1, calls)
+
+	// The token is renewed before it expires.
+	now = now.Add(9*time.Minute + 30*time.Second)
+	assert.Equal(t, "Bearer access-token-2", apply())
+	assert.Equal(t, 2, calls)
+}
+
+func TestNewOAuth2Provider_Errors(t *testing.T) {
+
+	request, _ := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/myself", nil)
+
+	err := NewOAuth2Provider(func(ctx context.Context) (*OAuth2Token, error) {
+		return nil, errors.New("error, unable to refresh the token")
+	}).Apply(context.Background(), request)
+	assert.EqualError(t, err, "error, unable to refresh the token")
+
+	err = NewOAuth2Provider(func(ctx context.Context) (*OAuth2Token, error) {
+		return &OAuth2Token{}, nil
+	}).Apply(context.Background(), request)
+	assert.True(t, errors.Is(err, model.ErrNoAccessToken))
+}
@@ -0,0 +1,52 @@
+package transport
+
+import (
+	"io"
+	"net/http"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// Replayable reports whether the request body can be sent again, e.g. when the request is retried.
+//
+// The requests created by the client NewRequest methods are always replayable, as the payloads are serialized
+// into an in-memory buffer and the http.Request GetBody function is populated with it.
+func Replayable(request *http.Request) bool {
+
+	if request.Body == nil || request.Body == http.NoBody || request.GetBody != nil {
+		return true
+	}
+
+	_, ok := request.Body.(io.Seeker)
+	return ok
+}
+
+// RewindBody resets the request body to its beginning, so the request can be sent again.
+//
+// The GetBody function is preferred, streamed bodies are only rewound if they implement io.Seeker,
+// otherwise model.ErrNoReplayableBody is returned and the request must not be retried.
+func RewindBody(request *http.Request) error {
+
+	if request.Body == nil || request.Body == http.NoBody {
+		return nil
+	}
+
+	if request.GetBody != nil {
+
+		body, err := request.GetBody()
+		if err != nil {
+			return err
+		}
+
+		request.Body = body
+		return nil
+	}
+
+	seeker, ok := request.Body.(io.Seeker)
+	if !ok {
+		return model.ErrNoReplayableBody
+	}
+
+	_, err := seeker.Seek(0, io.SeekStart)
+	return err
+}
@@ -0,0 +1,93 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+func TestRewindBody(t *testing.T) {
+
+	filePath := filepath.Join(t.TempDir(), "attachment.txt")
+	if err := os.WriteFile(filePath, []byte("Hello World"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name           string
+		body           func(t *testing.T) io.Reader
+		wantReplayable bool
+		Err            error
+	}{
+		{
+			name: "when the body is an in-memory buffer",
+			body: func(t *testing.T) io.Reader {
+				return bytes.NewBufferString("Hello World")
+			},
+			wantReplayable: true,
+		},
+
+		{
+			name: "when the body is a seekable file",
+			body: func(t *testing.T) io.Reader {
+
+				file, err := os.Open(filePath)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				t.Cleanup(func() { _ = file.Close() })
+				return file
+			},
+			wantReplayable: true,
+		},
+
+		{
+			name: "when the body is a stream",
+			body: func(t *testing.T) io.Reader {
+				return io.MultiReader(strings.NewReader("Hello World"))
+			},
+			wantReplayable: false,
+			Err:            model.ErrNoReplayableBody,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			request, err := http.NewRequestWithContext(context.Background(), http.MethodPost,
+				"https://ctreminiom.atlassian.net/rest/api/3/issue", testCase.body(t))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			assert.Equal(t, testCase.wantReplayable, Replayable(request))
+
+			firstRead, err := io.ReadAll(request.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, "Hello World", string(firstRead))
+
+			err = RewindBody(request)
+
+			if testCase.Err != nil {
+				assert.ErrorIs(t, err, testCase.Err)
+				return
+			}
+
+			assert.NoError(t, err)
+
+			secondRead, err := io.ReadAll(request.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, firstRead, secondRead)
+		})
+	}
+}
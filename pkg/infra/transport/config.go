@@ -0,0 +1,93 @@
+package transport
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ctreminiom/go-atlassian/v2/service/common"
+)
+
+// Config consolidates the settings used to construct any of the go-atlassian clients.
+//
+// It's consumed by the NewWithConfig constructor of every module, the New constructors delegate to it:
+//
+//	instance, err := v3.NewWithConfig(&transport.Config{
+//		Site:    "INSTANCE_HOST",
+//		Mail:    "MAIL_ADDRESS",
+//		Token:   "API_TOKEN",
+//		Timeout: 30 * time.Second,
+//		Options: []transport.Option{transport.WithDeprecationLogger(logger)},
+//	})
+type Config struct {
+	// Site is the Atlassian site URL, it's ignored by the modules targeting a fixed host, e.g. the admin module.
+	Site string
+
+	// HTTPClient executes the requests, http.DefaultClient is used if it's not provided.
+	HTTPClient common.HTTPClient
+
+	// Timeout limits the time spent on every request, it's only applied to *http.Client instances.
+	Timeout time.Duration
+
+	// Mail and Token are the basic authentication credentials.
+	Mail, Token string
+
+	// BearerToken is used instead of the basic authentication credentials, e.g. with OAuth 2.0 access tokens.
+	BearerToken string
+
+	// AuthProvider applies the credentials on every request, replacing the static ones, e.g. to fetch rotated
+	// credentials from a secret manager. See NewBasicAuthProvider, NewBearerAuthProvider and NewOAuth2Provider.
+	AuthProvider common.AuthProvider
+
+	// UserAgent is sent on the User-Agent header of every request.
+	UserAgent string
+
+	// Options are the transport behaviors decorating the HTTP client.
+	Options []Option
+}
+
+// HTTP returns the HTTP client described by the configuration.
+//
+// The provided *http.Client is copied before applying the timeout, and the client is
+// decorated with the transport options, if any.
+func (c *Config) HTTP() common.HTTPClient {
+
+	httpClient := c.HTTPClient
+
+	if c.Timeout > 0 {
+
+		switch client := httpClient.(type) {
+		case nil:
+			httpClient = &http.Client{Timeout: c.Timeout}
+		case *http.Client:
+			copied := *client
+			copied.Timeout = c.Timeout
+			httpClient = &copied
+		}
+	}
+
+	if len(c.Options) != 0 {
+		return New(httpClient, c.Options...)
+	}
+
+	return httpClient
+}
+
+// Authenticate sets the configured credentials, the credentials provider and the user agent on the client authentication service.
+func (c *Config) Authenticate(auth common.Authentication) {
+
+	if c.Mail != "" || c.Token != "" {
+		auth.SetBasicAuth(c.Mail, c.Token)
+	}
+
+	if c.BearerToken != "" {
+		auth.SetBearerToken(c.BearerToken)
+	}
+
+	if c.UserAgent != "" {
+		auth.SetUserAgent(c.UserAgent)
+	}
+
+	if c.AuthProvider != nil {
+		auth.SetAuthProvider(c.AuthProvider)
+	}
+}
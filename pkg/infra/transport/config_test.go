@@ -0,0 +1,68 @@
+package transport
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfig_HTTP(t *testing.T) {
+
+	customClient := &http.Client{Timeout: time.Minute}
+
+	testCases := []struct {
+		name        string
+		config      *Config
+		wantTimeout time.Duration
+		wantNil     bool
+		wantDecor   bool
+	}{
+		{
+			name:    "when no HTTP client nor timeout are provided",
+			config:  &Config{},
+			wantNil: true,
+		},
+
+		{
+			name:        "when only the timeout is provided",
+			config:      &Config{Timeout: 5 * time.Second},
+			wantTimeout: 5 * time.Second,
+		},
+
+		{
+			name:        "when the timeout overrides the HTTP client one",
+			config:      &Config{HTTPClient: customClient, Timeout: 5 * time.Second},
+			wantTimeout: 5 * time.Second,
+		},
+
+		{
+			name:      "when transport options are provided",
+			config:    &Config{HTTPClient: customClient, Options: []Option{WithDeprecationLogger(func(string, string) {})}},
+			wantDecor: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			gotClient := testCase.config.HTTP()
+
+			switch {
+			case testCase.wantNil:
+				assert.Nil(t, gotClient)
+
+			case testCase.wantDecor:
+				decorated, ok := gotClient.(*Client)
+				assert.True(t, ok)
+				assert.Equal(t, customClient, decorated.HTTP)
+
+			default:
+				assert.Equal(t, testCase.wantTimeout, gotClient.(*http.Client).Timeout)
+			}
+		})
+	}
+
+	assert.Equal(t, time.Minute, customClient.Timeout, "the provided HTTP client must not be modified")
+}
@@ -0,0 +1,106 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ctreminiom/go-atlassian/v2/service/common"
+)
+
+// WithHeader sets the header on every request, replacing the value set by the client, if any.
+func WithHeader(key, value string) Option {
+	return func(c *Client) {
+
+		if c.headers == nil {
+			c.headers = make(http.Header)
+		}
+
+		c.headers.Set(key, value)
+	}
+}
+
+// WithTimeout limits the time spent on every request, including the retries and the response body reading.
+//
+// Unlike the Config Timeout, it's applied through the request context, so it works with any HTTP client.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// With returns a shallow copy of the client decorated with the additional options.
+//
+// The decorated HTTP client and the state of the options, e.g. the retry budget or the coalesced requests, are
+// shared with the original client, so the connections are reused. Closing the copy only releases the resources
+// held by the additional options, the decorated HTTP client is closed by the original client.
+func (c *Client) With(options ...Option) *Client {
+
+	derived := *c
+	derived.headers = c.headers.Clone()
+	derived.closers = nil
+	derived.shared = true
+
+	for _, option := range options {
+		option(&derived)
+	}
+
+	return &derived
+}
+
+// Derive decorates the HTTP client with the additional options, reusing the decorator if the client is a *Client.
+//
+// It's used by the module clients With method, the returned client never closes the provided one, even when no
+// option is provided.
+func Derive(httpClient common.HTTPClient, options ...Option) common.HTTPClient {
+
+	if client, ok := httpClient.(*Client); ok {
+		return client.With(options...)
+	}
+
+	derived := New(httpClient, options...)
+	derived.shared = true
+
+	return derived
+}
+
+// prepare applies the headers and the timeout of the client on the request.
+// The returned function releases the timeout context, it's a no-op if no timeout is set.
+func (c *Client) prepare(request *http.Request) (*http.Request, context.CancelFunc) {
+
+	cancel := context.CancelFunc(func() {})
+
+	if c.timeout > 0 {
+		var ctx context.Context
+		ctx, cancel = context.WithTimeout(request.Context(), c.timeout)
+		request = request.WithContext(ctx)
+	}
+
+	if len(c.headers) != 0 {
+
+		if c.timeout <= 0 {
+			request = request.Clone(request.Context())
+		} else {
+			request.Header = request.Header.Clone()
+		}
+
+		for key, values := range c.headers {
+			request.Header[key] = values
+		}
+	}
+
+	return request, cancel
+}
+
+// cancelOnClose releases the timeout context once the response body is closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
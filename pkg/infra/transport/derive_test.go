@@ -0,0 +1,84 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func TestClient_With(t *testing.T) {
+
+	httpClient := &closableHTTPClient{HTTPClient: mocks.NewHTTPClient(t)}
+
+	var deadline time.Time
+	httpClient.On("Do", mock.Anything).
+		Run(func(args mock.Arguments) {
+			deadline, _ = args.Get(0).(*http.Request).Context().Deadline()
+		}).
+		Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil)
+
+	original := New(httpClient, WithHeader("X-Atlassian-Token", "no-check"))
+	derived := original.With(WithHeader("X-Experimentalapi", "opt-in"), WithTimeout(time.Minute))
+
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/myself", nil)
+	assert.NoError(t, err)
+
+	response, err := derived.Do(request)
+	assert.NoError(t, err)
+	assert.False(t, deadline.IsZero())
+	assert.NoError(t, response.Body.Close())
+
+	sent := httpClient.Calls[0].Arguments.Get(0).(*http.Request)
+	assert.Equal(t, "no-check", sent.Header.Get("X-Atlassian-Token"))
+	assert.Equal(t, "opt-in", sent.Header.Get("X-Experimentalapi"))
+	assert.Empty(t, request.Header, "the caller request must not be modified")
+	assert.True(t, errors.Is(sent.Context().Err(), context.Canceled), "the timeout must be released on the body close")
+
+	_, err = original.Do(request)
+	assert.NoError(t, err)
+
+	sent = httpClient.Calls[1].Arguments.Get(0).(*http.Request)
+	assert.Equal(t, "no-check", sent.Header.Get("X-Atlassian-Token"))
+	assert.Empty(t, sent.Header.Get("X-Experimentalapi"))
+
+	assert.NoError(t, derived.Close())
+	assert.False(t, httpClient.closed)
+
+	assert.NoError(t, original.Close())
+	assert.True(t, httpClient.closed)
+}
+
+func TestDerive(t *testing.T) {
+
+	httpClient := &closableHTTPClient{HTTPClient: mocks.NewHTTPClient(t)}
+	httpClient.On("Do", mock.Anything).
+		Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil)
+
+	plain, ok := Derive(httpClient).(*Client)
+	assert.True(t, ok)
+	assert.NoError(t, plain.Close())
+	assert.False(t, httpClient.closed)
+
+	derived, ok := Derive(httpClient, WithHeader("X-Experimentalapi", "opt-in")).(*Client)
+	assert.True(t, ok)
+
+	_, err := derived.Do(&http.Request{Method: http.MethodGet, URL: &url.URL{Path: "rest/api/3/myself"}, Header: http.Header{}})
+	assert.NoError(t, err)
+	assert.Equal(t, "opt-in", httpClient.Calls[0].Arguments.Get(0).(*http.Request).Header.Get("X-Experimentalapi"))
+
+	assert.NoError(t, derived.Close())
+	assert.False(t, httpClient.closed)
+
+	decorated := New(httpClient)
+	assert.NotSame(t, decorated, Derive(decorated))
+}
@@ -0,0 +1,153 @@
+package transport
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// connectJWTExpiration is the lifetime of the tokens signed for every request.
+const connectJWTExpiration = 3 * time.Minute
+
+// confluenceContextPath is excluded from the canonical URI, as the Confluence base URL of the Connect apps includes it.
+const confluenceContextPath = "/wiki"
+
+// WithConnectJWT signs every request with a short-lived Atlassian Connect JWT.
+//
+// The token is signed with HS256 using the shared secret received on the app installation,
+// the issuer is the app key and the qsh claim binds the token to the request method, path and query.
+func WithConnectJWT(sharedSecret, issuer string) Option {
+	return func(c *Client) {
+		c.connectJWT = &connectJWTSigner{sharedSecret: sharedSecret, issuer: issuer, now: time.Now}
+	}
+}
+
+type connectJWTSigner struct {
+	sharedSecret string
+	issuer       string
+	now          func() time.Time
+}
+
+func (s *connectJWTSigner) sign(request *http.Request) error {
+
+	if s.sharedSecret == "" {
+		return model.ErrNoConnectSharedSecret
+	}
+
+	if s.issuer == "" {
+		return model.ErrNoConnectIssuer
+	}
+
+	issuedAt := s.now()
+
+	claims := map[string]interface{}{
+		"iss": s.issuer,
+		"iat": issuedAt.Unix(),
+		"exp": issuedAt.Add(connectJWTExpiration).Unix(),
+		"qsh": QueryStringHash(request.Method, request.URL),
+	}
+
+	token, err := signHS256(claims, s.sharedSecret)
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Authorization", "JWT "+token)
+	return nil
+}
+
+// QueryStringHash returns the qsh claim of an Atlassian Connect JWT,
+// the hex encoded SHA-256 hash of the canonical request.
+func QueryStringHash(method string, u *url.URL) string {
+	hash := sha256.Sum256([]byte(CanonicalRequest(method, u)))
+	return hex.EncodeToString(hash[:])
+}
+
+// CanonicalRequest returns the canonical representation of a request used to compute the qsh claim.
+//
+// It's composed by the upper-cased method, the canonical URI and the canonical query string joined with "&".
+func CanonicalRequest(method string, u *url.URL) string {
+	return strings.Join([]string{strings.ToUpper(method), canonicalURI(u), canonicalQuery(u)}, "&")
+}
+
+func canonicalURI(u *url.URL) string {
+
+	path := strings.TrimPrefix(u.EscapedPath(), confluenceContextPath)
+
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+
+	return strings.ReplaceAll(path, "&", "%26")
+}
+
+func canonicalQuery(u *url.URL) string {
+
+	query, err := url.ParseQuery(u.RawQuery)
+	if err != nil {
+		return ""
+	}
+
+	// The jwt parameter carries the token itself, so it must not be part of the hash.
+	query.Del("jwt")
+
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	params := make([]string, 0, len(keys))
+	for _, key := range keys {
+
+		values := make([]string, 0, len(query[key]))
+		for _, value := range query[key] {
+			values = append(values, percentEncode(value))
+		}
+
+		sort.Strings(values)
+
+		params = append(params, percentEncode(key)+"="+strings.Join(values, ","))
+	}
+
+	return strings.Join(params, "&")
+}
+
+// percentEncode encodes the value following the RFC 3986, spaces are encoded as %20 instead of "+".
+func percentEncode(value string) string {
+	return strings.ReplaceAll(url.QueryEscape(value), "+", "%20")
+}
+
+func signHS256(claims map[string]interface{}, secret string) (string, error) {
+
+	header, err := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), nil
+}
@@ -0,0 +1,170 @@
+package transport
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func TestCanonicalRequest(t *testing.T) {
+
+	testCases := []struct {
+		name   string
+		method string
+		rawURL string
+		want   string
+	}{
+		{
+			name:   "when the query contains repeated and encoded parameters",
+			method: http.MethodGet,
+			rawURL: "https://ctreminiom.atlassian.net/rest/api/3/search?maxResults=50&jql=project%20%3D%20KEY&fields=summary&fields=status",
+			want:   "GET&/rest/api/3/search&fields=status,summary&jql=project%20%3D%20KEY&maxResults=50",
+		},
+
+		{
+			name:   "when the method is lower-cased and the path has a trailing slash",
+			method: "post",
+			rawURL: "https://ctreminiom.atlassian.net/rest/api/3/issue/",
+			want:   "POST&/rest/api/3/issue&",
+		},
+
+		{
+			name:   "when the path is empty",
+			method: http.MethodGet,
+			rawURL: "https://ctreminiom.atlassian.net",
+			want:   "GET&/&",
+		},
+
+		{
+			name:   "when the request targets the confluence context path and contains the jwt parameter",
+			method: http.MethodGet,
+			rawURL: "https://ctreminiom.atlassian.net/wiki/rest/api/content?expand=space&jwt=token",
+			want:   "GET&/rest/api/content&expand=space",
+		},
+
+		{
+			name:   "when the path contains an ampersand",
+			method: http.MethodDelete,
+			rawURL: "https://ctreminiom.atlassian.net/rest/api/3/group/R&D",
+			want:   "DELETE&/rest/api/3/group/R%26D&",
+		},
+
+		{
+			name:   "when the query contains reserved characters",
+			method: http.MethodGet,
+			rawURL: "https://ctreminiom.atlassian.net/rest/api/3/label?query=a+b*c~d&labels=x,y",
+			want:   "GET&/rest/api/3/label&labels=x%2Cy&query=a%20b%2Ac~d",
+		},
+
+		{
+			name:   "when the parameter keys differ on the case",
+			method: http.MethodGet,
+			rawURL: "https://ctreminiom.atlassian.net/rest/api/3/user?b=2&a=1&A=3",
+			want:   "GET&/rest/api/3/user&A=3&a=1&b=2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			u, err := url.Parse(testCase.rawURL)
+			assert.NoError(t, err)
+
+			assert.Equal(t, testCase.want, CanonicalRequest(testCase.method, u))
+
+			hash := sha256.Sum256([]byte(testCase.want))
+			assert.Equal(t, hex.EncodeToString(hash[:]), QueryStringHash(testCase.method, u))
+		})
+	}
+}
+
+func TestClient_Do_ConnectJWT(t *testing.T) {
+
+	u, err := url.Parse("https://ctreminiom.atlassian.net/rest/api/3/myself?expand=groups")
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name         string
+		sharedSecret string
+		issuer       string
+		wantErr      bool
+		Err          error
+	}{
+		{
+			name:         "when the shared secret and issuer are provided",
+			sharedSecret: "shared-secret",
+			issuer:       "com.example.app",
+		},
+
+		{
+			name:    "when the shared secret is not provided",
+			issuer:  "com.example.app",
+			wantErr: true,
+			Err:     model.ErrNoConnectSharedSecret,
+		},
+
+		{
+			name:         "when the issuer is not provided",
+			sharedSecret: "shared-secret",
+			wantErr:      true,
+			Err:          model.ErrNoConnectIssuer,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			request := &http.Request{Method: http.MethodGet, URL: u, Header: http.Header{}}
+
+			httpClient := mocks.NewHTTPClient(t)
+			if !testCase.wantErr {
+				httpClient.On("Do", request).Return(&http.Response{StatusCode: http.StatusOK}, nil)
+			}
+
+			client := New(httpClient, WithConnectJWT(testCase.sharedSecret, testCase.issuer))
+			client.connectJWT.now = func() time.Time { return time.Unix(1700000000, 0) }
+
+			_, err := client.Do(request)
+
+			if testCase.wantErr {
+				assert.EqualError(t, err, testCase.Err.Error())
+				return
+			}
+
+			assert.NoError(t, err)
+
+			authorization := request.Header.Get("Authorization")
+			assert.True(t, strings.HasPrefix(authorization, "JWT "))
+
+			segments := strings.Split(strings.TrimPrefix(authorization, "JWT "), ".")
+			assert.Len(t, segments, 3)
+
+			mac := hmac.New(sha256.New, []byte(testCase.sharedSecret))
+			mac.Write([]byte(segments[0] + "." + segments[1]))
+			assert.Equal(t, base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), segments[2])
+
+			payload, err := base64.RawURLEncoding.DecodeString(segments[1])
+			assert.NoError(t, err)
+
+			claims := make(map[string]interface{})
+			assert.NoError(t, json.Unmarshal(payload, &claims))
+
+			assert.Equal(t, testCase.issuer, claims["iss"])
+			assert.Equal(t, float64(1700000000), claims["iat"])
+			assert.Equal(t, float64(1700000180), claims["exp"])
+			assert.Equal(t, QueryStringHash(http.MethodGet, u), claims["qsh"])
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package transport
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// The modules reported to the metrics collector.
+const (
+	ModuleJira       = "jira"
+	ModuleAgile      = "agile"
+	ModuleSM         = "sm"
+	ModuleConfluence = "confluence"
+	ModuleAssets     = "assets"
+	ModuleAdmin      = "admin"
+	ModuleBitbucket  = "bitbucket"
+	ModuleUnknown    = "unknown"
+)
+
+// Collector receives the metrics of the requests sent to Atlassian.
+//
+// It must be safe for concurrent use, every attempt of a retried request is observed.
+type Collector interface {
+
+	// ObserveRequest records a request sent to a module, the status is 0 when no response is received.
+	ObserveRequest(module, method string, status int, duration time.Duration)
+}
+
+// CollectorFunc adapts a function to the Collector interface.
+type CollectorFunc func(module, method string, status int, duration time.Duration)
+
+// ObserveRequest calls f(module, method, status, duration).
+func (f CollectorFunc) ObserveRequest(module, method string, status int, duration time.Duration) {
+	f(module, method, status, duration)
+}
+
+// WithMetricsCollector reports the module, the method, the status and the duration of every request to the collector.
+//
+// The module is deduced from the URL of the request, e.g. jira, confluence or bitbucket, so a single client can be shared
+// by several modules. It's decoupled from any metrics library, the Prometheus counters and histograms are wired with:
+//
+//	requests := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "atlassian_requests_total"}, []string{"module", "method", "status"})
+//	durations := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: "atlassian_request_duration_seconds"}, []string{"module", "method"})
+//
+//	collector := transport.CollectorFunc(func(module, method string, status int, duration time.Duration) {
+//		requests.WithLabelValues(module, method, strconv.Itoa(status)).Inc()
+//		durations.WithLabelValues(module, method).Observe(duration.Seconds())
+//	})
+//
+//	httpClient := transport.New(http.DefaultClient, transport.WithMetricsCollector(collector))
+func WithMetricsCollector(collector Collector) Option {
+	return func(c *Client) {
+		c.metrics = &metricsObserver{collector: collector, now: time.Now}
+	}
+}
+
+type metricsObserver struct {
+	collector Collector
+	now       func() time.Time
+}
+
+func (m *metricsObserver) observe(request *http.Request, response *http.Response, startedAt time.Time) {
+
+	if m.collector == nil {
+		return
+	}
+
+	var status int
+	if response != nil {
+		status = response.StatusCode
+	}
+
+	m.collector.ObserveRequest(requestModule(request), request.Method, status, m.now().Sub(startedAt))
+}
+
+// requestModule returns the module of the request, deduced from the host and the path of its URL.
+func requestModule(request *http.Request) string {
+
+	if request.URL == nil {
+		return ModuleUnknown
+	}
+
+	path := "/" + strings.TrimPrefix(request.URL.Path, "/")
+
+	switch {
+	case strings.HasSuffix(request.URL.Hostname(), "bitbucket.org"):
+		return ModuleBitbucket
+	case strings.Contains(path, "/jsm/assets/"):
+		return ModuleAssets
+	case strings.Contains(path, "/wiki/"):
+		return ModuleConfluence
+	case strings.Contains(path, "/rest/servicedeskapi/"):
+		return ModuleSM
+	case strings.Contains(path, "/rest/agile/"), strings.Contains(path, "/rest/greenhopper/"):
+		return ModuleAgile
+	case strings.Contains(path, "/rest/"):
+		return ModuleJira
+	case strings.HasPrefix(path, "/admin/"), strings.HasPrefix(path, "/scim/"), strings.HasPrefix(path, "/users/"):
+		return ModuleAdmin
+	}
+
+	return ModuleUnknown
+}
@@ -0,0 +1,136 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func TestClient_Do_MetricsCollector(t *testing.T) {
+
+	testCases := []struct {
+		name       string
+		url        string
+		status     int
+		err        error
+		wantModule string
+		wantStatus int
+	}{
+		{
+			name:       "when the request is sent to jira",
+			url:        "https://ctreminiom.atlassian.net/rest/api/3/myself",
+			status:     http.StatusOK,
+			wantModule: ModuleJira,
+			wantStatus: http.StatusOK,
+		},
+
+		{
+			name:       "when the request is sent to confluence",
+			url:        "https://ctreminiom.atlassian.net/wiki/rest/api/content/65611",
+			status:     http.StatusNotFound,
+			wantModule: ModuleConfluence,
+			wantStatus: http.StatusNotFound,
+		},
+
+		{
+			name:       "when the request is sent to the service management",
+			url:        "https://ctreminiom.atlassian.net/rest/servicedeskapi/request",
+			status:     http.StatusOK,
+			wantModule: ModuleSM,
+			wantStatus: http.StatusOK,
+		},
+
+		{
+			name:       "when the request is sent to agile",
+			url:        "https://ctreminiom.atlassian.net/rest/agile/1.0/board",
+			status:     http.StatusOK,
+			wantModule: ModuleAgile,
+			wantStatus: http.StatusOK,
+		},
+
+		{
+			name:       "when the request is sent to assets",
+			url:        "https://api.atlassian.com/jsm/assets/workspace/workspace-id/v1/object/1",
+			status:     http.StatusOK,
+			wantModule: ModuleAssets,
+			wantStatus: http.StatusOK,
+		},
+
+		{
+			name:       "when the request is sent to admin",
+			url:        "https://api.atlassian.com/admin/v1/orgs",
+			status:     http.StatusOK,
+			wantModule: ModuleAdmin,
+			wantStatus: http.StatusOK,
+		},
+
+		{
+			name:       "when the request is sent to bitbucket",
+			url:        "https://api.bitbucket.org/2.0/workspaces",
+			status:     http.StatusOK,
+			wantModule: ModuleBitbucket,
+			wantStatus: http.StatusOK,
+		},
+
+		{
+			name:       "when the request fails",
+			url:        "https://ctreminiom.atlassian.net/rest/api/3/myself",
+			err:        errors.New("error, unable to execute the http call"),
+			wantModule: ModuleJira,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			u, err := url.Parse(testCase.url)
+			assert.NoError(t, err)
+
+			request := &http.Request{Method: http.MethodGet, URL: u}
+
+			httpClient := mocks.NewHTTPClient(t)
+			if testCase.err != nil {
+				httpClient.On("Do", request).Return(nil, testCase.err)
+			} else {
+				httpClient.On("Do", request).Return(&http.Response{StatusCode: testCase.status}, nil)
+			}
+
+			var gotModule, gotMethod string
+			var gotStatus, calls int
+			var gotDuration time.Duration
+
+			client := New(httpClient, WithMetricsCollector(CollectorFunc(func(module, method string, status int, duration time.Duration) {
+				gotModule, gotMethod, gotStatus, gotDuration = module, method, status, duration
+				calls++
+			})))
+
+			startedAt := time.Unix(1700000000, 0)
+			instants := []time.Time{startedAt, startedAt.Add(250 * time.Millisecond)}
+			client.metrics.now = func() time.Time {
+				instant := instants[0]
+				instants = instants[1:]
+				return instant
+			}
+
+			_, err = client.Do(request)
+
+			if testCase.err != nil {
+				assert.EqualError(t, err, testCase.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, 1, calls)
+			assert.Equal(t, testCase.wantModule, gotModule)
+			assert.Equal(t, http.MethodGet, gotMethod)
+			assert.Equal(t, testCase.wantStatus, gotStatus)
+			assert.Equal(t, 250*time.Millisecond, gotDuration)
+		})
+	}
+}
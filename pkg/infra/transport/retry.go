@@ -0,0 +1,246 @@
+package transport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+const (
+	// retryBaseDelay is the delay before the first retry when Atlassian doesn't return the Retry-After header.
+	retryBaseDelay = 500 * time.Millisecond
+
+	// retryMaxDelay is the maximum delay between two attempts of a request.
+	retryMaxDelay = 30 * time.Second
+
+	// retryBudgetMinRetries is the number of retries allowed regardless of the budget ratio, so the first
+	// requests of the client can be retried too.
+	retryBudgetMinRetries = 10
+)
+
+// WithRetry retries the requests rejected with the 429 Too Many Requests status, and the idempotent requests
+// failed with the 502, 503 or 504 statuses, up to maxRetries times per request. The attempts retried can be
+// customized with WithRetryClassifier.
+//
+// The requests are only retried if their body can be sent again, see Replayable. The streamed bodies implementing
+// io.Seeker are rewound, the other streamed bodies aren't retried.
+//
+// The retries are delayed by the Retry-After header when Atlassian returns it, otherwise by an exponential backoff.
+//
+// The retries are limited by a budget shared by every request of the client: once the retries exceed the budget
+// ratio of the requests executed, e.g. 0.1 for 10%, the requests aren't retried anymore and model.ErrRetryBudgetExhausted
+// is returned instead of the failed response. It prevents the retry storms from masking an outage, the budget
+// state is available through Client.RetryBudget. A ratio of 0 or less disables the budget.
+func WithRetry(maxRetries int, budgetRatio float64) Option {
+	return func(c *Client) {
+		c.retry = &retrier{
+			maxRetries: maxRetries,
+			budget:     &retryBudget{ratio: budgetRatio},
+			sleep:      sleepContext,
+		}
+	}
+}
+
+// RetryClassifier reports if an attempt of a request is retried, it receives either the response or the error of the attempt.
+//
+// The body of the responses with a 4xx or 5xx status can be read, e.g. to look for an error code, it's restored
+// once the classifier returns.
+type RetryClassifier func(request *http.Request, response *http.Response, err error) bool
+
+// DefaultRetryClassifier retries the requests rejected with the 429 Too Many Requests status, and the idempotent
+// requests failed with the 502, 503 or 504 statuses. The transport errors aren't retried.
+func DefaultRetryClassifier(request *http.Request, response *http.Response, err error) bool {
+	return err == nil && response != nil && retryable(request, response)
+}
+
+// WithRetryClassifier replaces the DefaultRetryClassifier deciding which attempts are retried by WithRetry, e.g. to
+// retry a transient error returned with the 400 Bad Request status. The retries are still limited by the maximum
+// retries and the retry budget, and the option has no effect unless the retries are enabled with WithRetry.
+func WithRetryClassifier(classifier RetryClassifier) Option {
+	return func(c *Client) {
+		c.retryClassifier = classifier
+	}
+}
+
+// RetryBudgetState represents the state of the retry budget of a client, e.g. to export it as metrics.
+type RetryBudgetState struct {
+	Ratio     float64 // The maximum ratio of retries to requests.
+	Requests  int64   // The number of requests executed, the retries excluded.
+	Retries   int64   // The number of retries executed.
+	Exhausted int64   // The number of retries refused because the budget was exhausted.
+}
+
+// RetryBudget returns the state of the retry budget, it's empty if the retries aren't enabled with WithRetry.
+func (c *Client) RetryBudget() RetryBudgetState {
+
+	if c.retry == nil {
+		return RetryBudgetState{}
+	}
+
+	return c.retry.budget.state()
+}
+
+type retrier struct {
+	maxRetries int
+	budget     *retryBudget
+	sleep      func(ctx context.Context, delay time.Duration) error
+}
+
+func (r *retrier) do(request *http.Request, roundTrip func(*http.Request) (*http.Response, error), classifier RetryClassifier) (*http.Response, error) {
+
+	r.budget.request()
+
+	for attempt := 0; ; attempt++ {
+
+		response, err := roundTrip(request)
+		if attempt >= r.maxRetries || !classify(classifier, request, response, err) {
+			return response, err
+		}
+
+		// The attempt is returned as is when the body can't be sent again, the budget is kept for the retries executed.
+		if !Replayable(request) {
+			return response, err
+		}
+
+		next := request
+		if request.Body != nil && request.Body != http.NoBody {
+
+			next = request.Clone(request.Context())
+			if rewindErr := RewindBody(next); rewindErr != nil {
+				return response, err
+			}
+		}
+
+		if !r.budget.withdraw() {
+
+			if response == nil {
+				return nil, fmt.Errorf("%w: %v", model.ErrRetryBudgetExhausted, err)
+			}
+
+			drain(response)
+			return nil, fmt.Errorf("%w: %v %v", model.ErrRetryBudgetExhausted, response.StatusCode, request.URL)
+		}
+
+		delay := retryDelay(response, attempt)
+		drain(response)
+
+		if err := r.sleep(request.Context(), delay); err != nil {
+			return nil, err
+		}
+
+		request = next
+	}
+}
+
+// classify reports if the attempt is retried, the DefaultRetryClassifier is used if no classifier is set.
+//
+// The body of the failed response is buffered, so the classifier can read it without consuming it.
+func classify(classifier RetryClassifier, request *http.Request, response *http.Response, err error) bool {
+
+	if classifier == nil {
+		return DefaultRetryClassifier(request, response, err)
+	}
+
+	if response != nil && response.Body != nil && response.StatusCode >= http.StatusBadRequest {
+
+		body, _ := io.ReadAll(response.Body)
+		_ = response.Body.Close()
+
+		response.Body = io.NopCloser(bytes.NewReader(body))
+		defer func() { response.Body = io.NopCloser(bytes.NewReader(body)) }()
+	}
+
+	return classifier(request, response, err)
+}
+
+// retryable reports if the failed response can be retried, the 5xx statuses are only retried on the idempotent methods.
+func retryable(request *http.Request, response *http.Response) bool {
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		switch request.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+			return true
+		}
+	}
+
+	return false
+}
+
+func retryDelay(response *http.Response, attempt int) time.Duration {
+
+	if response == nil {
+		return min(retryBaseDelay<<attempt, retryMaxDelay)
+	}
+
+	if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+		return min(time.Duration(seconds)*time.Second, retryMaxDelay)
+	}
+
+	return min(retryBaseDelay<<attempt, retryMaxDelay)
+}
+
+func drain(response *http.Response) {
+
+	if response != nil && response.Body != nil {
+		_, _ = io.Copy(io.Discard, response.Body)
+		_ = response.Body.Close()
+	}
+}
+
+func sleepContext(ctx context.Context, delay time.Duration) error {
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type retryBudget struct {
+	mu                           sync.Mutex
+	ratio                        float64
+	requests, retries, exhausted int64
+}
+
+func (b *retryBudget) request() {
+	b.mu.Lock()
+	b.requests++
+	b.mu.Unlock()
+}
+
+// withdraw reserves a retry, it reports false if the retry would exceed the budget.
+func (b *retryBudget) withdraw() bool {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.ratio > 0 && float64(b.retries+1) > b.ratio*float64(b.requests)+retryBudgetMinRetries {
+		b.exhausted++
+		return false
+	}
+
+	b.retries++
+	return true
+}
+
+func (b *retryBudget) state() RetryBudgetState {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return RetryBudgetState{Ratio: b.ratio, Requests: b.requests, Retries: b.retries, Exhausted: b.exhausted}
+}
@@ -0,0 +1,323 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func TestClient_Do_Retry(t *testing.T) {
+
+	u, err := url.Parse("https://ctreminiom.atlassian.net/rest/api/3/myself")
+	assert.NoError(t, err)
+
+	rateLimited := func() *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     http.Header{"Retry-After": {"2"}},
+			Body:       io.NopCloser(strings.NewReader("")),
+		}
+	}
+
+	unavailable := func() *http.Response {
+		return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: io.NopCloser(strings.NewReader(""))}
+	}
+
+	testCases := []struct {
+		name        string
+		method      string
+		maxRetries  int
+		responses   []*http.Response
+		wantStatus  int
+		wantDelays  []time.Duration
+		wantRetries int64
+	}{
+		{
+			name:        "when the request is rate limited",
+			method:      http.MethodGet,
+			maxRetries:  3,
+			responses:   []*http.Response{rateLimited(), {StatusCode: http.StatusOK}},
+			wantStatus:  http.StatusOK,
+			wantDelays:  []time.Duration{2 * time.Second},
+			wantRetries: 1,
+		},
+
+		{
+			name:        "when the service is unavailable",
+			method:      http.MethodGet,
+			maxRetries:  3,
+			responses:   []*http.Response{unavailable(), unavailable(), {StatusCode: http.StatusOK}},
+			wantStatus:  http.StatusOK,
+			wantDelays:  []time.Duration{500 * time.Millisecond, time.Second},
+			wantRetries: 2,
+		},
+
+		{
+			name:        "when the retries are exceeded",
+			method:      http.MethodGet,
+			maxRetries:  1,
+			responses:   []*http.Response{unavailable(), unavailable()},
+			wantStatus:  http.StatusServiceUnavailable,
+			wantDelays:  []time.Duration{500 * time.Millisecond},
+			wantRetries: 1,
+		},
+
+		{
+			name:       "when the non idempotent request fails",
+			method:     http.MethodPost,
+			maxRetries: 3,
+			responses:  []*http.Response{unavailable()},
+			wantStatus: http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			request := &http.Request{Method: testCase.method, URL: u}
+
+			httpClient := mocks.NewHTTPClient(t)
+			for _, response := range testCase.responses {
+				httpClient.On("Do", request).Return(response, nil).Once()
+			}
+
+			client := New(httpClient, WithRetry(testCase.maxRetries, 0.1))
+
+			var delays []time.Duration
+			client.retry.sleep = func(ctx context.Context, delay time.Duration) error {
+				delays = append(delays, delay)
+				return nil
+			}
+
+			response, err := client.Do(request)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantStatus, response.StatusCode)
+			assert.Equal(t, testCase.wantDelays, delays)
+			assert.Equal(t, RetryBudgetState{Ratio: 0.1, Requests: 1, Retries: testCase.wantRetries}, client.RetryBudget())
+		})
+	}
+}
+
+func TestClient_Do_RetryBody(t *testing.T) {
+
+	request, err := http.NewRequest(http.MethodPost, "https://ctreminiom.atlassian.net/rest/api/3/issue", strings.NewReader(`{"fields":{}}`))
+	assert.NoError(t, err)
+
+	var bodies []string
+
+	httpClient := mocks.NewHTTPClient(t)
+	httpClient.On("Do", mock.Anything).
+		Run(func(args mock.Arguments) {
+			body, _ := io.ReadAll(args.Get(0).(*http.Request).Body)
+			bodies = append(bodies, string(body))
+		}).
+		Return(&http.Response{StatusCode: http.StatusTooManyRequests, Body: http.NoBody}, nil).Once()
+	httpClient.On("Do", mock.Anything).
+		Run(func(args mock.Arguments) {
+			body, _ := io.ReadAll(args.Get(0).(*http.Request).Body)
+			bodies = append(bodies, string(body))
+		}).
+		Return(&http.Response{StatusCode: http.StatusCreated}, nil).Once()
+
+	client := New(httpClient, WithRetry(3, 0))
+	client.retry.sleep = func(ctx context.Context, delay time.Duration) error { return nil }
+
+	response, err := client.Do(request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, response.StatusCode)
+	assert.Equal(t, []string{`{"fields":{}}`, `{"fields":{}}`}, bodies)
+}
+
+func TestClient_Do_RetryBudgetExhausted(t *testing.T) {
+
+	u, err := url.Parse("https://ctreminiom.atlassian.net/rest/api/3/myself")
+	assert.NoError(t, err)
+
+	request := &http.Request{Method: http.MethodGet, URL: u}
+
+	httpClient := mocks.NewHTTPClient(t)
+	httpClient.On("Do", request).Return(func(*http.Request) *http.Response {
+		return &http.Response{StatusCode: http.StatusServiceUnavailable, Body: http.NoBody}
+	}, nil)
+
+	client := New(httpClient, WithRetry(1, 0.1))
+	client.retry.sleep = func(ctx context.Context, delay time.Duration) error { return nil }
+
+	// The 10 minimum retries and 10% of the requests executed are allowed, so the 12th request exhausts the budget.
+	for index := 0; index < 11; index++ {
+		response, err := client.Do(request)
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, response.StatusCode)
+	}
+
+	response, err := client.Do(request)
+	assert.Nil(t, response)
+	assert.True(t, errors.Is(err, model.ErrRetryBudgetExhausted))
+
+	assert.Equal(t, RetryBudgetState{Ratio: 0.1, Requests: 12, Retries: 11, Exhausted: 1}, client.RetryBudget())
+}
+
+func TestClient_Do_RetryClassifier(t *testing.T) {
+
+	u, err := url.Parse("https://ctreminiom.atlassian.net/rest/api/3/issue")
+	assert.NoError(t, err)
+
+	// The transient error is retried, the body read by the classifier is still returned to the caller.
+	transient := func(request *http.Request, response *http.Response, err error) bool {
+
+		if err != nil {
+			return true
+		}
+
+		if response.StatusCode != http.StatusBadRequest {
+			return false
+		}
+
+		body, _ := io.ReadAll(response.Body)
+		return strings.Contains(string(body), "LOCK_TIMEOUT")
+	}
+
+	testCases := []struct {
+		name      string
+		responses []*http.Response
+		errs      []error
+		wantBody  string
+		wantErr   error
+	}{
+		{
+			name: "when the classifier retries the response",
+			responses: []*http.Response{
+				{StatusCode: http.StatusBadRequest, Body: io.NopCloser(strings.NewReader(`{"errorCode":"LOCK_TIMEOUT"}`))},
+				{StatusCode: http.StatusCreated, Body: io.NopCloser(strings.NewReader(`{"id":"10001"}`))},
+			},
+			errs:     []error{nil, nil},
+			wantBody: `{"id":"10001"}`,
+		},
+
+		{
+			name: "when the classifier does not retry the response",
+			responses: []*http.Response{
+				{StatusCode: http.StatusBadRequest, Body: io.NopCloser(strings.NewReader(`{"errorCode":"INVALID_INPUT"}`))},
+			},
+			errs:     []error{nil},
+			wantBody: `{"errorCode":"INVALID_INPUT"}`,
+		},
+
+		{
+			name: "when the classifier retries the transport error",
+			responses: []*http.Response{
+				nil,
+				{StatusCode: http.StatusCreated, Body: io.NopCloser(strings.NewReader(`{"id":"10001"}`))},
+			},
+			errs:     []error{errors.New("connection reset by peer"), nil},
+			wantBody: `{"id":"10001"}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			request := &http.Request{Method: http.MethodPost, URL: u}
+
+			httpClient := mocks.NewHTTPClient(t)
+			for index, response := range testCase.responses {
+				httpClient.On("Do", request).Return(response, testCase.errs[index]).Once()
+			}
+
+			client := New(httpClient, WithRetryClassifier(transient), WithRetry(3, 0))
+			client.retry.sleep = func(ctx context.Context, delay time.Duration) error { return nil }
+
+			response, err := client.Do(request)
+			assert.NoError(t, err)
+
+			body, err := io.ReadAll(response.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.wantBody, string(body))
+			assert.Equal(t, int64(len(testCase.responses)-1), client.RetryBudget().Retries)
+		})
+	}
+}
+
+// seekableBody is a streamed body without GetBody, it's rewound by the retries.
+type seekableBody struct {
+	*strings.Reader
+}
+
+func (seekableBody) Close() error { return nil }
+
+func TestClient_Do_RetrySeekableBody(t *testing.T) {
+
+	u, err := url.Parse("https://ctreminiom.atlassian.net/rest/api/3/issue")
+	assert.NoError(t, err)
+
+	request := &http.Request{Method: http.MethodPost, URL: u, Body: seekableBody{strings.NewReader(`{"fields":{}}`)}}
+
+	var bodies []string
+
+	httpClient := mocks.NewHTTPClient(t)
+	httpClient.On("Do", mock.Anything).
+		Run(func(args mock.Arguments) {
+			body, _ := io.ReadAll(args.Get(0).(*http.Request).Body)
+			bodies = append(bodies, string(body))
+		}).
+		Return(&http.Response{StatusCode: http.StatusTooManyRequests, Body: http.NoBody}, nil).Once()
+	httpClient.On("Do", mock.Anything).
+		Run(func(args mock.Arguments) {
+			body, _ := io.ReadAll(args.Get(0).(*http.Request).Body)
+			bodies = append(bodies, string(body))
+		}).
+		Return(&http.Response{StatusCode: http.StatusCreated}, nil).Once()
+
+	client := New(httpClient, WithRetry(3, 0))
+	client.retry.sleep = func(ctx context.Context, delay time.Duration) error { return nil }
+
+	response, err := client.Do(request)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, response.StatusCode)
+	assert.Equal(t, []string{`{"fields":{}}`, `{"fields":{}}`}, bodies)
+}
+
+func TestClient_Do_RetryNotReplayable(t *testing.T) {
+
+	u, err := url.Parse("https://ctreminiom.atlassian.net/rest/api/3/issue")
+	assert.NoError(t, err)
+
+	// The streamed body can't be sent again, so the transport error is returned instead of being retried.
+	request := &http.Request{Method: http.MethodPost, URL: u, Body: io.NopCloser(strings.NewReader(`{"fields":{}}`))}
+
+	httpClient := mocks.NewHTTPClient(t)
+	httpClient.On("Do", request).Return(nil, errors.New("connection reset by peer")).Once()
+
+	retryAll := func(request *http.Request, response *http.Response, err error) bool { return true }
+
+	client := New(httpClient, WithRetryClassifier(retryAll), WithRetry(3, 0))
+	client.retry.sleep = func(ctx context.Context, delay time.Duration) error { return nil }
+
+	response, err := client.Do(request)
+	assert.Nil(t, response)
+	assert.EqualError(t, err, "connection reset by peer")
+	assert.Equal(t, RetryBudgetState{Requests: 1}, client.RetryBudget())
+}
+
+func TestDefaultRetryClassifier(t *testing.T) {
+
+	get := &http.Request{Method: http.MethodGet}
+	post := &http.Request{Method: http.MethodPost}
+
+	assert.True(t, DefaultRetryClassifier(post, &http.Response{StatusCode: http.StatusTooManyRequests}, nil))
+	assert.True(t, DefaultRetryClassifier(get, &http.Response{StatusCode: http.StatusServiceUnavailable}, nil))
+	assert.False(t, DefaultRetryClassifier(post, &http.Response{StatusCode: http.StatusServiceUnavailable}, nil))
+	assert.False(t, DefaultRetryClassifier(get, &http.Response{StatusCode: http.StatusBadRequest}, nil))
+	assert.False(t, DefaultRetryClassifier(get, nil, errors.New("connection reset by peer")))
+}
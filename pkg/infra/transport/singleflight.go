@@ -0,0 +1,105 @@
+package transport
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// WithSingleflight coalesces the identical GET requests executed at the same time into a single round trip.
+//
+// The requests are identical when they target the same URL with the same Authorization and Accept headers, so the
+// requests asking for another media type, e.g. with models.WithAccept, aren't coalesced. The first request
+// is executed and the other ones wait for its response, then every caller receives a copy of the response with
+// its own body reader. It prevents the thundering-herd fetches of the same metadata, e.g. the fields or statuses,
+// when many goroutines share the client.
+//
+// The response and the error of the executed request are shared, so canceling its context fails the waiting requests
+// too, while a waiting request gives up as soon as its own context is canceled. The response body is buffered in memory
+// only when it's shared with waiting requests, otherwise it's returned unread, so the large downloads or the responses
+// streamed with CallStream are buffered only if they're coalesced.
+func WithSingleflight() Option {
+	return func(c *Client) {
+		c.singleflight = &singleflightGroup{flights: make(map[string]*flight)}
+	}
+}
+
+type singleflightGroup struct {
+	mu      sync.Mutex
+	flights map[string]*flight
+}
+
+type flight struct {
+	done     chan struct{}
+	response *http.Response
+	body     []byte
+	err      error
+
+	// waiters is the number of requests waiting for the flight, the response is only buffered when there's any.
+	waiters int
+}
+
+func (g *singleflightGroup) do(request *http.Request, roundTrip func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+
+	key := request.URL.String() + "\n" + request.Header.Get("Authorization") + "\n" + request.Header.Get("Accept")
+
+	g.mu.Lock()
+	if current, ok := g.flights[key]; ok {
+		current.waiters++
+		g.mu.Unlock()
+
+		select {
+		case <-current.done:
+			return current.share(request)
+		case <-request.Context().Done():
+
+			g.mu.Lock()
+			current.waiters--
+			g.mu.Unlock()
+
+			return nil, request.Context().Err()
+		}
+	}
+
+	current := &flight{done: make(chan struct{})}
+	g.flights[key] = current
+	g.mu.Unlock()
+
+	response, err := roundTrip(request)
+
+	g.mu.Lock()
+	delete(g.flights, key)
+	shared := current.waiters != 0
+	g.mu.Unlock()
+
+	defer close(current.done)
+
+	if !shared {
+		return response, err
+	}
+
+	current.response, current.err = response, err
+
+	if current.err == nil && current.response != nil && current.response.Body != nil {
+		current.body, current.err = io.ReadAll(current.response.Body)
+		_ = current.response.Body.Close()
+	}
+
+	return current.share(request)
+}
+
+// share returns a copy of the flight response bound to the request, with its own body reader.
+func (f *flight) share(request *http.Request) (*http.Response, error) {
+
+	if f.err != nil || f.response == nil {
+		return nil, f.err
+	}
+
+	response := *f.response
+	response.Header = f.response.Header.Clone()
+	response.Body = io.NopCloser(bytes.NewReader(f.body))
+	response.Request = request
+
+	return &response, nil
+}
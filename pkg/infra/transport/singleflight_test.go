@@ -0,0 +1,254 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// blockingHTTPClient holds the requests until it's released.
+type blockingHTTPClient struct {
+	calls   int32
+	release chan struct{}
+	err     error
+}
+
+func (b *blockingHTTPClient) Do(request *http.Request) (*http.Response, error) {
+
+	atomic.AddInt32(&b.calls, 1)
+	<-b.release
+
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(`[{"id":"summary"}]`)),
+		Request:    request,
+	}, nil
+}
+
+func TestClient_Do_Singleflight(t *testing.T) {
+
+	testCases := []struct {
+		name    string
+		err     error
+		wantErr bool
+	}{
+		{
+			name: "when the identical requests are coalesced",
+		},
+
+		{
+			name:    "when the coalesced request fails",
+			err:     errors.New("error, unable to execute the http call"),
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			const callers = 20
+
+			httpClient := &blockingHTTPClient{release: make(chan struct{}), err: testCase.err}
+			client := New(httpClient, WithSingleflight())
+
+			var (
+				wg     sync.WaitGroup
+				bodies = make([]string, callers)
+				errs   = make([]error, callers)
+			)
+
+			for index := 0; index < callers; index++ {
+
+				wg.Add(1)
+				go func(index int) {
+					defer wg.Done()
+
+					request, err := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/field", nil)
+					if err != nil {
+						errs[index] = err
+						return
+					}
+
+					response, err := client.Do(request)
+					if err != nil {
+						errs[index] = err
+						return
+					}
+					defer response.Body.Close()
+
+					body, err := io.ReadAll(response.Body)
+					bodies[index], errs[index] = string(body), err
+				}(index)
+			}
+
+			assert.Eventually(t, func() bool {
+				client.singleflight.mu.Lock()
+				defer client.singleflight.mu.Unlock()
+
+				for _, current := range client.singleflight.flights {
+					return current.waiters == callers-1
+				}
+
+				return false
+			}, 5*time.Second, time.Millisecond)
+
+			close(httpClient.release)
+			wg.Wait()
+
+			assert.Equal(t, int32(1), atomic.LoadInt32(&httpClient.calls))
+
+			for index := 0; index < callers; index++ {
+
+				if testCase.wantErr {
+					assert.EqualError(t, errs[index], testCase.err.Error())
+					continue
+				}
+
+				assert.NoError(t, errs[index])
+				assert.Equal(t, `[{"id":"summary"}]`, bodies[index])
+			}
+		})
+	}
+}
+
+func TestClient_Do_SingleflightSequentialRequests(t *testing.T) {
+
+	httpClient := &blockingHTTPClient{release: make(chan struct{})}
+	close(httpClient.release)
+
+	client := New(httpClient, WithSingleflight())
+
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+
+		request, err := http.NewRequest(method, "https://ctreminiom.atlassian.net/rest/api/3/field", nil)
+		assert.NoError(t, err)
+
+		response, err := client.Do(request)
+		assert.NoError(t, err)
+		assert.Equal(t, request, response.Request)
+	}
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&httpClient.calls))
+	assert.Empty(t, client.singleflight.flights)
+}
+
+func TestClient_Do_SingleflightCanceledWaiter(t *testing.T) {
+
+	httpClient := &blockingHTTPClient{release: make(chan struct{})}
+	client := New(httpClient, WithSingleflight())
+
+	leader := make(chan error, 1)
+	go func() {
+
+		request, err := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/field", nil)
+		if err != nil {
+			leader <- err
+			return
+		}
+
+		response, err := client.Do(request)
+		if err == nil {
+			err = response.Body.Close()
+		}
+
+		leader <- err
+	}()
+
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&httpClient.calls) == 1
+	}, 5*time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/field", nil)
+	assert.NoError(t, err)
+
+	_, err = client.Do(request)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	close(httpClient.release)
+	assert.NoError(t, <-leader)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&httpClient.calls))
+}
+
+func TestClient_Do_SingleflightDifferentAccept(t *testing.T) {
+
+	httpClient := &blockingHTTPClient{release: make(chan struct{})}
+	client := New(httpClient, WithSingleflight())
+
+	var (
+		wg   sync.WaitGroup
+		errs = make([]error, 2)
+	)
+
+	for index, accept := range []string{"application/json", "application/xml"} {
+
+		wg.Add(1)
+		go func(index int, accept string) {
+			defer wg.Done()
+
+			request, err := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/field", nil)
+			if err != nil {
+				errs[index] = err
+				return
+			}
+
+			request.Header.Set("Accept", accept)
+
+			response, err := client.Do(request)
+			if err == nil {
+				err = response.Body.Close()
+			}
+
+			errs[index] = err
+		}(index, accept)
+	}
+
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&httpClient.calls) == 2
+	}, 5*time.Second, time.Millisecond)
+
+	close(httpClient.release)
+	wg.Wait()
+
+	assert.NoError(t, errs[0])
+	assert.NoError(t, errs[1])
+	assert.Equal(t, int32(2), atomic.LoadInt32(&httpClient.calls))
+	assert.Empty(t, client.singleflight.flights)
+}
+
+// bodyHTTPClient returns the same body on every response.
+type bodyHTTPClient struct {
+	body io.ReadCloser
+}
+
+func (b *bodyHTTPClient) Do(request *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Body: b.body, Request: request}, nil
+}
+
+func TestClient_Do_SingleflightUnsharedBody(t *testing.T) {
+
+	httpClient := &bodyHTTPClient{body: &seekableBody{strings.NewReader("%PDF-1.7")}}
+	client := New(httpClient, WithSingleflight())
+
+	request, err := http.NewRequest(http.MethodGet, "https://ctreminiom.atlassian.net/rest/api/3/attachment/content/10000", nil)
+	assert.NoError(t, err)
+
+	response, err := client.Do(request)
+	assert.NoError(t, err)
+	assert.Same(t, httpClient.body, response.Body)
+}
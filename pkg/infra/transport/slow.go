@@ -0,0 +1,41 @@
+package transport
+
+import (
+	"net/http"
+	"time"
+)
+
+// WithSlowRequestCallback sets a callback invoked when a request takes longer than the threshold.
+//
+// The callback is invoked once the response is received, or the request fails, and before the
+// response is returned to the service, so it can be used to emit alerts on slow Atlassian responses.
+func WithSlowRequestCallback(threshold time.Duration, callback func(method, url string, duration time.Duration)) Option {
+	return func(c *Client) {
+		c.slowRequest = &slowRequestNotifier{threshold: threshold, callback: callback, now: time.Now}
+	}
+}
+
+type slowRequestNotifier struct {
+	threshold time.Duration
+	callback  func(method, url string, duration time.Duration)
+	now       func() time.Time
+}
+
+func (s *slowRequestNotifier) notify(request *http.Request, startedAt time.Time) {
+
+	if s.callback == nil {
+		return
+	}
+
+	duration := s.now().Sub(startedAt)
+	if duration <= s.threshold {
+		return
+	}
+
+	var endpoint string
+	if request.URL != nil {
+		endpoint = request.URL.String()
+	}
+
+	s.callback(request.Method, endpoint, duration)
+}
@@ -0,0 +1,93 @@
+package transport
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func TestClient_Do_SlowRequestCallback(t *testing.T) {
+
+	u, err := url.Parse("https://ctreminiom.atlassian.net/rest/api/3/myself")
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name         string
+		elapsed      time.Duration
+		err          error
+		wantCallback bool
+	}{
+		{
+			name:         "when the request exceeds the threshold",
+			elapsed:      3 * time.Second,
+			wantCallback: true,
+		},
+
+		{
+			name:    "when the request does not exceed the threshold",
+			elapsed: 500 * time.Millisecond,
+		},
+
+		{
+			name:         "when the request exceeds the threshold and fails",
+			elapsed:      3 * time.Second,
+			err:          errors.New("error, unable to execute the http call"),
+			wantCallback: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			request := &http.Request{Method: http.MethodGet, URL: u}
+
+			httpClient := mocks.NewHTTPClient(t)
+			if testCase.err != nil {
+				httpClient.On("Do", request).Return(nil, testCase.err)
+			} else {
+				httpClient.On("Do", request).Return(&http.Response{StatusCode: http.StatusOK}, nil)
+			}
+
+			var gotMethod, gotURL string
+			var gotDuration time.Duration
+			var calls int
+
+			client := New(httpClient, WithSlowRequestCallback(2*time.Second, func(method, url string, duration time.Duration) {
+				gotMethod, gotURL, gotDuration = method, url, duration
+				calls++
+			}))
+
+			startedAt := time.Unix(1700000000, 0)
+			instants := []time.Time{startedAt, startedAt.Add(testCase.elapsed)}
+			client.slowRequest.now = func() time.Time {
+				instant := instants[0]
+				instants = instants[1:]
+				return instant
+			}
+
+			_, err := client.Do(request)
+
+			if testCase.err != nil {
+				assert.EqualError(t, err, testCase.err.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if !testCase.wantCallback {
+				assert.Equal(t, 0, calls)
+				return
+			}
+
+			assert.Equal(t, 1, calls)
+			assert.Equal(t, http.MethodGet, gotMethod)
+			assert.Equal(t, u.String(), gotURL)
+			assert.Equal(t, testCase.elapsed, gotDuration)
+		})
+	}
+}
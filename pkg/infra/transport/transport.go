@@ -0,0 +1,199 @@
+// Package transport provides a common.HTTPClient decorator used to plug cross-cutting behaviors,
+// such as the deprecation notices logging, the Atlassian Connect JWT signing, the slow requests alerting,
+// the metrics collection, the identical requests coalescing or the retries, into any of the go-atlassian clients.
+//
+// The decorator is passed as the httpClient parameter of the module constructors:
+//
+//	httpClient := transport.New(http.DefaultClient, transport.WithDeprecationLogger(logger))
+//
+//	instance, err := v3.New(httpClient, "INSTANCE_HOST")
+package transport
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+	"github.com/ctreminiom/go-atlassian/v2/service/common"
+)
+
+// Option configures the Client decorator.
+type Option func(*Client)
+
+// New creates a new Client decorating the provided HTTP client.
+// If a nil httpClient is provided, http.DefaultClient will be used.
+func New(httpClient common.HTTPClient, options ...Option) *Client {
+
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	client := &Client{HTTP: httpClient}
+
+	for _, option := range options {
+		option(client)
+	}
+
+	return client
+}
+
+// Client decorates a common.HTTPClient with the behaviors configured through the options.
+type Client struct {
+	// HTTP is the decorated HTTP client, it executes the requests.
+	HTTP common.HTTPClient
+
+	deprecationLogger func(endpoint, warning string)
+	connectJWT        *connectJWTSigner
+	slowRequest       *slowRequestNotifier
+	metrics           *metricsObserver
+	singleflight      *singleflightGroup
+	retry             *retrier
+	retryClassifier   RetryClassifier
+	headers           http.Header
+	timeout           time.Duration
+
+	// shared is set on the derived clients, they don't close the decorated HTTP client.
+	shared bool
+
+	// closers release the resources held by the options, e.g. background workers, on Close.
+	closers []func() error
+}
+
+// WithDeprecationLogger sets a callback invoked when Atlassian flags an endpoint as deprecated.
+//
+// The callback receives the endpoint called and every warning found on the Warning, Deprecation or Sunset response headers.
+func WithDeprecationLogger(logger func(endpoint, warning string)) Option {
+	return func(c *Client) {
+		c.deprecationLogger = logger
+	}
+}
+
+// Do executes the request using the decorated HTTP client.
+func (c *Client) Do(request *http.Request) (*http.Response, error) {
+
+	request, cancel := c.prepare(request)
+
+	response, err := c.do(request)
+	if err != nil || response == nil || response.Body == nil {
+		cancel()
+		return response, err
+	}
+
+	if c.timeout > 0 {
+		response.Body = &cancelOnClose{ReadCloser: response.Body, cancel: cancel}
+	}
+
+	return response, nil
+}
+
+func (c *Client) do(request *http.Request) (*http.Response, error) {
+
+	send := c.roundTrip
+	if c.retry != nil {
+		send = func(request *http.Request) (*http.Response, error) {
+			return c.retry.do(request, c.roundTrip, c.retryClassifier)
+		}
+	}
+
+	if c.singleflight != nil && request.Method == http.MethodGet {
+		return c.singleflight.do(request, send)
+	}
+
+	return send(request)
+}
+
+func (c *Client) roundTrip(request *http.Request) (*http.Response, error) {
+
+	if c.connectJWT != nil {
+		if err := c.connectJWT.sign(request); err != nil {
+			return nil, err
+		}
+	}
+
+	var startedAt, observedAt time.Time
+	if c.slowRequest != nil {
+		startedAt = c.slowRequest.now()
+	}
+
+	if c.metrics != nil {
+		observedAt = c.metrics.now()
+	}
+
+	response, err := c.HTTP.Do(request)
+
+	if c.slowRequest != nil {
+		c.slowRequest.notify(request, startedAt)
+	}
+
+	if c.metrics != nil {
+		c.metrics.observe(request, response, observedAt)
+	}
+
+	if err != nil {
+		return response, err
+	}
+
+	c.notifyDeprecation(response)
+
+	return response, nil
+}
+
+// Close releases the resources held by the options and closes the decorated HTTP client if it implements io.Closer.
+// The clients returned by With don't close the decorated HTTP client.
+//
+// The closers are called in the reverse order of their registration, and every error is returned joined.
+// The client must not be used after Close.
+func (c *Client) Close() error {
+
+	var errs []error
+	for index := len(c.closers) - 1; index >= 0; index-- {
+		if err := c.closers[index](); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	c.closers = nil
+
+	if c.shared {
+		return errors.Join(errs...)
+	}
+
+	if closer, ok := c.HTTP.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (c *Client) notifyDeprecation(response *http.Response) {
+
+	if c.deprecationLogger == nil || response == nil {
+		return
+	}
+
+	deprecation := model.NewDeprecationScheme(response.Header)
+	if deprecation == nil {
+		return
+	}
+
+	var endpoint string
+	if response.Request != nil && response.Request.URL != nil {
+		endpoint = response.Request.URL.String()
+	}
+
+	for _, warning := range deprecation.Warnings {
+		c.deprecationLogger(endpoint, warning)
+	}
+
+	if deprecation.Deprecation != "" {
+		c.deprecationLogger(endpoint, "deprecated since: "+deprecation.Deprecation)
+	}
+
+	if deprecation.Sunset != "" {
+		c.deprecationLogger(endpoint, "sunset scheduled on: "+deprecation.Sunset)
+	}
+}
@@ -0,0 +1,123 @@
+package transport
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func TestClient_Do(t *testing.T) {
+
+	request := &http.Request{
+		Method: http.MethodGet,
+		URL:    &url.URL{Path: "rest/api/3/search"},
+	}
+
+	deprecatedResponse := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader("{}")),
+		Header: http.Header{
+			"Warning": []string{`299 - "The requested API has been removed"`},
+			"Sunset":  []string{"Sat, 01 Nov 2025 00:00:00 GMT"},
+		},
+		Request: request,
+	}
+
+	expectedResponse := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader("{}")),
+		Header:     http.Header{},
+		Request:    request,
+	}
+
+	testCases := []struct {
+		name         string
+		response     *http.Response
+		err          error
+		wantWarnings []string
+		wantErr      bool
+	}{
+		{
+			name:     "when the response contains deprecation headers",
+			response: deprecatedResponse,
+			wantWarnings: []string{
+				"The requested API has been removed",
+				"sunset scheduled on: Sat, 01 Nov 2025 00:00:00 GMT",
+			},
+		},
+
+		{
+			name:     "when the response does not contain deprecation headers",
+			response: expectedResponse,
+		},
+
+		{
+			name:    "when the http call cannot be executed",
+			err:     errors.New("error, unable to execute the http call"),
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+
+			httpClient := mocks.NewHTTPClient(t)
+			httpClient.On("Do", request).Return(testCase.response, testCase.err)
+
+			var gotWarnings []string
+			client := New(httpClient, WithDeprecationLogger(func(endpoint, warning string) {
+				assert.Equal(t, "rest/api/3/search", endpoint)
+				gotWarnings = append(gotWarnings, warning)
+			}))
+
+			gotResponse, err := client.Do(request)
+
+			if testCase.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, gotWarnings)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.response, gotResponse)
+			assert.Equal(t, testCase.wantWarnings, gotWarnings)
+		})
+	}
+}
+
+type closableHTTPClient struct {
+	*mocks.HTTPClient
+	err    error
+	closed bool
+}
+
+func (c *closableHTTPClient) Close() error {
+	c.closed = true
+	return c.err
+}
+
+func TestClient_Close(t *testing.T) {
+
+	var order []string
+	client := New(nil)
+	client.closers = append(client.closers,
+		func() error { order = append(order, "first"); return nil },
+		func() error { order = append(order, "second"); return errors.New("unable to stop the worker") },
+	)
+
+	err := client.Close()
+	assert.EqualError(t, err, "unable to stop the worker")
+	assert.Equal(t, []string{"second", "first"}, order)
+	assert.NoError(t, client.Close())
+
+	httpClient := &closableHTTPClient{HTTPClient: mocks.NewHTTPClient(t), err: errors.New("unable to close the client")}
+	assert.EqualError(t, New(httpClient).Close(), "unable to close the client")
+	assert.True(t, httpClient.closed)
+}
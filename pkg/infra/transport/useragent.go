@@ -0,0 +1,25 @@
+package transport
+
+// DefaultUserAgent identifies the library on the User-Agent header, it's sent when no user agent is configured.
+const DefaultUserAgent = "go-atlassian/v2"
+
+// UserAgent returns the User-Agent header identifying the application followed by the library,
+// e.g. "myapp/1.2 (+https://example.com) go-atlassian/v2". The library alone is returned if no application is provided.
+func UserAgent(application string) string {
+
+	if application == "" {
+		return DefaultUserAgent
+	}
+
+	return application + " " + DefaultUserAgent
+}
+
+// WithUserAgent sends the User-Agent header identifying the application on every request, so Atlassian can
+// attribute the traffic, e.g. on the rate limiting analysis:
+//
+//	httpClient := transport.New(http.DefaultClient, transport.WithUserAgent("myapp/1.2 (+https://example.com)"))
+//
+// It replaces the user agent set with the client authentication service.
+func WithUserAgent(application string) Option {
+	return WithHeader("User-Agent", UserAgent(application))
+}
@@ -0,0 +1,41 @@
+package transport
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/ctreminiom/go-atlassian/v2/service/mocks"
+)
+
+func TestUserAgent(t *testing.T) {
+
+	assert.Equal(t, "go-atlassian/v2", UserAgent(""))
+	assert.Equal(t, "myapp/1.2 (+https://example.com) go-atlassian/v2", UserAgent("myapp/1.2 (+https://example.com)"))
+}
+
+func TestWithUserAgent(t *testing.T) {
+
+	httpClient := mocks.NewHTTPClient(t)
+	httpClient.On("Do", mock.Anything).
+		Return(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}"))}, nil)
+
+	client := New(httpClient, WithUserAgent("myapp/1.2"))
+
+	request := &http.Request{
+		Method: http.MethodGet,
+		URL:    &url.URL{Path: "rest/api/3/myself"},
+		Header: http.Header{"User-Agent": []string{DefaultUserAgent}},
+	}
+
+	_, err := client.Do(request)
+	assert.NoError(t, err)
+
+	sent := httpClient.Calls[0].Arguments.Get(0).(*http.Request)
+	assert.Equal(t, "myapp/1.2 go-atlassian/v2", sent.Header.Get("User-Agent"))
+}
@@ -94,4 +94,13 @@ type SprintConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-agile/sprints#move-issues-to-sprint
 	Move(ctx context.Context, sprintID int, payload *models.SprintMovePayloadScheme) (*models.ResponseScheme, error)
+
+	// Report returns the sprint report of a board, with the completed, not completed and removed issues and their estimate sums.
+	//
+	// The report is provided by the private greenhopper API backing the sprint report chart, it may change without notice.
+	//
+	// GET /rest/greenhopper/1.0/rapid/charts/sprintreport
+	//
+	// https://docs.go-atlassian.io/jira-agile/sprints#get-sprint-report
+	Report(ctx context.Context, boardID, sprintID int) (*models.SprintReportScheme, *models.ResponseScheme, error)
 }
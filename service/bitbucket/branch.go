@@ -0,0 +1,67 @@
+package bitbucket
+
+import (
+	"context"
+
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// BranchConnector represents the Bitbucket Cloud repository branches.
+//
+// Use it to create, retrieve and delete the branches of a repository.
+type BranchConnector interface {
+
+	// Create creates a new branch in the specified repository.
+	//
+	// The target hash is the commit the branch points to.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/refs/branches
+	//
+	// https://docs.go-atlassian.io/bitbucket-cloud/repository/branches#create-a-branch
+	Create(ctx context.Context, workspace, repoSlug string, payload *models.BranchCreatePayloadScheme) (*models.BranchReferenceScheme, *models.ResponseScheme, error)
+
+	// Get returns a branch object within the specified repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/refs/branches/{name}
+	//
+	// https://docs.go-atlassian.io/bitbucket-cloud/repository/branches#get-a-branch
+	Get(ctx context.Context, workspace, repoSlug, name string) (*models.BranchReferenceScheme, *models.ResponseScheme, error)
+
+	// Delete deletes a branch in the specified repository.
+	//
+	// The main branch is not allowed to be deleted.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/refs/branches/{name}
+	//
+	// https://docs.go-atlassian.io/bitbucket-cloud/repository/branches#delete-a-branch
+	Delete(ctx context.Context, workspace, repoSlug, name string) (*models.ResponseScheme, error)
+}
+
+// BranchRestrictionConnector represents the Bitbucket Cloud repository branch restrictions.
+//
+// Use it to enforce the branch policies of a repository, such as restricting the pushes or requiring approvals to merge.
+type BranchRestrictionConnector interface {
+
+	// Gets returns a paginated list of all branch restrictions on the repository.
+	//
+	// GET /2.0/repositories/{workspace}/{repo_slug}/branch-restrictions
+	//
+	// https://docs.go-atlassian.io/bitbucket-cloud/repository/branch-restrictions#list-branch-restrictions
+	Gets(ctx context.Context, workspace, repoSlug string, options *models.BranchRestrictionOptionsScheme) (*models.BranchRestrictionPageScheme, *models.ResponseScheme, error)
+
+	// Create creates a new branch restriction rule for a repository.
+	//
+	// The ID of the created restriction is returned on the restriction.
+	//
+	// POST /2.0/repositories/{workspace}/{repo_slug}/branch-restrictions
+	//
+	// https://docs.go-atlassian.io/bitbucket-cloud/repository/branch-restrictions#create-a-branch-restriction-rule
+	Create(ctx context.Context, workspace, repoSlug string, payload *models.BranchRestrictionScheme) (*models.BranchRestrictionScheme, *models.ResponseScheme, error)
+
+	// Delete deletes an existing branch restriction rule.
+	//
+	// DELETE /2.0/repositories/{workspace}/{repo_slug}/branch-restrictions/{id}
+	//
+	// https://docs.go-atlassian.io/bitbucket-cloud/repository/branch-restrictions#delete-a-branch-restriction-rule
+	Delete(ctx context.Context, workspace, repoSlug string, restrictionID int) (*models.ResponseScheme, error)
+}
@@ -1,5 +1,10 @@
 package common
 
+import (
+	"context"
+	"net/http"
+)
+
 type Authentication interface {
 	SetBasicAuth(mail, token string)
 	GetBasicAuth() (string, string)
@@ -14,4 +19,15 @@ type Authentication interface {
 
 	SetBearerToken(token string)
 	GetBearerToken() string
+
+	SetAuthProvider(provider AuthProvider)
+	GetAuthProvider() AuthProvider
+}
+
+// AuthProvider sets the credentials on the requests, it's called by the NewRequest method of the clients.
+//
+// It's applied after the static credentials, so a provider fetching fresh credentials, e.g. from a secret manager,
+// replaces them without rebuilding the client.
+type AuthProvider interface {
+	Apply(ctx context.Context, request *http.Request) error
 }
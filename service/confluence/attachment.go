@@ -38,6 +38,20 @@ type ContentAttachmentConnector interface {
 	//
 	// https://docs.go-atlassian.io/confluence-cloud/content/attachments#create-attachment
 	Create(ctx context.Context, attachmentID, status, fileName string, file io.Reader) (*model.ContentPageScheme, *model.ResponseScheme, error)
+
+	// Upsert uploads an attachment to a piece of content, matching the existing attachments by the file name.
+	//
+	// A new version of the attachment is added if the content already has an attachment with exactly the file name,
+	// otherwise the attachment is created. The result reports which of both happened.
+	//
+	// GET /wiki/rest/api/content/{id}/child/attachment
+	//
+	// POST /wiki/rest/api/content/{id}/child/attachment
+	//
+	// POST /wiki/rest/api/content/{id}/child/attachment/{attachmentID}/data
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/attachments#upsert-attachment
+	Upsert(ctx context.Context, contentID, fileName string, file io.Reader) (*model.ContentAttachmentUpsertScheme, *model.ResponseScheme, error)
 }
 
 type AttachmentConnector interface {
@@ -66,6 +80,27 @@ type AttachmentConnector interface {
 	// https://docs.go-atlassian.io/confluence-cloud/v2/attachments#get-attachments-by-type
 	Gets(ctx context.Context, entityID int, entityType string, options *model.AttachmentParamsScheme, cursor string, limit int) (*model.AttachmentPageScheme, *model.ResponseScheme, error)
 
+	// GetsByPage returns the attachments of a specific page.
+	//
+	// The number of results is limited by the limit parameter and additional results.
+	//
+	// (if available) will be available through the next URL present in the Link response header.
+	//
+	// GET /wiki/api/v2/pages/{id}/attachments
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/attachments#get-attachments-for-page
+	GetsByPage(ctx context.Context, pageID string, cursor string, limit int) (*model.AttachmentPageScheme, *model.ResponseScheme, error)
+
+	// Download returns the contents of an attachment, the caller must close the returned reader.
+	//
+	// The attachment download link is resolved first, the file contents are streamed when the client implements
+	// service.BodyConnector, otherwise they're buffered in memory.
+	//
+	// GET /wiki/download/attachments/{pageID}/{fileName}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/attachments#download-attachment
+	Download(ctx context.Context, attachmentID string) (io.ReadCloser, *model.ResponseScheme, error)
+
 	// Delete deletes an attachment by id.
 	//
 	// DELETE /wiki/api/v2/attachments/{id}
@@ -0,0 +1,54 @@
+package confluence
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// BlogPostConnector represents the Confluence Cloud Blog Posts.
+// Use it to search, get, create, delete, and change blog posts.
+type BlogPostConnector interface {
+
+	// Gets returns all blog posts that fit the filtering criteria.
+	//
+	// The number of results is limited by the limit parameter and additional results
+	//
+	// (if available) will be available through the next cursor
+	//
+	// GET /wiki/api/v2/blogposts
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/blog-post#get-blog-posts
+	Gets(ctx context.Context, options *models.BlogPostOptionsScheme, cursor string, limit int) (*models.BlogPostChunkScheme, *models.ResponseScheme, error)
+
+	// Get returns a specific blog post.
+	//
+	// GET /wiki/api/v2/blogposts/{id}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/blog-post#get-blog-post-by-id
+	Get(ctx context.Context, blogPostID int, format string, draft bool, version int) (*models.BlogPostScheme, *models.ResponseScheme, error)
+
+	// Create creates a blog post in the space.
+	//
+	// Blog posts are created as published by default unless specified as a draft in the status field.
+	//
+	// If creating a published blog post, the title must be specified.
+	//
+	// POST /wiki/api/v2/blogposts
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/blog-post#create-blog-post
+	Create(ctx context.Context, payload *models.BlogPostCreatePayloadScheme) (*models.BlogPostScheme, *models.ResponseScheme, error)
+
+	// Update updates a blog post by id.
+	//
+	// PUT /wiki/api/v2/blogposts/{id}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/blog-post#update-blog-post
+	Update(ctx context.Context, blogPostID int, payload *models.BlogPostUpdatePayloadScheme) (*models.BlogPostScheme, *models.ResponseScheme, error)
+
+	// Delete deletes a blog post by id.
+	//
+	// DELETE /wiki/api/v2/blogposts/{id}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/blog-post#delete-blog-post
+	Delete(ctx context.Context, blogPostID int) (*models.ResponseScheme, error)
+}
@@ -0,0 +1,27 @@
+package confluence
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// ContentStateConnector the interface for the content state methods of the Confluence Service.
+type ContentStateConnector interface {
+
+	// Get returns the content state of the current version of a piece of content.
+	//
+	// GET /wiki/rest/api/content/{id}/state
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/states#get-content-state
+	Get(ctx context.Context, contentID string) (*model.ContentStateResponseScheme, *model.ResponseScheme, error)
+
+	// Set applies a content state to a piece of content, a new version of the content is published with the state.
+	//
+	// The state ID must be one of the states available on the space of the content, see Space.ContentStates.
+	//
+	// PUT /wiki/rest/api/content/{id}/state
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/states#set-content-state
+	Set(ctx context.Context, contentID string, stateID int) (*model.ContentStateResponseScheme, *model.ResponseScheme, error)
+}
@@ -38,6 +38,8 @@ type ContentConnector interface {
 	//
 	// By default, the following objects are expanded: space, history, version.
 	//
+	// The expansions are validated against model.ContentExpands, wrap the unlisted ones with model.RawExpand.
+	//
 	// GET /wiki/rest/api/content/{id}
 	//
 	// https://docs.go-atlassian.io/confluence-cloud/content#get-content
@@ -86,4 +88,115 @@ type ContentConnector interface {
 	//
 	// https://docs.go-atlassian.io/confluence-cloud/content#archive-pages
 	Archive(ctx context.Context, payload *model.ContentArchivePayloadScheme) (*model.ContentArchiveResultScheme, *model.ResponseScheme, error)
+
+	// HasAnonymousAccess returns whether anonymous users can view a piece of content.
+	//
+	// The content is not visible when itself or any of its ancestors has read restrictions,
+	// otherwise the access is inherited from the anonymous permissions of the space.
+	//
+	// GET /wiki/rest/api/content/{id}?expand=space,ancestors
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content#get-content-anonymous-access
+	HasAnonymousAccess(ctx context.Context, contentID string) (*model.AnonymousAccessScheme, *model.ResponseScheme, error)
+
+	// SearchByProperty returns all the content where the content property matches the given value.
+	//
+	// The CQL content.property[propertyKey] = "propertyValue" is built and the search pages are walked using the cursor,
+	// if the spaceKey is provided, the search is restricted to that space.
+	//
+	// GET /wiki/rest/api/content/search
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content#search-contents-by-property
+	SearchByProperty(ctx context.Context, spaceKey, propertyKey, propertyValue string) ([]*model.ContentScheme, *model.ResponseScheme, error)
+
+	// SetParent moves a piece of content under a new parent page.
+	//
+	// The current version of the content is fetched and a minimal update with the new ancestor and the next version number is sent.
+	// The parent must belong to the same space, otherwise model.ErrParentSpaceMismatch is returned.
+	//
+	// PUT /wiki/rest/api/content/{id}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content#set-content-parent
+	SetParent(ctx context.Context, contentID, newParentID string) (*model.ContentScheme, *model.ResponseScheme, error)
+
+	// GetMany returns the contents with the provided IDs, such as pages or blog posts.
+	//
+	// The contents are fetched in batches using the CQL id in (...) query and the search pages are walked using the cursor,
+	// the batches are executed one after another to avoid hitting the rate limits.
+	//
+	// The contents are returned in the same order as the IDs, the IDs not found or failed are nil
+	// and their errors are returned on the result keyed by the ID.
+	//
+	// GET /wiki/rest/api/content/search
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content#get-many-contents
+	GetMany(ctx context.Context, ids []string, expand []string) (*model.ContentBulkResultScheme, *model.ResponseScheme, error)
+
+	// GetBySpace returns a page of the contents of a type in a space, such as the pages or the blog posts.
+	//
+	// The content type must be page or blogpost, and the status current, trashed, draft, historical or any.
+	// An empty status returns the current contents.
+	//
+	// GET /wiki/rest/api/content
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content#get-content-by-space
+	GetBySpace(ctx context.Context, spaceKey, contentType, status string, expand []string, startAt, maxResults int) (*model.ContentPageScheme, *model.ResponseScheme, error)
+
+	// FindReplace replaces a text on the storage body of every page of a space, creating a new version of every page changed.
+	//
+	// The pages are searched using the CQL text match, and the pages not containing the text on the storage body are skipped.
+	// The text is only replaced on the text nodes of the storage format, the markup and the macro parameters are untouched,
+	// see storage.ReplaceText. Both texts are provided as displayed, e.g. R&D, they're escaped on the storage body.
+	//
+	// The dry run returns the replacements planned without updating the pages.
+	// The pages failed don't stop the replacement, their errors are returned on the result keyed by the content ID.
+	//
+	// GET /wiki/rest/api/content/search
+	//
+	// PUT /wiki/rest/api/content/{id}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content#find-and-replace
+	FindReplace(ctx context.Context, spaceKey, find, replace string, dryRun bool) (*model.ContentFindReplaceResultScheme, *model.ResponseScheme, error)
+
+	// GetByTitle returns the content of the type, page or blogpost, with the title in the space.
+	//
+	// It returns model.ErrContentNotFound if no content has the title. If several contents share the title,
+	// the first match is returned along with a *model.ContentDuplicateTitleError carrying every match.
+	//
+	// GET /wiki/rest/api/content
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content#get-content-by-title
+	GetByTitle(ctx context.Context, spaceKey, title, contentType string, expand []string) (*model.ContentScheme, *model.ResponseScheme, error)
+
+	// Purge permanently deletes a trashed content, the content must be trashed before it can be purged.
+	//
+	// It returns model.ErrContentNotTrashed if the content is not in the trash.
+	//
+	// GET /wiki/rest/api/content/{id}
+	//
+	// DELETE /wiki/rest/api/content/{id}?status=trashed
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content#purge-content
+	Purge(ctx context.Context, contentID string) (*model.ResponseScheme, error)
+
+	// PurgeSpace permanently deletes the trashed contents of a type, page or blogpost, in a space.
+	//
+	// The trashed contents are listed before being purged one after another, the contents failed don't stop the purge,
+	// their errors are returned on the result keyed by the content ID.
+	//
+	// GET /wiki/rest/api/content
+	//
+	// DELETE /wiki/rest/api/content/{id}?status=trashed
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content#purge-space-content
+	PurgeSpace(ctx context.Context, spaceKey, contentType string) (*model.ContentPurgeResultScheme, *model.ResponseScheme, error)
+
+	// Tasks returns the inline tasks of the current version of a piece of content, in document order.
+	//
+	// The storage body of the content is fetched and parsed with storage.Tasks, use Completed to filter the open tasks.
+	//
+	// GET /wiki/rest/api/content/{id}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content#get-content-tasks
+	Tasks(ctx context.Context, contentID string) ([]model.InlineTaskScheme, *model.ResponseScheme, error)
 }
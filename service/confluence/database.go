@@ -0,0 +1,60 @@
+package confluence
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// DatabaseConnector represents the Confluence Cloud Databases.
+// Use it to create, get, delete and organize the databases in the content tree.
+type DatabaseConnector interface {
+
+	// Create creates a database in the space.
+	//
+	// The database is created at the space root unless the parent ID is set.
+	//
+	// POST /wiki/api/v2/databases
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/database#create-database
+	Create(ctx context.Context, payload *models.DatabaseCreatePayloadScheme) (*models.DatabaseScheme, *models.ResponseScheme, error)
+
+	// Get returns a specific database.
+	//
+	// GET /wiki/api/v2/databases/{id}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/database#get-database-by-id
+	Get(ctx context.Context, databaseID int) (*models.DatabaseScheme, *models.ResponseScheme, error)
+
+	// Delete deletes a database, the database is moved to the trash.
+	//
+	// DELETE /wiki/api/v2/databases/{id}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/database#delete-database
+	Delete(ctx context.Context, databaseID int) (*models.ResponseScheme, error)
+
+	// Ancestors returns the ancestors of the database in the content tree, from the top level to the parent.
+	//
+	// GET /wiki/api/v2/databases/{id}/ancestors
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/database#get-database-ancestors
+	Ancestors(ctx context.Context, databaseID, limit int) (*models.ContentAncestorsScheme, *models.ResponseScheme, error)
+
+	// Children returns the direct children of the database in the content tree.
+	//
+	// The results are paginated with a cursor, the cursor of the next chunk is available on the next link of the chunk.
+	//
+	// GET /wiki/api/v2/databases/{id}/direct-children
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/database#get-database-direct-children
+	Children(ctx context.Context, databaseID int, cursor string, limit int) (*models.ContentChildrenChunkScheme, *models.ResponseScheme, error)
+
+	// Move moves the database relative to the target content in the content tree.
+	//
+	// The position is one of before, after or append, the REST v2 API doesn't provide a move endpoint,
+	// so the REST v1 content move endpoint is used.
+	//
+	// PUT /wiki/rest/api/content/{id}/move/{position}/{targetID}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/database#move-database
+	Move(ctx context.Context, databaseID int, position string, targetID int) (*models.ContentMoveScheme, *models.ResponseScheme, error)
+}
@@ -0,0 +1,27 @@
+package confluence
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// GroupConnector the interface for the group methods of the Confluence Service.
+type GroupConnector interface {
+
+	// Members returns a page of the users that are members of a group.
+	//
+	// GET /wiki/rest/api/group/member
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/groups#get-group-members
+	Members(ctx context.Context, groupName string, start, limit int) (*model.ContentGroupMemberPageScheme, *model.ResponseScheme, error)
+
+	// HasMembers checks which of the accounts are members of a group, the result is keyed by the account ID.
+	//
+	// Every page of the group members is fetched before answering, so a single walk of the group serves any number of accounts.
+	//
+	// GET /wiki/rest/api/group/member
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/groups#check-group-members
+	HasMembers(ctx context.Context, groupName string, accountIDs []string) (map[string]bool, *model.ResponseScheme, error)
+}
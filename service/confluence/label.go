@@ -37,4 +37,16 @@ type LabelsConnector interface {
 	//
 	// https://docs.go-atlassian.io/confluence-cloud/content/labels#remove-label-from-content
 	Remove(ctx context.Context, contentID, labelName string) (*model.ResponseScheme, error)
+
+	// RemoveByPrefix removes the labels whose name starts with the prefix from a piece of content, e.g. every status- label.
+	//
+	// The labels of the content are listed and the matching ones are removed with a bounded concurrency.
+	// The failed labels don't stop the operation, their errors are returned on the result keyed by the label name.
+	//
+	// GET /wiki/rest/api/content/{id}/label
+	//
+	// DELETE /wiki/rest/api/content/{id}/label/{label}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/labels#remove-labels-by-prefix
+	RemoveByPrefix(ctx context.Context, contentID, prefix string) (*model.ContentLabelRemovalScheme, *model.ResponseScheme, error)
 }
@@ -0,0 +1,34 @@
+package confluence
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// ReactionConnector the interface for the reaction methods of the Confluence Service.
+type ReactionConnector interface {
+
+	// Gets returns the reactions of a piece of content, grouped by emoji.
+	//
+	// GET /wiki/rest/reactions/1.0/content/{contentID}/reactions
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/reactions#get-reactions
+	Gets(ctx context.Context, contentID string) (*model.ContentReactionSummaryScheme, *model.ResponseScheme, error)
+
+	// Add reacts to a piece of content with the emoji, on behalf of the current user.
+	//
+	// The emoji is the emoji ID, e.g. 1f44d.
+	//
+	// PUT /wiki/rest/reactions/1.0/content/{contentID}/reactions/{emoji}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/reactions#add-reaction
+	Add(ctx context.Context, contentID, emoji string) (*model.ResponseScheme, error)
+
+	// Remove removes the reaction of the current user to a piece of content.
+	//
+	// DELETE /wiki/rest/reactions/1.0/content/{contentID}/reactions/{emoji}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/reactions#remove-reaction
+	Remove(ctx context.Context, contentID, emoji string) (*model.ResponseScheme, error)
+}
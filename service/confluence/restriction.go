@@ -35,6 +35,17 @@ type ContentRestrictionConnector interface {
 	//
 	// https://docs.go-atlassian.io/confluence-cloud/content/restrictions#update-restrictions
 	Update(ctx context.Context, contentID string, payload *model.ContentRestrictionUpdatePayloadScheme, expand []string) (*model.ContentRestrictionPageScheme, *model.ResponseScheme, error)
+
+	// Effective returns the restrictions applied to a piece of content, including the ones inherited from its ancestors.
+	//
+	// The read restrictions are inherited from every ancestor and a user must satisfy all of them,
+	// the update restrictions only apply to the content itself. Each restriction includes the content defining it, and
+	// ReadPrincipals and UpdatePrincipals hold the users and the groups allowed by all of them.
+	//
+	// GET /wiki/rest/api/content/{id}/restriction/byOperation/{operationKey}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/restrictions#get-effective-restrictions
+	Effective(ctx context.Context, contentID string) (*model.ContentEffectiveRestrictionScheme, *model.ResponseScheme, error)
 }
 
 type RestrictionOperationConnector interface {
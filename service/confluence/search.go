@@ -24,4 +24,18 @@ type SearchConnector interface {
 	//
 	// https://docs.go-atlassian.io/confluence-cloud/search#search-users
 	Users(ctx context.Context, cql string, start, limit int, expand []string) (*model.SearchPageScheme, *model.ResponseScheme, error)
+
+	// CQLStream searches the contents matching the CQL query and calls fn for every content, fetched with the expand provided.
+	//
+	// The search pages are walked using the cursor and the contents are fetched by a pool of concurrency workers,
+	// 4 when the concurrency is 0 or less, so only the contents being fetched are kept in memory. fn is called from
+	// the calling goroutine as the contents arrive, not in the search order. The first error returned by the search,
+	// a fetch or fn stops the stream and it's returned.
+	//
+	// GET /wiki/rest/api/search
+	//
+	// GET /wiki/rest/api/content/{id}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/search#stream-content
+	CQLStream(ctx context.Context, cql string, expand []string, concurrency int, fn func(content *model.ContentScheme) error) error
 }
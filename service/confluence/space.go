@@ -75,7 +75,7 @@ type SpaceConnector interface {
 	//
 	// Therefore, the space may not be deleted yet when this method has returned.
 	//
-	// Clients should poll the status link that is returned to the response until the task completes.
+	// Clients should poll the status link that is returned to the response until the task completes, e.g. with LongTask.Await.
 	//
 	// DELETE /wiki/rest/api/space/{spaceKey}
 	//
@@ -99,4 +99,68 @@ type SpaceConnector interface {
 	//
 	// https://docs.go-atlassian.io/confluence-cloud/space#get-content-by-type-for-space
 	ContentByType(ctx context.Context, spaceKey, contentType, depth string, expand []string, startAt, maxResults int) (*model.ContentPageScheme, *model.ResponseScheme, error)
+
+	// GetLabels returns the labels of a space.
+	//
+	// The labels can be filtered by prefix, valid values: global, my, team.
+	//
+	// GET /wiki/rest/api/space/{spaceKey}/label
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/space#get-space-labels
+	GetLabels(ctx context.Context, spaceKey, prefix string, startAt, maxResults int) (*model.ContentLabelPageScheme, *model.ResponseScheme, error)
+
+	// AddLabels adds labels to a space.
+	//
+	// Labels without a prefix are added using the global prefix.
+	//
+	// POST /wiki/rest/api/space/{spaceKey}/label
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/space#add-labels-to-space
+	AddLabels(ctx context.Context, spaceKey string, payload []*model.ContentLabelPayloadScheme) (*model.ContentLabelPageScheme, *model.ResponseScheme, error)
+
+	// RemoveLabel removes a label from a space.
+	//
+	// The prefix is optional, labels are removed from the global prefix by default.
+	//
+	// DELETE /wiki/rest/api/space/{spaceKey}/label
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/space#remove-label-from-space
+	RemoveLabel(ctx context.Context, spaceKey, labelName, prefix string) (*model.ResponseScheme, error)
+
+	// AnonymousAccess returns whether anonymous users can view a space.
+	//
+	// The access is granted when the space permissions include the read operation for anonymous users.
+	//
+	// GET /wiki/rest/api/space/{spaceKey}?expand=permissions
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/space#get-space-anonymous-access
+	AnonymousAccess(ctx context.Context, spaceKey string) (*model.AnonymousAccessScheme, *model.ResponseScheme, error)
+
+	// Exists checks if a space key is taken, use it to verify a key is available before creating a space.
+	//
+	// The space not found is reported as false instead of an error, any other error is returned.
+	//
+	// GET /wiki/rest/api/space/{spaceKey}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/space#check-space-exists
+	Exists(ctx context.Context, spaceKey string) (bool, *model.ResponseScheme, error)
+
+	// ContentStates returns the content states available on a space, they can be applied to the contents of the space.
+	//
+	// GET /wiki/rest/api/space/{spaceKey}/state
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/space#get-space-content-states
+	ContentStates(ctx context.Context, spaceKey string) ([]*model.ContentStateScheme, *model.ResponseScheme, error)
+
+	// ApplyPermissionTemplate grants every operation of the template to every principal of the template on a space.
+	//
+	// The permissions already granted by the space are skipped, so the template can be applied many times. The first
+	// permission failed stops the operation, the permissions added before it are kept.
+	//
+	// GET /wiki/rest/api/space/{spaceKey}?expand=permissions
+	//
+	// POST /wiki/rest/api/space/{spaceKey}/permission
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/space#apply-space-permission-template
+	ApplyPermissionTemplate(ctx context.Context, spaceKey string, template *model.SpacePermissionTemplate) (*model.SpacePermissionTemplateResultScheme, *model.ResponseScheme, error)
 }
@@ -28,4 +28,49 @@ type TemplateConnector interface {
 	//
 	// https://docs.go-atlassian.io/confluence-cloud/template#get-content-template
 	Get(ctx context.Context, templateID string) (*models.ContentTemplateScheme, *models.ResponseScheme, error)
+
+	// Render returns the template storage body with the @@VARIABLE@@ placeholders replaced by the variables values.
+	//
+	// The values are escaped, so they're rendered as text and can't inject markup on the storage body.
+	//
+	// The rendered content can be used as the body of a new page, the placeholders without a variable are kept.
+	//
+	// GET /wiki/rest/api/template/{id}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/template#render-content-template
+	Render(ctx context.Context, templateID string, variables map[string]string) (*models.ContentScheme, *models.ResponseScheme, error)
+
+	// CreateFromTemplate creates a content using the rendered body of a template.
+	//
+	// The payload provides the type, title, space and ancestors of the new content, its body is overridden by the rendered template.
+	//
+	// POST /wiki/rest/api/content
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/template#create-content-from-template
+	CreateFromTemplate(ctx context.Context, templateID string, variables map[string]string, payload *models.ContentScheme) (*models.ContentScheme, *models.ResponseScheme, error)
+
+	// Gets returns the content templates of a space, or the global content templates if no space key is provided.
+	//
+	// GET /wiki/rest/api/template/page
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/template#get-content-templates
+	Gets(ctx context.Context, spaceKey string, start, limit int) (*models.ContentTemplatePageScheme, *models.ResponseScheme, error)
+
+	// Blueprints returns the blueprint templates of a space, or the global blueprint templates if no space key is provided.
+	//
+	// The blueprint of every template is referenced by its OriginalTemplate plugin and module keys.
+	//
+	// GET /wiki/rest/api/template/blueprint
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/template#get-blueprint-templates
+	Blueprints(ctx context.Context, spaceKey string, start, limit int) (*models.ContentTemplatePageScheme, *models.ResponseScheme, error)
+
+	// CreateFromBlueprint publishes the draft created from a blueprint, e.g. by the blueprint wizard.
+	//
+	// The draft ID is the ID of the content created from the blueprint, the payload sets its title, space and parent.
+	//
+	// POST /wiki/rest/api/content/blueprint/instance/{draftID}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/template#publish-blueprint-draft
+	CreateFromBlueprint(ctx context.Context, draftID string, payload *models.ContentBlueprintDraftScheme) (*models.ContentScheme, *models.ResponseScheme, error)
 }
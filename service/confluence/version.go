@@ -32,10 +32,23 @@ type VersionConnector interface {
 	//
 	// This does not delete the changes made to the content in that version, rather the changes for the deleted version
 	//
-	// are rolled up into the next version. Note, you cannot delete the current version.
+	// are rolled up into the next version.
+	//
+	// The current version cannot be deleted, model.ErrCurrentContentVersion is returned without deleting anything.
+	//
+	// GET /wiki/rest/api/content/{id}/version
 	//
 	// DELETE /wiki/rest/api/content/{id}/version/{versionNumber}
 	//
 	// https://docs.go-atlassian.io/confluence-cloud/content/versions#delete-content-version
 	Delete(ctx context.Context, contentID string, versionNumber int) (*model.ResponseScheme, error)
+
+	// Diff compares the storage bodies of two versions of a piece of content.
+	//
+	// The differences are returned as an ordered list of equal, added and removed word segments.
+	//
+	// GET /wiki/rest/api/content/{id}?version={versionNumber}&expand=body.storage
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/versions#diff-content-versions
+	Diff(ctx context.Context, contentID string, fromVersion, toVersion int) (*model.ContentDiffScheme, *model.ResponseScheme, error)
 }
@@ -0,0 +1,42 @@
+package confluence
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// ContentWatchConnector the interface for the content watcher methods of the Confluence Service.
+type ContentWatchConnector interface {
+
+	// Get returns whether a user is watching a piece of content.
+	//
+	// GET /wiki/rest/api/user/watch/content/{contentID}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/watchers#get-content-watch-status
+	Get(ctx context.Context, contentID, accountID string) (*model.ContentWatchStatusScheme, *model.ResponseScheme, error)
+
+	// Add adds a user as a watcher to a piece of content.
+	//
+	// POST /wiki/rest/api/user/watch/content/{contentID}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/watchers#add-content-watcher
+	Add(ctx context.Context, contentID, accountID string) (*model.ResponseScheme, error)
+
+	// AddBulk adds many users as watchers to a piece of content.
+	//
+	// The watchers are added concurrently, the errors are reported per account and the remaining accounts
+	// are still added.
+	//
+	// POST /wiki/rest/api/user/watch/content/{contentID}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/watchers#add-content-watchers
+	AddBulk(ctx context.Context, contentID string, accountIDs []string) (*model.ContentWatchBulkResultScheme, error)
+
+	// Remove removes a user as a watcher from a piece of content.
+	//
+	// DELETE /wiki/rest/api/user/watch/content/{contentID}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/content/watchers#remove-content-watcher
+	Remove(ctx context.Context, contentID, accountID string) (*model.ResponseScheme, error)
+}
@@ -0,0 +1,60 @@
+package confluence
+
+import (
+	"context"
+	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// WhiteboardConnector represents the Confluence Cloud Whiteboards.
+// Use it to create, get, delete and organize the whiteboards in the content tree.
+type WhiteboardConnector interface {
+
+	// Create creates a whiteboard in the space.
+	//
+	// The whiteboard is created at the space root unless the parent ID is set.
+	//
+	// POST /wiki/api/v2/whiteboards
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/whiteboard#create-whiteboard
+	Create(ctx context.Context, payload *models.WhiteboardCreatePayloadScheme) (*models.WhiteboardScheme, *models.ResponseScheme, error)
+
+	// Get returns a specific whiteboard.
+	//
+	// GET /wiki/api/v2/whiteboards/{id}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/whiteboard#get-whiteboard-by-id
+	Get(ctx context.Context, whiteboardID int) (*models.WhiteboardScheme, *models.ResponseScheme, error)
+
+	// Delete deletes a whiteboard, the whiteboard is moved to the trash.
+	//
+	// DELETE /wiki/api/v2/whiteboards/{id}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/whiteboard#delete-whiteboard
+	Delete(ctx context.Context, whiteboardID int) (*models.ResponseScheme, error)
+
+	// Ancestors returns the ancestors of the whiteboard in the content tree, from the top level to the parent.
+	//
+	// GET /wiki/api/v2/whiteboards/{id}/ancestors
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/whiteboard#get-whiteboard-ancestors
+	Ancestors(ctx context.Context, whiteboardID, limit int) (*models.ContentAncestorsScheme, *models.ResponseScheme, error)
+
+	// Children returns the direct children of the whiteboard in the content tree.
+	//
+	// The results are paginated with a cursor, the cursor of the next chunk is available on the next link of the chunk.
+	//
+	// GET /wiki/api/v2/whiteboards/{id}/direct-children
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/whiteboard#get-whiteboard-direct-children
+	Children(ctx context.Context, whiteboardID int, cursor string, limit int) (*models.ContentChildrenChunkScheme, *models.ResponseScheme, error)
+
+	// Move moves the whiteboard relative to the target content in the content tree.
+	//
+	// The position is one of before, after or append, the REST v2 API doesn't provide a move endpoint,
+	// so the REST v1 content move endpoint is used.
+	//
+	// PUT /wiki/rest/api/content/{id}/move/{position}/{targetID}
+	//
+	// https://docs.go-atlassian.io/confluence-cloud/v2/whiteboard#move-whiteboard
+	Move(ctx context.Context, whiteboardID int, position string, targetID int) (*models.ContentMoveScheme, *models.ResponseScheme, error)
+}
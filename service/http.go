@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
 
 	"github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
@@ -11,3 +13,24 @@ type Connector interface {
 	NewRequest(ctx context.Context, method, urlStr, contentType string, body interface{}) (*http.Request, error)
 	Call(request *http.Request, structure interface{}) (*models.ResponseScheme, error)
 }
+
+// StreamConnector is implemented by the clients able to stream the elements of the JSON array responses, e.g. the Jira clients.
+//
+// The services use it, when the client implements it, to keep a bounded memory while walking the huge result sets.
+type StreamConnector interface {
+	CallStream(request *http.Request, field string, fn func(element json.RawMessage) error) (*models.ResponseScheme, error)
+}
+
+// DeploymentTypeConnector is implemented by the clients able to report the deployment type of the instance, e.g. the
+// Jira clients, which request it once and cache it.
+type DeploymentTypeConnector interface {
+	DeploymentType(ctx context.Context) (string, error)
+}
+
+// BodyConnector is implemented by the clients able to return the body of the successful responses unread, e.g. the
+// Confluence clients.
+//
+// The services use it, when the client implements it, to stream the files downloaded instead of buffering them.
+type BodyConnector interface {
+	CallBody(request *http.Request) (io.ReadCloser, *models.ResponseScheme, error)
+}
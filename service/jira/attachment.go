@@ -56,4 +56,13 @@ type AttachmentConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/attachments#download-attachment
 	Download(ctx context.Context, attachmentID string, redirect bool) (*model.ResponseScheme, error)
+
+	// DeleteBulk deletes many attachments, the attachments are deleted with a bounded concurrency.
+	//
+	// The failed attachments don't stop the operation, their errors are returned on the result keyed by the attachment ID.
+	//
+	// DELETE /rest/api/{2-3}/attachment/{attachmentID}
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/attachments#delete-attachments
+	DeleteBulk(ctx context.Context, attachmentIDs []string) (*model.IssueAttachmentDeleteBulkScheme, error)
 }
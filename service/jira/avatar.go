@@ -0,0 +1,36 @@
+package jira
+
+import (
+	"context"
+	"io"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+// AvatarConnector represents the Jira universal avatars.
+// Use it to get, upload and delete the avatars of the projects, issue types and priorities.
+type AvatarConnector interface {
+
+	// Gets returns the system and custom avatars for a project, issue type or priority.
+	//
+	// GET /rest/api/{2-3}/universal_avatar/type/{type}/owner/{entityId}
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/avatars#get-avatars
+	Gets(ctx context.Context, avatarType, entityID string) (*model.AvatarsScheme, *model.ResponseScheme, error)
+
+	// Upload loads a custom avatar for a project, issue type or priority.
+	//
+	// The avatar is cropped to a square of the size provided, a size 0 uses the image as it is.
+	//
+	// POST /rest/api/{2-3}/universal_avatar/type/{type}/owner/{entityId}
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/avatars#load-avatar
+	Upload(ctx context.Context, avatarType, entityID string, r io.Reader, size int) (*model.AvatarScheme, *model.ResponseScheme, error)
+
+	// Delete deletes an avatar from a project, issue type or priority.
+	//
+	// DELETE /rest/api/{2-3}/universal_avatar/type/{type}/owner/{owningObjectId}/avatar/{id}
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/avatars#delete-avatar
+	Delete(ctx context.Context, avatarType, owningObjectID string, avatarID int) (*model.ResponseScheme, error)
+}
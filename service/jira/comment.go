@@ -29,6 +29,16 @@ type CommentRichTextConnector interface {
 	//
 	//https://docs.go-atlassian.io/jira-software-cloud/issues/comments#add-comment
 	Add(ctx context.Context, issueKeyOrID string, payload *model.CommentPayloadSchemeV2, expand []string) (*model.IssueCommentSchemeV2, *model.ResponseScheme, error)
+
+	// Walk returns the comments of an issue and calls visit for every comment, one page after another.
+	//
+	// The comments are streamed instead of being gathered, so a single page is kept in memory. The first error returned
+	// by visit stops the walk and it's returned.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}/comment
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/comments#walk-comments
+	Walk(ctx context.Context, issueKeyOrID, orderBy string, expand []string, visit func(comment *model.IssueCommentSchemeV2) error) error
 }
 
 type CommentADFConnector interface {
@@ -54,6 +64,25 @@ type CommentADFConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/comments#add-comment
 	Add(ctx context.Context, issueKeyOrID string, payload *model.CommentPayloadScheme, expand []string) (*model.IssueCommentScheme, *model.ResponseScheme, error)
+
+	// AddText adds a plain text comment to an issue, the text is wrapped in an ADF document.
+	//
+	// Every line of the text is mapped to a paragraph, use Add to post a rich ADF comment.
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/comment
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/comments#add-text-comment
+	AddText(ctx context.Context, issueKeyOrID, text string) (*model.IssueCommentScheme, *model.ResponseScheme, error)
+
+	// Walk returns the comments of an issue and calls visit for every comment, one page after another.
+	//
+	// The comments are streamed instead of being gathered, so a single page is kept in memory. The first error returned
+	// by visit stops the walk and it's returned.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}/comment
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/comments#walk-comments
+	Walk(ctx context.Context, issueKeyOrID, orderBy string, expand []string, visit func(comment *model.IssueCommentScheme) error) error
 }
 
 type CommentSharedConnector interface {
@@ -51,5 +51,12 @@ type GroupConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/groups#remove-user-from-group
 	Remove(ctx context.Context, groupName, accountID string) (*model.ResponseScheme, error)
 
-	// TODO: GET /rest/api/3/groups/picker needs to be parsed
+	// Picker returns a list of groups whose names contain a query string.
+	//
+	// The groups names are returned as plain text, the HTML highlights of the matched query are removed.
+	//
+	// GET /rest/api/{2-3}/groups/picker
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/groups#find-groups
+	Picker(ctx context.Context, query string, maxResults int, excludeGroups []string) (*model.GroupUserPickerFoundGroupsScheme, *model.ResponseScheme, error)
 }
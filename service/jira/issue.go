@@ -29,13 +29,24 @@ type IssueSharedConnector interface {
 	//
 	// If accountID is set to:
 	//
-	//  1. "-1", the issue is assigned to the default assignee for the project.
-	//  2. null, the issue is set to unassigned.
+	//  1. model.AutomaticAssignee(), the issue is assigned to the default assignee for the project.
+	//  2. nil, the issue is set to unassigned.
 	//
 	// PUT /rest/api/{2-3}/issue/{issueKeyOrID}/assignee
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#assign-issue
-	Assign(ctx context.Context, issueKeyOrID, accountID string) (*model.ResponseScheme, error)
+	Assign(ctx context.Context, issueKeyOrID string, accountID *string) (*model.ResponseScheme, error)
+
+	// AssignRoundRobin assigns the issues to a pool of users, distributing the issues evenly in turns.
+	//
+	// The issues are assigned concurrently, the accounts rejected because they cannot be assigned to an issue are
+	// skipped on the remaining assignments of the same project. The errors are reported per issue, the assignment
+	// continues on failure.
+	//
+	// PUT /rest/api/{2-3}/issue/{issueKeyOrID}/assignee
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues#assign-issue
+	AssignRoundRobin(ctx context.Context, issueKeys, accountIDs []string) (*model.IssueAssignBulkResultScheme, error)
 
 	// Notify creates an email notification for an issue and adds it to the mail queue.
 	//
@@ -50,12 +61,102 @@ type IssueSharedConnector interface {
 	//
 	// given its status, the response will return any empty transitions list.
 	//
+	// The transitions.fields expand returns the fields required to perform each transition.
+	//
 	// GET /rest/api/{2-3}/issue/{issueKeyOrID}/transitions
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#get-transitions
-	Transitions(ctx context.Context, issueKeyOrID string) (*model.IssueTransitionsScheme, *model.ResponseScheme, error)
-	// TODO The Transitions methods requires more parameters such as expand, transitionID, and more
-	// The parameters are documented on this [page](https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-issueidorkey-transitions-get)
+	Transitions(ctx context.Context, issueKeyOrID string, options *model.TransitionOptionsScheme) (*model.IssueTransitionsScheme, *model.ResponseScheme, error)
+
+	// EditMeta returns the edit screen fields for an issue that are visible to and editable by the user.
+	//
+	// Every field contains the update operations allowed, use the model.IssueUpdateBuilder to validate the operations against it.
+	//
+	// The overrides return the fields hidden from the screens or not editable, they require the administrator permissions.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}/editmeta
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues#get-edit-issue-metadata
+	EditMeta(ctx context.Context, issueKeyOrID string, overrideScreenSecurity, overrideEditableFlag bool) (*model.IssueEditMetadataScheme, *model.ResponseScheme, error)
+
+	// ChangelogsSince returns the changelog entries of an issue created after the given history ID.
+	//
+	// The changelog pages are walked from the newest entries and the paging stops once the history ID is reached,
+	// the entries are returned in chronological order. If afterHistoryID is empty, the whole changelog is returned.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}/changelog
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues#get-changelogs
+	ChangelogsSince(ctx context.Context, issueKeyOrID, afterHistoryID string) ([]*model.IssueChangelogHistoryScheme, *model.ResponseScheme, error)
+
+	// EditBulkByJQL edits the fields of every issue matching a JQL query using the server-side bulk edit.
+	//
+	// The issues are searched using the token based pagination and submitted on a single bulk edit,
+	// the operation is processed asynchronously, so the ID of the task tracking it is returned, see AwaitBulk.
+	//
+	// The bulk edit is limited to 1000 issues, the queries matching more issues are rejected.
+	//
+	// POST /rest/api/{2-3}/search/jql
+	//
+	// POST /rest/api/{2-3}/bulk/issues/fields
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues#bulk-edit-issues-by-jql
+	EditBulkByJQL(ctx context.Context, jql string, payload *model.BulkEditFieldsScheme) (*model.BulkEditTaskScheme, *model.ResponseScheme, error)
+
+	// CreateMetaIssueTypes returns a page of the issue types available to create issues in a project.
+	//
+	// GET /rest/api/{2-3}/issue/createmeta/{projectIdOrKey}/issuetypes
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues#get-create-metadata-issue-types
+	CreateMetaIssueTypes(ctx context.Context, projectKeyOrID string, startAt, maxResults int) (*model.IssueCreateMetaIssueTypePageScheme, *model.ResponseScheme, error)
+
+	// CreateMeta returns a page of the fields available to create issues of an issue type in a project.
+	//
+	// The fields include the required flags and the allowed values, use them to build dynamic create forms.
+	//
+	// GET /rest/api/{2-3}/issue/createmeta/{projectIdOrKey}/issuetypes/{issueTypeId}
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues#get-create-field-metadata
+	CreateMeta(ctx context.Context, options *model.IssueCreateMetaOptions) (*model.IssueCreateMetaFieldPageScheme, *model.ResponseScheme, error)
+
+	// BulkMove moves issues between projects and issue types, mapping the statuses and the fields required by the targets.
+	//
+	// The operation is processed asynchronously, so the ID of the task tracking it is returned, see AwaitBulk.
+	// The bulk move is limited to 1000 issues.
+	//
+	// POST /rest/api/{2-3}/bulk/issues/move
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues#bulk-move-issues
+	BulkMove(ctx context.Context, payload *model.IssueBulkMovePayloadScheme) (*model.IssueBulkMoveTaskScheme, *model.ResponseScheme, error)
+
+	// BulkProgress returns the progress of a bulk operation, e.g. the tasks of EditBulkByJQL and BulkMove.
+	//
+	// GET /rest/api/{2-3}/bulk/queue/{taskId}
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues#get-bulk-issue-operation-progress
+	BulkProgress(ctx context.Context, taskID string) (*model.BulkOperationProgressScheme, *model.ResponseScheme, error)
+
+	// GetRendered returns the issue with the HTML rendered values of the rich-text fields, along with the raw values.
+	//
+	// The fields limit the fields returned, all the fields are returned if they are not set.
+	// The fields without a rendered form are only available as raw values.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}?expand=renderedFields
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues#get-issue-rendered
+	GetRendered(ctx context.Context, issueKeyOrID string, fields []string) (*model.IssueRenderedScheme, *model.ResponseScheme, error)
+
+	// ReorderSubtasks sets the order of the subtasks under the parent issue, ranking them in the order provided.
+	//
+	// Every key must belong to a subtask of the parent, the subtasks not provided keep their rank.
+	// The subtasks are ranked using the Jira Software rank operation, in chunks of 50 issues.
+	//
+	// GET /rest/api/{2-3}/issue/{parentKey}
+	//
+	// PUT /rest/agile/1.0/issue/rank
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues#reorder-subtasks
+	ReorderSubtasks(ctx context.Context, parentKey string, orderedSubtaskKeys []string) (*model.ResponseScheme, error)
 }
 
 type IssueRichTextConnector interface {
@@ -68,6 +169,17 @@ type IssueRichTextConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#create-issue
 	Create(ctx context.Context, payload *model.IssueSchemeV2, customFields *model.CustomFields) (*model.IssueResponseScheme, *model.ResponseScheme, error)
 
+	// CreateWithOperations creates an issue applying the update operations on creation, e.g. to add an initial comment or worklog.
+	//
+	// The fields and the operations are sent on a single request, so the issue is never visible without them.
+	// A field can't be set on the payload and updated by the operations, model.ErrIssueFieldUpdateConflict is returned.
+	// The operations of a field must be a []map[string]interface{}, model.ErrInvalidUpdateOperation is returned otherwise.
+	//
+	// POST /rest/api/{2-3}/issue
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues#create-issue
+	CreateWithOperations(ctx context.Context, payload *model.IssueSchemeV2, customFields *model.CustomFields, operations *model.UpdateOperations) (*model.IssueResponseScheme, *model.ResponseScheme, error)
+
 	// Creates issues and, where the option to create subtasks is enabled in Jira, subtasks.
 	//
 	// 1.Creates upto 50 issues and, where the option to create subtasks is enabled in Jira, subtasks.
@@ -106,12 +218,33 @@ type IssueRichTextConnector interface {
 
 	// Move performs an issue transition and, if the transition has a screen, updates the fields from the transition screen.
 	//
+	// The options HistoryMetadata attributes the transition on the issue history, e.g. to the integration performing it.
+	//
 	// sortByCategory To update the fields on the transition screen, specify the fields in the fields or update parameters in the request body. Get details about the fields using Get transitions with the transitions.fields expand.
 	//
 	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/transitions
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#transition-issue
 	Move(ctx context.Context, issueKeyOrID, transitionID string, options *model.IssueMoveOptionsV2) (*model.ResponseScheme, error)
+
+	// BulkFetch returns the issues with the provided IDs or keys, with the fields, expansions and properties selected.
+	//
+	// The issues not found or not visible are returned on the issue errors, instead of failing the whole request.
+	// The bulk fetch is limited to 100 issues.
+	//
+	// POST /rest/api/{2-3}/issue/bulkfetch
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues#bulk-fetch-issues
+	BulkFetch(ctx context.Context, payload *model.IssueBulkFetchPayloadScheme) (*model.IssueBulkFetchSchemeV2, *model.ResponseScheme, error)
+
+	// GetWithProperties returns the details for an issue along with the entity properties selected, up to 5.
+	//
+	// The properties are returned on the issue Properties field, sparing the requests to the issue properties endpoints.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}?properties={properties}
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues#get-issue-with-properties
+	GetWithProperties(ctx context.Context, issueKeyOrID string, fields, properties []string) (*model.IssueSchemeV2, *model.ResponseScheme, error)
 }
 
 type IssueADFConnector interface {
@@ -124,6 +257,17 @@ type IssueADFConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#create-issue
 	Create(ctx context.Context, payload *model.IssueScheme, customFields *model.CustomFields) (*model.IssueResponseScheme, *model.ResponseScheme, error)
 
+	// CreateWithOperations creates an issue applying the update operations on creation, e.g. to add an initial comment or worklog.
+	//
+	// The fields and the operations are sent on a single request, so the issue is never visible without them.
+	// A field can't be set on the payload and updated by the operations, model.ErrIssueFieldUpdateConflict is returned.
+	// The operations of a field must be a []map[string]interface{}, model.ErrInvalidUpdateOperation is returned otherwise.
+	//
+	// POST /rest/api/{2-3}/issue
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues#create-issue
+	CreateWithOperations(ctx context.Context, payload *model.IssueScheme, customFields *model.CustomFields, operations *model.UpdateOperations) (*model.IssueResponseScheme, *model.ResponseScheme, error)
+
 	// Creates issues and, where the option to create subtasks is enabled in Jira, subtasks.
 	//
 	// 1.Creates upto 50 issues and, where the option to create subtasks is enabled in Jira, subtasks.
@@ -162,10 +306,31 @@ type IssueADFConnector interface {
 
 	// Move performs an issue transition and, if the transition has a screen, updates the fields from the transition screen.
 	//
+	// The options HistoryMetadata attributes the transition on the issue history, e.g. to the integration performing it.
+	//
 	// sortByCategory To update the fields on the transition screen, specify the fields in the fields or update parameters in the request body. Get details about the fields using Get transitions with the transitions.fields expand.
 	//
 	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/transitions
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues#transition-issue
 	Move(ctx context.Context, issueKeyOrID, transitionID string, options *model.IssueMoveOptionsV3) (*model.ResponseScheme, error)
+
+	// BulkFetch returns the issues with the provided IDs or keys, with the fields, expansions and properties selected.
+	//
+	// The issues not found or not visible are returned on the issue errors, instead of failing the whole request.
+	// The bulk fetch is limited to 100 issues.
+	//
+	// POST /rest/api/{2-3}/issue/bulkfetch
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues#bulk-fetch-issues
+	BulkFetch(ctx context.Context, payload *model.IssueBulkFetchPayloadScheme) (*model.IssueBulkFetchScheme, *model.ResponseScheme, error)
+
+	// GetWithProperties returns the details for an issue along with the entity properties selected, up to 5.
+	//
+	// The properties are returned on the issue Properties field, sparing the requests to the issue properties endpoints.
+	//
+	// GET /rest/api/{2-3}/issue/{issueKeyOrID}?properties={properties}
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues#get-issue-with-properties
+	GetWithProperties(ctx context.Context, issueKeyOrID string, fields, properties []string) (*model.IssueScheme, *model.ResponseScheme, error)
 }
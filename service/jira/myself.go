@@ -35,4 +35,16 @@ type MySelfConnector interface {
 	//
 	// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-myself/#api-rest-api-3-mypreferences-delete
 	Delete(ctx context.Context, key string) (*model.ResponseScheme, error)
+
+	// Validate verifies the credentials calling the current user endpoint, use it before running long jobs.
+	//
+	// It returns the authenticated account, the deployment type and the scopes granted to the OAuth 2.0 tokens.
+	// The deployment type is the one reported by the server information, cached by the client, and the scopes
+	// are the ones listed by the X-OAuth-Scopes header, never the ones accepted by the endpoint.
+	// The authentication challenge is appended to the error when the credentials are rejected.
+	//
+	// GET /rest/api/{2-3}/myself
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/myself#validate-credentials
+	Validate(ctx context.Context) (*model.ValidationResultScheme, *model.ResponseScheme, error)
 }
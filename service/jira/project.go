@@ -53,6 +53,8 @@ type ProjectConnector interface {
 	//
 	// 2. asynchronous. Follow the location link in the response to determine the status of the task and use Get task to obtain subsequent updates.
 	//
+	// The returned task can be awaited with the Task service, e.g. instance.Task.Await(ctx, deletion.ID).
+	//
 	// POST /rest/api/{2-3}/project/{projectKeyOrID}/delete
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/projects#delete-project-asynchronously
@@ -187,7 +189,8 @@ type ProjectFeatureConnector interface {
 	// https://docs.go-atlassian.io/jira-software-cloud/projects/features#get-project-features
 	Gets(ctx context.Context, projectKeyOrID string) (*model.ProjectFeaturesScheme, *model.ResponseScheme, error)
 
-	// Set sets the state of a project feature.
+	// Set sets the state of a project feature, e.g. model.ProjectFeatureEnabled or model.ProjectFeatureDisabled,
+	// and returns the updated features of the project.
 	//
 	// PUT /rest/api/{2-3}/project/{projectKeyOrID}/features/{featureKey}
 	//
@@ -80,3 +80,56 @@ type IssuePropertyConnector interface {
 	*/
 	Delete(ctx context.Context, issueKeyOrID, propertyKey string) (*model.ResponseScheme, error)
 }
+
+/*
+EntityPropertyConnector represents the entity properties of the issues, projects, comments and issue types.
+
+Use it to store the state of the apps on the entities with create-or-update semantics.
+*/
+type EntityPropertyConnector interface {
+
+	/*
+		Get returns the key and value of an entity property.
+
+		The entity type is one of model.PropertyEntityIssue, model.PropertyEntityProject, model.PropertyEntityComment
+		or model.PropertyEntityIssueType.
+
+		Endpoint: GET /rest/api/{apiVersion}/{entityType}/{entityID}/properties/{propertyKey}
+
+		You can refer to the documentation: [Get entity property]
+
+		[Get entity property]: https://docs.go-atlassian.io/jira-software-cloud/properties#get-entity-property
+	*/
+	Get(ctx context.Context, entityType, entityID, propertyKey string) (*model.EntityPropertyScheme, *model.ResponseScheme, error)
+
+	/*
+		Set sets the value of an entity property.
+			- The value must be a valid, non-empty JSON blob. The maximum length is 32768 characters.
+
+		Endpoint: PUT /rest/api/{apiVersion}/{entityType}/{entityID}/properties/{propertyKey}
+
+		You can refer to the documentation: [Set entity property]
+
+		[Set entity property]: https://docs.go-atlassian.io/jira-software-cloud/properties#set-entity-property
+	*/
+	Set(ctx context.Context, entityType, entityID, propertyKey string, value interface{}) (*model.ResponseScheme, error)
+
+	/*
+		Upsert creates or updates an entity property, it returns true if the property was written.
+			- The current value is read first and the property is only written if the value changed.
+			- If ifVersion is set, the current value must be a JSON object with a numeric version field equal to it,
+			  the missing property counts as the version 0, otherwise model.ErrPropertyVersionConflict is returned.
+			  The new value is expected to carry the next version, so the concurrent updaters are usually detected.
+			  The guard is best-effort: Jira has no conditional write, the version is checked on the read and a concurrent
+			  write landing between the read and the write is overwritten silently.
+
+		Endpoint: GET /rest/api/{apiVersion}/{entityType}/{entityID}/properties/{propertyKey}
+
+		Endpoint: PUT /rest/api/{apiVersion}/{entityType}/{entityID}/properties/{propertyKey}
+
+		You can refer to the documentation: [Upsert entity property]
+
+		[Upsert entity property]: https://docs.go-atlassian.io/jira-software-cloud/properties#upsert-entity-property
+	*/
+	Upsert(ctx context.Context, entityType, entityID, propertyKey string, value interface{}, ifVersion *int) (bool, *model.ResponseScheme, error)
+}
@@ -66,4 +66,14 @@ type RemoteLinkConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/link/remote#delete-remote-issue-link-by-global-id
 	DeleteByGlobalID(ctx context.Context, issueKeyOrID, globalID string) (*models.ResponseScheme, error)
+
+	// LinkConfluencePage links a Confluence page to an issue, the page is listed on the Confluence content panel of the issue.
+	//
+	// The global ID of the remote link is built as appId=<application link ID>&pageId=<page ID>, the format Jira expects
+	// to resolve the page through the application link, so linking the same page again updates the existing link.
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/remotelink
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/link/remote#link-confluence-page
+	LinkConfluencePage(ctx context.Context, issueKeyOrID string, page *models.RemoteLinkConfluencePageScheme) (*models.RemoteLinkIdentify, *models.ResponseScheme, error)
 }
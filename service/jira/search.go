@@ -51,9 +51,22 @@ type SearchRichTextConnector interface {
 
 	// BulkFetch fetches multiple issues by their IDs or keys
 	//
+	// The bulk fetch is limited to 100 issues, use Issue.BulkFetch to select the expansions and the properties too.
+	//
 	// POST /rest/api/2/issue/bulkfetch
 	//
 	BulkFetch(ctx context.Context, issueIDsOrKeys []string, fields []string) (*model.IssueBulkFetchSchemeV2, *model.ResponseScheme, error)
+
+	// Walk searches the issues matching the JQL query and calls visit for every issue, one page after another.
+	//
+	// The issues are streamed instead of being gathered, so a single page is kept in memory, or a single issue
+	// when the client implements service.StreamConnector, e.g. the Jira clients. The first error returned by visit
+	// stops the walk and it's returned.
+	//
+	// POST /rest/api/2/search/jql
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/search#walk-issues
+	Walk(ctx context.Context, jql string, fields, expands []string, visit func(issue *model.IssueSchemeV2) error) error
 }
 
 type SearchADFConnector interface {
@@ -91,7 +104,20 @@ type SearchADFConnector interface {
 
 	// BulkFetch fetches multiple issues by their IDs or keys
 	//
+	// The bulk fetch is limited to 100 issues, use Issue.BulkFetch to select the expansions and the properties too.
+	//
 	// POST /rest/api/3/issue/bulkfetch
 	//
 	BulkFetch(ctx context.Context, issueIDsOrKeys []string, fields []string) (*model.IssueBulkFetchScheme, *model.ResponseScheme, error)
+
+	// Walk searches the issues matching the JQL query and calls visit for every issue, one page after another.
+	//
+	// The issues are streamed instead of being gathered, so a single page is kept in memory, or a single issue
+	// when the client implements service.StreamConnector, e.g. the Jira clients. The first error returned by visit
+	// stops the walk and it's returned.
+	//
+	// POST /rest/api/3/search/jql
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/search#walk-issues
+	Walk(ctx context.Context, jql string, fields, expands []string, visit func(issue *model.IssueScheme) error) error
 }
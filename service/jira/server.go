@@ -13,4 +13,12 @@ type ServerConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/server#get-jira-instance-info
 	Info(ctx context.Context) (*model.ServerInformationScheme, *model.ResponseScheme, error)
+
+	// DeploymentType returns the deployment type of the Jira instance reported by the server information,
+	// model.DeploymentTypeCloud or model.DeploymentTypeServer for the Server and Data Center instances.
+	//
+	// GET /rest/api/{2-3}/serverInfo
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/server#get-jira-instance-info
+	DeploymentType(ctx context.Context) (string, *model.ResponseScheme, error)
 }
@@ -0,0 +1,35 @@
+package jira
+
+import (
+	"context"
+
+	model "github.com/ctreminiom/go-atlassian/v2/pkg/infra/models"
+)
+
+type TimeTrackingConnector interface {
+
+	// Get returns the time tracking provider that is currently selected.
+	//
+	// Note that if time tracking is disabled, then a successful but empty response is returned.
+	//
+	// GET /rest/api/{2-3}/configuration/timetracking
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/time-tracking#get-selected-time-tracking-provider
+	Get(ctx context.Context) (*model.TimeTrackingProviderScheme, *model.ResponseScheme, error)
+
+	// GetConfiguration returns the time tracking settings.
+	//
+	// This includes settings such as the working hours per day, the working days per week and the default unit.
+	//
+	// GET /rest/api/{2-3}/configuration/timetracking/options
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/time-tracking#get-time-tracking-settings
+	GetConfiguration(ctx context.Context) (*model.TimeTrackingConfigurationScheme, *model.ResponseScheme, error)
+
+	// SetConfiguration sets the time tracking settings.
+	//
+	// PUT /rest/api/{2-3}/configuration/timetracking/options
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/time-tracking#set-time-tracking-settings
+	SetConfiguration(ctx context.Context, payload *model.TimeTrackingConfigurationScheme) (*model.TimeTrackingConfigurationScheme, *model.ResponseScheme, error)
+}
@@ -32,4 +32,30 @@ type WatcherConnector interface {
 	//
 	// https://docs.go-atlassian.io/jira-software-cloud/issues/watcher#delete-watcher
 	Delete(ctx context.Context, issueKeyOrID, accountID string) (*model.ResponseScheme, error)
+
+	// AddByJQL adds a user as a watcher of every issue matching a JQL query.
+	//
+	// The issues are searched using the token based pagination and the watcher is added with a bounded concurrency,
+	// the requests rejected by the rate limiter are retried by the HTTP client, e.g. transport.WithRetry.
+	//
+	// The failed issues don't stop the operation, their errors are returned on the result keyed by the issue key.
+	//
+	// POST /rest/api/{2-3}/search/jql
+	//
+	// POST /rest/api/{2-3}/issue/{issueKeyOrID}/watchers
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/watcher#add-watcher-by-jql
+	AddByJQL(ctx context.Context, jql, accountID string) (*model.IssueWatcherBulkResultScheme, *model.ResponseScheme, error)
+
+	// GetBulk returns, for every issue, if the current user is watching it.
+	//
+	// The bulk endpoint only reports the watching status of the current user, it doesn't return the watchers of the issues.
+	// The watcher lists are fetched per issue with Gets, e.g. with fanout.Map to bound the concurrent requests.
+	//
+	// The issue IDs are sent in chunks of 1000 issues, the maximum allowed by the endpoint, and the results are merged.
+	//
+	// POST /rest/api/{2-3}/issue/watching
+	//
+	// https://docs.go-atlassian.io/jira-software-cloud/issues/watcher#get-is-watching-issue-bulk
+	GetBulk(ctx context.Context, issueIDs []string) (*model.BulkIssueWatchersScheme, *model.ResponseScheme, error)
 }
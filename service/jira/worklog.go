@@ -69,6 +69,8 @@ type WorklogRichTextConnector interface {
 
 	// Issue returns worklogs for an issue, starting from the oldest worklog or from the worklog started on or after a date and time.
 	//
+	// The after date is a UNIX timestamp in milliseconds, e.g. time.Time.UnixMilli(), 0 returns every worklog.
+	//
 	// Time tracking must be enabled in Jira, otherwise this operation returns an error.
 	//
 	// GET /rest/api/{2-3}/issue/{issueKeyOrID}/worklog
@@ -120,6 +122,8 @@ type WorklogADFConnector interface {
 
 	// Issue returns worklogs for an issue, starting from the oldest worklog or from the worklog started on or after a date and time.
 	//
+	// The after date is a UNIX timestamp in milliseconds, e.g. time.Time.UnixMilli(), 0 returns every worklog.
+	//
 	// Time tracking must be enabled in Jira, otherwise this operation returns an error.
 	//
 	// GET /rest/api/{2-3}/issue/{issueKeyOrID}/worklog